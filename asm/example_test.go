@@ -18,8 +18,13 @@ func Example() {
 	// Output:
 	//
 	// &ir.Module{
-	//     Types:   nil,
-	//     Globals: {
+	//     SourceFilename: "",
+	//     DataLayout:     "",
+	//     TargetTriple:   "",
+	//     ModuleAsm:      nil,
+	//     Types:          nil,
+	//     Comdats:        nil,
+	//     Globals:        {
 	//         &ir.Global{
 	//             Name: "seed",
 	//             Typ:  &types.PointerType{
@@ -32,10 +37,23 @@ func Example() {
 	//                 Typ: &types.IntType{(CYCLIC REFERENCE)},
 	//                 X:   &big.Int{},
 	//             },
-	//             IsConst: false,
+	//             IsConst:         false,
+	//             Linkage:         0,
+	//             Preemption:      0,
+	//             Visibility:      0,
+	//             DLLStorageClass: 0,
+	//             ThreadLocal:     0,
+	//             UnnamedAddr:     0,
+	//             Section:         "",
+	//             Partition:       "",
+	//             Comdat:          (*ir.Comdat)(nil),
+	//             Align:           0,
+	//             Metadata:        nil,
 	//         },
 	//     },
-	//     Funcs: {
+	//     Aliases: nil,
+	//     IFuncs:  nil,
+	//     Funcs:   {
 	//         &ir.Function{
 	//             Parent: &ir.Module{(CYCLIC REFERENCE)},
 	//             Name:   "abs",
@@ -46,8 +64,9 @@ func Example() {
 	//                     Ret:    &types.IntType{Name:"", Size:32},
 	//                     Params: {
 	//                         &types.Param{
-	//                             Name: "x",
-	//                             Typ:  &types.IntType{Name:"", Size:32},
+	//                             Name:  "x",
+	//                             Typ:   &types.IntType{Name:"", Size:32},
+	//                             Attrs: nil,
 	//                         },
 	//                     },
 	//                     Variadic: false,
@@ -59,13 +78,27 @@ func Example() {
 	//                 Ret:    &types.IntType{Name:"", Size:32},
 	//                 Params: {
 	//                     &types.Param{
-	//                         Name: "x",
-	//                         Typ:  &types.IntType{Name:"", Size:32},
+	//                         Name:  "x",
+	//                         Typ:   &types.IntType{Name:"", Size:32},
+	//                         Attrs: nil,
 	//                     },
 	//                 },
 	//                 Variadic: false,
 	//             },
-	//             Blocks: nil,
+	//             Personality:     nil,
+	//             Linkage:         0,
+	//             Preemption:      0,
+	//             Visibility:      0,
+	//             DLLStorageClass: 0,
+	//             UnnamedAddr:     0,
+	//             ReturnAttrs:     nil,
+	//             FuncAttrs:       nil,
+	//             Section:         "",
+	//             Partition:       "",
+	//             Comdat:          (*ir.Comdat)(nil),
+	//             Align:           0,
+	//             Metadata:        nil,
+	//             Blocks:          nil,
 	//         },
 	//         &ir.Function{
 	//             Parent: &ir.Module{(CYCLIC REFERENCE)},
@@ -88,16 +121,34 @@ func Example() {
 	//                 },
 	//                 Variadic: false,
 	//             },
-	//             Blocks: {
+	//             Personality:     nil,
+	//             Linkage:         0,
+	//             Preemption:      0,
+	//             Visibility:      0,
+	//             DLLStorageClass: 0,
+	//             UnnamedAddr:     0,
+	//             ReturnAttrs:     nil,
+	//             FuncAttrs:       nil,
+	//             Section:         "",
+	//             Partition:       "",
+	//             Comdat:          (*ir.Comdat)(nil),
+	//             Align:           0,
+	//             Metadata:        nil,
+	//             Blocks:          {
 	//                 &ir.BasicBlock{
 	//                     Parent: &ir.Function{(CYCLIC REFERENCE)},
 	//                     Name:   "0",
 	//                     Insts:  {
 	//                         &ir.InstLoad{
-	//                             Parent: &ir.BasicBlock{(CYCLIC REFERENCE)},
-	//                             Name:   "1",
-	//                             Typ:    &types.IntType{(CYCLIC REFERENCE)},
-	//                             Src:    &ir.Global{(CYCLIC REFERENCE)},
+	//                             Parent:    &ir.BasicBlock{(CYCLIC REFERENCE)},
+	//                             Name:      "1",
+	//                             Typ:       &types.IntType{(CYCLIC REFERENCE)},
+	//                             Src:       &ir.Global{(CYCLIC REFERENCE)},
+	//                             Volatile:  false,
+	//                             Ordering:  0,
+	//                             SyncScope: "",
+	//                             Align:     0,
+	//                             Metadata:  nil,
 	//                         },
 	//                         &ir.InstMul{
 	//                             Parent: &ir.BasicBlock{(CYCLIC REFERENCE)},
@@ -110,6 +161,8 @@ func Example() {
 	//                                     abs: {0x15a4e35},
 	//                                 },
 	//                             },
+	//                             OverflowFlags: nil,
+	//                             Metadata:      nil,
 	//                         },
 	//                         &ir.InstAdd{
 	//                             Parent: &ir.BasicBlock{(CYCLIC REFERENCE)},
@@ -122,11 +175,18 @@ func Example() {
 	//                                     abs: {0x1},
 	//                                 },
 	//                             },
+	//                             OverflowFlags: nil,
+	//                             Metadata:      nil,
 	//                         },
 	//                         &ir.InstStore{
-	//                             Parent: &ir.BasicBlock{(CYCLIC REFERENCE)},
-	//                             Src:    &ir.InstAdd{(CYCLIC REFERENCE)},
-	//                             Dst:    &ir.Global{(CYCLIC REFERENCE)},
+	//                             Parent:    &ir.BasicBlock{(CYCLIC REFERENCE)},
+	//                             Src:       &ir.InstAdd{(CYCLIC REFERENCE)},
+	//                             Dst:       &ir.Global{(CYCLIC REFERENCE)},
+	//                             Volatile:  false,
+	//                             Ordering:  0,
+	//                             SyncScope: "",
+	//                             Align:     0,
+	//                             Metadata:  nil,
 	//                         },
 	//                         &ir.InstCall{
 	//                             Parent: &ir.BasicBlock{(CYCLIC REFERENCE)},
@@ -136,6 +196,10 @@ func Example() {
 	//                             Args:   {
 	//                                 &ir.InstAdd{(CYCLIC REFERENCE)},
 	//                             },
+	//                             FastMathFlags: nil,
+	//                             ReturnAttrs:   nil,
+	//                             FuncAttrs:     nil,
+	//                             Metadata:      nil,
 	//                         },
 	//                     },
 	//                     Term: &ir.TermRet{
@@ -148,11 +212,19 @@ func Example() {
 	//                             Args:   {
 	//                                 &ir.InstAdd{(CYCLIC REFERENCE)},
 	//                             },
+	//                             FastMathFlags: nil,
+	//                             ReturnAttrs:   nil,
+	//                             FuncAttrs:     nil,
+	//                             Metadata:      nil,
 	//                         },
+	//                         Metadata: nil,
 	//                     },
 	//                 },
 	//             },
 	//         },
 	//     },
+	//     AttrGroupDefs: nil,
+	//     NamedMetadata: nil,
+	//     MetadataDefs:  nil,
 	// }
 }
@@ -0,0 +1,73 @@
+package ast
+
+// An Alias represents an LLVM IR global alias.
+type Alias struct {
+	// Alias name.
+	Name string
+	// Content type.
+	Content Type
+	// Aliasee.
+	Aliasee Constant
+	// Linkage type of the alias.
+	Linkage Linkage
+	// Runtime preemption specifier of the alias.
+	Preemption Preemption
+	// Visibility style of the alias.
+	Visibility Visibility
+	// DLL storage class of the alias.
+	DLLStorageClass DLLStorageClass
+	// Thread-local storage model of the alias; or TLSNone if not thread-local.
+	ThreadLocal ThreadLocalMode
+	// Unnamed address specifier of the alias.
+	UnnamedAddr UnnamedAddr
+}
+
+// GetName returns the name of the value.
+func (alias *Alias) GetName() string {
+	return alias.Name
+}
+
+// SetName sets the name of the value.
+func (alias *Alias) SetName(name string) {
+	alias.Name = name
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*Alias) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*Alias) isConstant() {}
+
+// An IFunc represents an LLVM IR indirect function.
+type IFunc struct {
+	// IFunc name.
+	Name string
+	// Content type.
+	Content Type
+	// Resolver function.
+	Resolver Constant
+	// Linkage type of the IFunc.
+	Linkage Linkage
+	// Runtime preemption specifier of the IFunc.
+	Preemption Preemption
+	// Visibility style of the IFunc.
+	Visibility Visibility
+}
+
+// GetName returns the name of the value.
+func (ifunc *IFunc) GetName() string {
+	return ifunc.Name
+}
+
+// SetName sets the name of the value.
+func (ifunc *IFunc) SetName(name string) {
+	ifunc.Name = name
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*IFunc) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*IFunc) isConstant() {}
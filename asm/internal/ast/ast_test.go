@@ -8,15 +8,23 @@ var (
 	_ ast.Constant = &ast.IntConst{}
 	_ ast.Constant = &ast.FloatConst{}
 	_ ast.Constant = &ast.NullConst{}
+	_ ast.Constant = &ast.NoneConst{}
 	// Complex constants.
 	_ ast.Constant = &ast.VectorConst{}
 	_ ast.Constant = &ast.ArrayConst{}
 	_ ast.Constant = &ast.CharArrayConst{}
 	_ ast.Constant = &ast.StructConst{}
 	_ ast.Constant = &ast.ZeroInitializerConst{}
-	// Global variable and function addresses
+	// Undefined values.
+	_ ast.Constant = &ast.UndefConst{}
+	_ ast.Constant = &ast.PoisonConst{}
+	// Global variable, alias, IFunc and function addresses
 	_ ast.Constant = &ast.Global{}
+	_ ast.Constant = &ast.Alias{}
+	_ ast.Constant = &ast.IFunc{}
 	_ ast.Constant = &ast.Function{}
+	// Addresses of basic blocks
+	_ ast.Constant = &ast.BlockAddressConst{}
 )
 
 // Validate that the relevant types satisfy the ast.Constant interface.
@@ -42,6 +50,13 @@ var (
 	_ ast.Constant = &ast.ExprAnd{}
 	_ ast.Constant = &ast.ExprOr{}
 	_ ast.Constant = &ast.ExprXor{}
+	// Vector instructions
+	_ ast.Constant = &ast.ExprExtractElement{}
+	_ ast.Constant = &ast.ExprInsertElement{}
+	_ ast.Constant = &ast.ExprShuffleVector{}
+	// Aggregate instructions
+	_ ast.Constant = &ast.ExprExtractValue{}
+	_ ast.Constant = &ast.ExprInsertValue{}
 	// Memory instructions
 	_ ast.Constant = &ast.ExprGetElementPtr{}
 	// Conversion instructions
@@ -87,11 +102,19 @@ var (
 	_ ast.Instruction = &ast.InstOr{}
 	_ ast.Instruction = &ast.InstXor{}
 	// Vector instructions
+	_ ast.Instruction = &ast.InstExtractElement{}
+	_ ast.Instruction = &ast.InstInsertElement{}
+	_ ast.Instruction = &ast.InstShuffleVector{}
 	// Aggregate instructions
+	_ ast.Instruction = &ast.InstExtractValue{}
+	_ ast.Instruction = &ast.InstInsertValue{}
 	// Memory instructions
 	_ ast.Instruction = &ast.InstAlloca{}
 	_ ast.Instruction = &ast.InstLoad{}
 	_ ast.Instruction = &ast.InstStore{}
+	_ ast.Instruction = &ast.InstFence{}
+	_ ast.Instruction = &ast.InstCmpXchg{}
+	_ ast.Instruction = &ast.InstAtomicRMW{}
 	_ ast.Instruction = &ast.InstGetElementPtr{}
 	// Conversion instructions
 	_ ast.Instruction = &ast.InstTrunc{}
@@ -113,6 +136,9 @@ var (
 	_ ast.Instruction = &ast.InstPhi{}
 	_ ast.Instruction = &ast.InstSelect{}
 	_ ast.Instruction = &ast.InstCall{}
+	_ ast.Instruction = &ast.InstLandingPad{}
+	_ ast.Instruction = &ast.InstCatchPad{}
+	_ ast.Instruction = &ast.InstCleanupPad{}
 )
 
 // Validate that the relevant types satisfy the ast.Terminator interface.
@@ -122,12 +148,20 @@ var (
 	_ ast.Terminator = &ast.TermBr{}
 	_ ast.Terminator = &ast.TermCondBr{}
 	_ ast.Terminator = &ast.TermSwitch{}
+	_ ast.Terminator = &ast.TermIndirectBr{}
+	_ ast.Terminator = &ast.TermInvoke{}
+	_ ast.Terminator = &ast.TermResume{}
+	_ ast.Terminator = &ast.TermCatchSwitch{}
+	_ ast.Terminator = &ast.TermCatchRet{}
+	_ ast.Terminator = &ast.TermCleanupRet{}
 	_ ast.Terminator = &ast.TermUnreachable{}
 )
 
 // Validate that the relevant types satisfy the ast.NamedValue interface.
 var (
 	_ ast.NamedValue = &ast.Global{}
+	_ ast.NamedValue = &ast.Alias{}
+	_ ast.NamedValue = &ast.IFunc{}
 	_ ast.NamedValue = &ast.GlobalDummy{}
 	_ ast.NamedValue = &ast.Function{}
 	_ ast.NamedValue = &ast.Param{}
@@ -154,10 +188,17 @@ var (
 	_ ast.NamedValue = &ast.InstOr{}
 	_ ast.NamedValue = &ast.InstXor{}
 	// Vector instructions
+	_ ast.NamedValue = &ast.InstExtractElement{}
+	_ ast.NamedValue = &ast.InstInsertElement{}
+	_ ast.NamedValue = &ast.InstShuffleVector{}
 	// Aggregate instructions
+	_ ast.NamedValue = &ast.InstExtractValue{}
+	_ ast.NamedValue = &ast.InstInsertValue{}
 	// Memory instructions
 	_ ast.NamedValue = &ast.InstAlloca{}
 	_ ast.NamedValue = &ast.InstLoad{}
+	_ ast.NamedValue = &ast.InstCmpXchg{}
+	_ ast.NamedValue = &ast.InstAtomicRMW{}
 	_ ast.NamedValue = &ast.InstGetElementPtr{}
 	// Conversion instructions
 	_ ast.NamedValue = &ast.InstTrunc{}
@@ -179,6 +220,12 @@ var (
 	_ ast.NamedValue = &ast.InstPhi{}
 	_ ast.NamedValue = &ast.InstSelect{}
 	_ ast.NamedValue = &ast.InstCall{}
+	_ ast.NamedValue = &ast.InstLandingPad{}
+	_ ast.NamedValue = &ast.InstCatchPad{}
+	_ ast.NamedValue = &ast.InstCleanupPad{}
+	// Terminators
+	_ ast.NamedValue = &ast.TermInvoke{}
+	_ ast.NamedValue = &ast.TermCatchSwitch{}
 )
 
 // Validate that the relevant types satisfy the ast.Type interface.
@@ -189,9 +236,59 @@ var (
 	_ ast.Type = &ast.FloatType{}
 	_ ast.Type = &ast.PointerType{}
 	_ ast.Type = &ast.VectorType{}
+	_ ast.Type = &ast.MMXType{}
 	_ ast.Type = &ast.LabelType{}
 	_ ast.Type = &ast.MetadataType{}
+	_ ast.Type = &ast.TokenType{}
 	_ ast.Type = &ast.ArrayType{}
 	_ ast.Type = &ast.StructType{}
 	_ ast.Type = &ast.NamedType{}
 )
+
+// Validate that the relevant types satisfy the ast.FuncAttribute interface.
+var (
+	_ ast.FuncAttribute = ast.AttrKind(0)
+	_ ast.FuncAttribute = &ast.AttrInt{}
+	_ ast.FuncAttribute = &ast.AttrString{}
+	_ ast.FuncAttribute = ast.AttrGroupID(0)
+)
+
+// Validate that the relevant types satisfy the ast.ParamAttribute interface.
+var (
+	_ ast.ParamAttribute = ast.AttrKind(0)
+	_ ast.ParamAttribute = &ast.AttrInt{}
+	_ ast.ParamAttribute = &ast.AttrString{}
+)
+
+// Validate that the relevant types satisfy the ast.Metadata interface.
+var (
+	_ ast.Metadata = &ast.MDNode{}
+	_ ast.Metadata = ast.MetadataID(0)
+	_ ast.Metadata = &ast.MDString{}
+	_ ast.Metadata = &ast.ValueAsMetadata{}
+)
+
+// Validate that the relevant types satisfy the ast.MetadataNode interface.
+var (
+	_ ast.MetadataNode = &ast.MDNode{}
+	_ ast.MetadataNode = &ast.DICompileUnit{}
+	_ ast.MetadataNode = &ast.DIFile{}
+	_ ast.MetadataNode = &ast.DISubprogram{}
+	_ ast.MetadataNode = &ast.DILocation{}
+	_ ast.MetadataNode = &ast.DILocalVariable{}
+	_ ast.MetadataNode = &ast.DIBasicType{}
+	_ ast.MetadataNode = &ast.DICompositeType{}
+	_ ast.MetadataNode = &ast.DIDerivedType{}
+	_ ast.MetadataNode = &ast.DISubroutineType{}
+	_ ast.MetadataNode = &ast.DILexicalBlock{}
+	_ ast.MetadataNode = &ast.DISubrange{}
+	_ ast.MetadataNode = &ast.DIGlobalVariable{}
+	_ ast.MetadataNode = &ast.DIGlobalVariableExpression{}
+	_ ast.MetadataNode = &ast.DIExpression{}
+)
+
+// Validate that the relevant types satisfy the ast.DIExpressionElem interface.
+var (
+	_ ast.DIExpressionElem = ast.DwarfOp("")
+	_ ast.DIExpressionElem = ast.Uint(0)
+)
@@ -55,6 +55,9 @@ func WalkFuncBeforeAfter(f *ast.Function, before, after func(interface{})) {
 	// Traverse child nodes of function, instead of f directly, as *ast.Function
 	// nodes are not traversed when staying within the scope of the function.
 	w.walkBeforeAfter(&f.Sig, before, after)
+	if f.Personality != nil {
+		w.walkBeforeAfter(&f.Personality, before, after)
+	}
 	if f.Blocks != nil {
 		w.walkBeforeAfter(&f.Blocks, before, after)
 	}
@@ -75,7 +78,7 @@ type walker struct {
 // traversal.
 func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{})) {
 	switch x.(type) {
-	case []*ast.Global, []*ast.Function, []*ast.Param, []ast.Type, []*ast.NamedType, []ast.Value, []ast.Constant, []*ast.BasicBlock, []ast.Instruction, []*ast.Incoming, []*ast.Case:
+	case []*ast.ComdatDef, []*ast.Global, []*ast.Alias, []*ast.IFunc, []*ast.Function, []*ast.Param, []ast.Type, []*ast.NamedType, []ast.Value, []ast.Constant, []*ast.BasicBlock, []ast.Instruction, []*ast.Incoming, []*ast.Clause, []*ast.Case, []ast.NamedValue, []*ast.MetadataDef, []ast.Metadata:
 		// unhashable type.
 	case *ast.Function:
 		if w.funcScope {
@@ -110,10 +113,22 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case *ast.Terminator:
 		w.walkBeforeAfter(*n, before, after)
+	case *ast.Metadata:
+		w.walkBeforeAfter(*n, before, after)
+	case *ast.MetadataNode:
+		w.walkBeforeAfter(*n, before, after)
 
 	// pointers to struct pointers
+	case **ast.ComdatDef:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.MetadataDef:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.Global:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.Alias:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.IFunc:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.Function:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.Param:
@@ -135,10 +150,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.VectorType:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.MMXType:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.LabelType:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.MetadataType:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.TokenType:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.ArrayType:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.StructType:
@@ -154,6 +173,8 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.NullConst:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.NoneConst:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.VectorConst:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.ArrayConst:
@@ -164,6 +185,12 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.ZeroInitializerConst:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.UndefConst:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.PoisonConst:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.BlockAddressConst:
+		w.walkBeforeAfter(*n, before, after)
 	// Constant expressions
 	case **ast.ExprAdd:
 		w.walkBeforeAfter(*n, before, after)
@@ -201,6 +228,16 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.ExprXor:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.ExprExtractElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.ExprInsertElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.ExprShuffleVector:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.ExprExtractValue:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.ExprInsertValue:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.ExprGetElementPtr:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.ExprTrunc:
@@ -275,12 +312,28 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstXor:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstExtractElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstInsertElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstShuffleVector:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstExtractValue:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstInsertValue:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstAlloca:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstLoad:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstStore:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstFence:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstCmpXchg:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstAtomicRMW:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstGetElementPtr:
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstTrunc:
@@ -321,6 +374,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.InstCall:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstLandingPad:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstCatchPad:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.InstCleanupPad:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.Clause:
+		w.walkBeforeAfter(*n, before, after)
 	// Terminators
 	case **ast.TermRet:
 		w.walkBeforeAfter(*n, before, after)
@@ -330,8 +391,20 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ast.TermSwitch:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermIndirectBr:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.Case:
 		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermInvoke:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermResume:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermCatchSwitch:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermCatchRet:
+		w.walkBeforeAfter(*n, before, after)
+	case **ast.TermCleanupRet:
+		w.walkBeforeAfter(*n, before, after)
 	case **ast.TermUnreachable:
 		w.walkBeforeAfter(*n, before, after)
 
@@ -340,10 +413,22 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*ast.NamedType:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ast.ComdatDef:
+		w.walkBeforeAfter(*n, before, after)
+	case *[]*ast.MetadataDef:
+		w.walkBeforeAfter(*n, before, after)
+	case *[]ast.Metadata:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]*ast.Global:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ast.Alias:
+		w.walkBeforeAfter(*n, before, after)
+	case *[]*ast.IFunc:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]ast.Value:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]ast.NamedValue:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]ast.Constant:
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*ast.Function:
@@ -358,18 +443,38 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*ast.Incoming:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ast.Clause:
+		w.walkBeforeAfter(*n, before, after)
 
 	// These are ordered and grouped to match ../../ll.bnf
 	case *ast.Module:
 		if n.Types != nil {
 			w.walkBeforeAfter(&n.Types, before, after)
 		}
+		if n.Comdats != nil {
+			w.walkBeforeAfter(&n.Comdats, before, after)
+		}
 		if n.Globals != nil {
 			w.walkBeforeAfter(&n.Globals, before, after)
 		}
+		if n.Aliases != nil {
+			w.walkBeforeAfter(&n.Aliases, before, after)
+		}
+		if n.IFuncs != nil {
+			w.walkBeforeAfter(&n.IFuncs, before, after)
+		}
 		if n.Funcs != nil {
 			w.walkBeforeAfter(&n.Funcs, before, after)
 		}
+		if n.MetadataDefs != nil {
+			w.walkBeforeAfter(&n.MetadataDefs, before, after)
+		}
+	case []*ast.ComdatDef:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ast.ComdatDef:
+		// nothing to do.
 	case []*ast.Global:
 		for i := range n {
 			w.walkBeforeAfter(&n[i], before, after)
@@ -379,12 +484,29 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		if n.Init != nil {
 			w.walkBeforeAfter(&n.Init, before, after)
 		}
+	case []*ast.Alias:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ast.Alias:
+		w.walkBeforeAfter(&n.Content, before, after)
+		w.walkBeforeAfter(&n.Aliasee, before, after)
+	case []*ast.IFunc:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ast.IFunc:
+		w.walkBeforeAfter(&n.Content, before, after)
+		w.walkBeforeAfter(&n.Resolver, before, after)
 	case []*ast.Function:
 		for i := range n {
 			w.walkBeforeAfter(&n[i], before, after)
 		}
 	case *ast.Function:
 		w.walkBeforeAfter(&n.Sig, before, after)
+		if n.Personality != nil {
+			w.walkBeforeAfter(&n.Personality, before, after)
+		}
 		if n.Blocks != nil {
 			w.walkBeforeAfter(&n.Blocks, before, after)
 		}
@@ -420,10 +542,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(&n.Elem, before, after)
 	case *ast.VectorType:
 		w.walkBeforeAfter(&n.Elem, before, after)
+	case *ast.MMXType:
+		// nothing to do.
 	case *ast.LabelType:
 		// nothing to do.
 	case *ast.MetadataType:
 		// nothing to do.
+	case *ast.TokenType:
+		// nothing to do.
 	case *ast.ArrayType:
 		w.walkBeforeAfter(&n.Elem, before, after)
 	case *ast.StructType:
@@ -449,12 +575,18 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		for i := range n {
 			w.walkBeforeAfter(&n[i], before, after)
 		}
+	case []ast.NamedValue:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
 	case *ast.IntConst:
 		w.walkBeforeAfter(&n.Type, before, after)
 	case *ast.FloatConst:
 		w.walkBeforeAfter(&n.Type, before, after)
 	case *ast.NullConst:
 		w.walkBeforeAfter(&n.Type, before, after)
+	case *ast.NoneConst:
+		w.walkBeforeAfter(&n.Type, before, after)
 	case *ast.VectorConst:
 		w.walkBeforeAfter(&n.Type, before, after)
 		if n.Elems != nil {
@@ -474,6 +606,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		}
 	case *ast.ZeroInitializerConst:
 		w.walkBeforeAfter(&n.Type, before, after)
+	case *ast.UndefConst:
+		w.walkBeforeAfter(&n.Type, before, after)
+	case *ast.PoisonConst:
+		w.walkBeforeAfter(&n.Type, before, after)
+	case *ast.BlockAddressConst:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.Func, before, after)
+		w.walkBeforeAfter(&n.Block, before, after)
 	// Constant expressions
 	case *ast.ExprAdd:
 		w.walkBeforeAfter(&n.Type, before, after)
@@ -547,6 +687,27 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(&n.Type, before, after)
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Y, before, after)
+	case *ast.ExprExtractElement:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ast.ExprInsertElement:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ast.ExprShuffleVector:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Y, before, after)
+		w.walkBeforeAfter(&n.Mask, before, after)
+	case *ast.ExprExtractValue:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ast.ExprInsertValue:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
 	case *ast.ExprGetElementPtr:
 		w.walkBeforeAfter(&n.Type, before, after)
 		w.walkBeforeAfter(&n.Elem, before, after)
@@ -688,6 +849,22 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ast.InstXor:
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Y, before, after)
+	case *ast.InstExtractElement:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ast.InstInsertElement:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ast.InstShuffleVector:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Y, before, after)
+		w.walkBeforeAfter(&n.Mask, before, after)
+	case *ast.InstExtractValue:
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ast.InstInsertValue:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
 	case *ast.InstAlloca:
 		w.walkBeforeAfter(&n.Elem, before, after)
 		if n.NElems != nil {
@@ -699,6 +876,15 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ast.InstStore:
 		w.walkBeforeAfter(&n.Src, before, after)
 		w.walkBeforeAfter(&n.Dst, before, after)
+	case *ast.InstFence:
+		// nothing to do.
+	case *ast.InstCmpXchg:
+		w.walkBeforeAfter(&n.Ptr, before, after)
+		w.walkBeforeAfter(&n.Cmp, before, after)
+		w.walkBeforeAfter(&n.New, before, after)
+	case *ast.InstAtomicRMW:
+		w.walkBeforeAfter(&n.Ptr, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
 	case *ast.InstGetElementPtr:
 		w.walkBeforeAfter(&n.Elem, before, after)
 		w.walkBeforeAfter(&n.Src, before, after)
@@ -772,6 +958,29 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		if n.Args != nil {
 			w.walkBeforeAfter(&n.Args, before, after)
 		}
+	case *ast.Arg:
+		w.walkBeforeAfter(&n.Value, before, after)
+	case *ast.InstLandingPad:
+		w.walkBeforeAfter(&n.Type, before, after)
+		if n.Clauses != nil {
+			w.walkBeforeAfter(&n.Clauses, before, after)
+		}
+	case *ast.InstCatchPad:
+		w.walkBeforeAfter(&n.CatchSwitch, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
+	case *ast.InstCleanupPad:
+		w.walkBeforeAfter(&n.ParentPad, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
+	case []*ast.Clause:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ast.Clause:
+		w.walkBeforeAfter(&n.X, before, after)
 	// Terminators
 	case *ast.TermRet:
 		if n.X != nil {
@@ -796,9 +1005,231 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ast.Case:
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Target, before, after)
+	case *ast.TermIndirectBr:
+		w.walkBeforeAfter(&n.Addr, before, after)
+		if n.ValidTargets != nil {
+			w.walkBeforeAfter(&n.ValidTargets, before, after)
+		}
+	case *ast.TermInvoke:
+		w.walkBeforeAfter(&n.Type, before, after)
+		w.walkBeforeAfter(&n.Callee, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
+		w.walkBeforeAfter(&n.TargetNormal, before, after)
+		w.walkBeforeAfter(&n.TargetUnwind, before, after)
+	case *ast.TermResume:
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ast.TermCatchSwitch:
+		w.walkBeforeAfter(&n.ParentPad, before, after)
+		if n.Handlers != nil {
+			w.walkBeforeAfter(&n.Handlers, before, after)
+		}
+		if n.UnwindTarget != nil {
+			w.walkBeforeAfter(&n.UnwindTarget, before, after)
+		}
+	case *ast.TermCatchRet:
+		w.walkBeforeAfter(&n.CatchPad, before, after)
+		w.walkBeforeAfter(&n.Target, before, after)
+	case *ast.TermCleanupRet:
+		w.walkBeforeAfter(&n.CleanupPad, before, after)
+		if n.UnwindTarget != nil {
+			w.walkBeforeAfter(&n.UnwindTarget, before, after)
+		}
 	case *ast.TermUnreachable:
 		// nothing to do.
 
+	// Metadata
+	case []*ast.MetadataDef:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ast.MetadataDef:
+		w.walkBeforeAfter(&n.Node, before, after)
+	case []ast.Metadata:
+		for i := range n {
+			// Skip null metadata operands.
+			if n[i] != nil {
+				w.walkBeforeAfter(&n[i], before, after)
+			}
+		}
+	case *ast.MDNode:
+		if n.Nodes != nil {
+			w.walkBeforeAfter(&n.Nodes, before, after)
+		}
+	case ast.MetadataID:
+		// nothing to do.
+	case *ast.MDString:
+		// nothing to do.
+	case *ast.ValueAsMetadata:
+		w.walkBeforeAfter(&n.Value, before, after)
+	case *ast.DICompileUnit:
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.Enums != nil {
+			w.walkBeforeAfter(&n.Enums, before, after)
+		}
+		if n.RetainedTypes != nil {
+			w.walkBeforeAfter(&n.RetainedTypes, before, after)
+		}
+		if n.Globals != nil {
+			w.walkBeforeAfter(&n.Globals, before, after)
+		}
+		if n.Imports != nil {
+			w.walkBeforeAfter(&n.Imports, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIFile:
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DISubprogram:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.Sig != nil {
+			w.walkBeforeAfter(&n.Sig, before, after)
+		}
+		if n.Unit != nil {
+			w.walkBeforeAfter(&n.Unit, before, after)
+		}
+		if n.Declaration != nil {
+			w.walkBeforeAfter(&n.Declaration, before, after)
+		}
+		if n.RetainedNodes != nil {
+			w.walkBeforeAfter(&n.RetainedNodes, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DILocation:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.InlinedAt != nil {
+			w.walkBeforeAfter(&n.InlinedAt, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DILocalVariable:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.VarType != nil {
+			w.walkBeforeAfter(&n.VarType, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIBasicType:
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DICompositeType:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.BaseType != nil {
+			w.walkBeforeAfter(&n.BaseType, before, after)
+		}
+		if n.Elements != nil {
+			w.walkBeforeAfter(&n.Elements, before, after)
+		}
+		if n.VtableHolder != nil {
+			w.walkBeforeAfter(&n.VtableHolder, before, after)
+		}
+		if n.TemplateParams != nil {
+			w.walkBeforeAfter(&n.TemplateParams, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIDerivedType:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.BaseType != nil {
+			w.walkBeforeAfter(&n.BaseType, before, after)
+		}
+		if n.ExtraData != nil {
+			w.walkBeforeAfter(&n.ExtraData, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DISubroutineType:
+		if n.Types != nil {
+			w.walkBeforeAfter(&n.Types, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DILexicalBlock:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DISubrange:
+		if n.CountVar != nil {
+			w.walkBeforeAfter(&n.CountVar, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIGlobalVariable:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.VarType != nil {
+			w.walkBeforeAfter(&n.VarType, before, after)
+		}
+		if n.Declaration != nil {
+			w.walkBeforeAfter(&n.Declaration, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIGlobalVariableExpression:
+		if n.Var != nil {
+			w.walkBeforeAfter(&n.Var, before, after)
+		}
+		if n.Expr != nil {
+			w.walkBeforeAfter(&n.Expr, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *ast.DIExpression:
+		// nothing to do.
+	case *ast.DIField:
+		if n.MD != nil {
+			w.walkBeforeAfter(&n.MD, before, after)
+		}
+
 	default:
 		panic(fmt.Errorf("support for type %T not yet implemented", x))
 	}
@@ -0,0 +1,264 @@
+package ast
+
+import "fmt"
+
+// A FuncAttribute represents a function attribute.
+//
+// FuncAttribute may have one of the following underlying types.
+//
+//    ast.AttrKind
+//    *ast.AttrInt
+//    *ast.AttrAllocSize
+//    *ast.AttrString
+//    ast.AttrGroupID
+type FuncAttribute interface {
+	// isFuncAttribute ensures that only function attributes can be assigned to
+	// the ast.FuncAttribute interface.
+	isFuncAttribute()
+}
+
+// A ParamAttribute represents a parameter attribute of a function parameter,
+// return value or call site argument.
+//
+// ParamAttribute may have one of the following underlying types.
+//
+//    ast.AttrKind
+//    *ast.AttrInt
+//    *ast.AttrString
+type ParamAttribute interface {
+	// isParamAttribute ensures that only parameter attributes can be assigned
+	// to the ast.ParamAttribute interface.
+	isParamAttribute()
+}
+
+// --- [ Enum attributes ] -----------------------------------------------------
+
+// AttrKind represents the kind of an enum attribute; i.e. an attribute without
+// arguments.
+type AttrKind int
+
+// Enum attributes.
+const (
+	AttrAlwaysInline                AttrKind = iota + 1 // alwaysinline
+	AttrArgMemOnly                                      // argmemonly
+	AttrBuiltin                                         // builtin
+	AttrByVal                                           // byval
+	AttrCold                                            // cold
+	AttrConvergent                                      // convergent
+	AttrInAlloca                                        // inalloca
+	AttrInReg                                           // inreg
+	AttrInaccessibleMemOnly                             // inaccessiblememonly
+	AttrInaccessibleMemOrArgMemOnly                     // inaccessiblemem_or_argmemonly
+	AttrInlineHint                                      // inlinehint
+	AttrJumpTable                                       // jumptable
+	AttrMinSize                                         // minsize
+	AttrNaked                                           // naked
+	AttrNest                                            // nest
+	AttrNoAlias                                         // noalias
+	AttrNoBuiltin                                       // nobuiltin
+	AttrNoCapture                                       // nocapture
+	AttrNoDuplicate                                     // noduplicate
+	AttrNoImplicitFloat                                 // noimplicitfloat
+	AttrNoInline                                        // noinline
+	AttrNoRecurse                                       // norecurse
+	AttrNoRedZone                                       // noredzone
+	AttrNoReturn                                        // noreturn
+	AttrNoUnwind                                        // nounwind
+	AttrNonLazyBind                                     // nonlazybind
+	AttrNonNull                                         // nonnull
+	AttrOptNone                                         // optnone
+	AttrOptSize                                         // optsize
+	AttrReadNone                                        // readnone
+	AttrReadOnly                                        // readonly
+	AttrReturned                                        // returned
+	AttrReturnsTwice                                    // returns_twice
+	AttrSExt                                            // signext
+	AttrSRet                                            // sret
+	AttrSafeStack                                       // safestack
+	AttrSanitizeAddress                                 // sanitize_address
+	AttrSanitizeMemory                                  // sanitize_memory
+	AttrSanitizeThread                                  // sanitize_thread
+	AttrSpeculatable                                    // speculatable
+	AttrSSP                                             // ssp
+	AttrSSPReq                                          // sspreq
+	AttrSSPStrong                                       // sspstrong
+	AttrSwiftError                                      // swifterror
+	AttrSwiftSelf                                       // swiftself
+	AttrUWTable                                         // uwtable
+	AttrWriteOnly                                       // writeonly
+	AttrZExt                                            // zeroext
+)
+
+// String returns the LLVM syntax representation of the enum attribute.
+func (kind AttrKind) String() string {
+	m := map[AttrKind]string{
+		AttrAlwaysInline:                "alwaysinline",
+		AttrArgMemOnly:                  "argmemonly",
+		AttrBuiltin:                     "builtin",
+		AttrByVal:                       "byval",
+		AttrCold:                        "cold",
+		AttrConvergent:                  "convergent",
+		AttrInAlloca:                    "inalloca",
+		AttrInReg:                       "inreg",
+		AttrInaccessibleMemOnly:         "inaccessiblememonly",
+		AttrInaccessibleMemOrArgMemOnly: "inaccessiblemem_or_argmemonly",
+		AttrInlineHint:                  "inlinehint",
+		AttrJumpTable:                   "jumptable",
+		AttrMinSize:                     "minsize",
+		AttrNaked:                       "naked",
+		AttrNest:                        "nest",
+		AttrNoAlias:                     "noalias",
+		AttrNoBuiltin:                   "nobuiltin",
+		AttrNoCapture:                   "nocapture",
+		AttrNoDuplicate:                 "noduplicate",
+		AttrNoImplicitFloat:             "noimplicitfloat",
+		AttrNoInline:                    "noinline",
+		AttrNoRecurse:                   "norecurse",
+		AttrNoRedZone:                   "noredzone",
+		AttrNoReturn:                    "noreturn",
+		AttrNoUnwind:                    "nounwind",
+		AttrNonLazyBind:                 "nonlazybind",
+		AttrNonNull:                     "nonnull",
+		AttrOptNone:                     "optnone",
+		AttrOptSize:                     "optsize",
+		AttrReadNone:                    "readnone",
+		AttrReadOnly:                    "readonly",
+		AttrReturned:                    "returned",
+		AttrReturnsTwice:                "returns_twice",
+		AttrSExt:                        "signext",
+		AttrSRet:                        "sret",
+		AttrSafeStack:                   "safestack",
+		AttrSanitizeAddress:             "sanitize_address",
+		AttrSanitizeMemory:              "sanitize_memory",
+		AttrSanitizeThread:              "sanitize_thread",
+		AttrSpeculatable:                "speculatable",
+		AttrSSP:                         "ssp",
+		AttrSSPReq:                      "sspreq",
+		AttrSSPStrong:                   "sspstrong",
+		AttrSwiftError:                  "swifterror",
+		AttrSwiftSelf:                   "swiftself",
+		AttrUWTable:                     "uwtable",
+		AttrWriteOnly:                   "writeonly",
+		AttrZExt:                        "zeroext",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown enum attribute %d>", int(kind))
+}
+
+// isFuncAttribute ensures that only function attributes can be assigned to the
+// ast.FuncAttribute interface.
+func (AttrKind) isFuncAttribute() {}
+
+// isParamAttribute ensures that only parameter attributes can be assigned to the
+// ast.ParamAttribute interface.
+func (AttrKind) isParamAttribute() {}
+
+// --- [ Integer attributes ] --------------------------------------------------
+
+// AttrInt represents an integer attribute; i.e. an attribute with an integer
+// argument.
+type AttrInt struct {
+	// Integer attribute kind.
+	Kind AttrIntKind
+	// Integer argument.
+	N int64
+}
+
+// isFuncAttribute ensures that only function attributes can be assigned to the
+// ast.FuncAttribute interface.
+func (*AttrInt) isFuncAttribute() {}
+
+// isParamAttribute ensures that only parameter attributes can be assigned to the
+// ast.ParamAttribute interface.
+func (*AttrInt) isParamAttribute() {}
+
+// AttrIntKind represents the kind of an integer attribute.
+type AttrIntKind int
+
+// Integer attributes.
+const (
+	AttrAlign                 AttrIntKind = iota + 1 // align
+	AttrAlignStack                                   // alignstack
+	AttrDereferenceable                              // dereferenceable
+	AttrDereferenceableOrNull                        // dereferenceable_or_null
+)
+
+// String returns the LLVM syntax representation of the integer attribute kind.
+func (kind AttrIntKind) String() string {
+	m := map[AttrIntKind]string{
+		AttrAlign:                 "align",
+		AttrAlignStack:            "alignstack",
+		AttrDereferenceable:       "dereferenceable",
+		AttrDereferenceableOrNull: "dereferenceable_or_null",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown integer attribute %d>", int(kind))
+}
+
+// AttrAllocSize represents an allocsize function attribute.
+type AttrAllocSize struct {
+	// Index of the parameter holding the element size in bytes.
+	ElemSize int64
+	// Index of the parameter holding the number of elements; or -1 if not
+	// present.
+	NumElems int64
+}
+
+// isFuncAttribute ensures that only function attributes can be assigned to the
+// ast.FuncAttribute interface.
+func (*AttrAllocSize) isFuncAttribute() {}
+
+// --- [ String attributes ] ---------------------------------------------------
+
+// AttrString represents a string attribute; i.e. a target-dependent key-value
+// attribute.
+type AttrString struct {
+	// Attribute key.
+	Key string
+	// Attribute value; or empty if not present.
+	Val string
+}
+
+// isFuncAttribute ensures that only function attributes can be assigned to the
+// ast.FuncAttribute interface.
+func (*AttrString) isFuncAttribute() {}
+
+// isParamAttribute ensures that only parameter attributes can be assigned to the
+// ast.ParamAttribute interface.
+func (*AttrString) isParamAttribute() {}
+
+// --- [ Attribute groups ] ----------------------------------------------------
+
+// An AttrGroupDef represents an attribute group definition.
+type AttrGroupDef struct {
+	// Attribute group ID.
+	ID int64
+	// Function attributes of the attribute group.
+	FuncAttrs []FuncAttribute
+}
+
+// AttrGroupID represents a reference to an attribute group, as identified by
+// its attribute group ID.
+type AttrGroupID int64
+
+// isFuncAttribute ensures that only function attributes can be assigned to the
+// ast.FuncAttribute interface.
+func (AttrGroupID) isFuncAttribute() {}
+
+// --- [ Function arguments ] --------------------------------------------------
+
+// An Arg represents a function argument of a call site with parameter
+// attributes.
+type Arg struct {
+	// Argument value.
+	Value Value
+	// Parameter attributes of the argument.
+	Attrs []ParamAttribute
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*Arg) isValue() {}
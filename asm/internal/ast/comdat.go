@@ -0,0 +1,38 @@
+package ast
+
+import "fmt"
+
+// A ComdatDef represents an LLVM IR comdat definition.
+type ComdatDef struct {
+	// Comdat name.
+	Name string
+	// Comdat selection kind.
+	Kind SelectionKind
+}
+
+// SelectionKind represents the selection kind of a comdat.
+type SelectionKind int
+
+// Comdat selection kinds.
+const (
+	SelectionAny          SelectionKind = iota // any
+	SelectionExactMatch                        // exactmatch
+	SelectionLargest                           // largest
+	SelectionNoDuplicates                      // noduplicates
+	SelectionSameSize                          // samesize
+)
+
+// String returns the LLVM syntax representation of the comdat selection kind.
+func (kind SelectionKind) String() string {
+	m := map[SelectionKind]string{
+		SelectionAny:          "any",
+		SelectionExactMatch:   "exactmatch",
+		SelectionLargest:      "largest",
+		SelectionNoDuplicates: "noduplicates",
+		SelectionSameSize:     "samesize",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown comdat selection kind %d>", int(kind))
+}
@@ -0,0 +1,19 @@
+package ast
+
+// BlockAddressConst represents a blockaddress constant.
+type BlockAddressConst struct {
+	// Constant type.
+	Type Type
+	// Parent function of the basic block.
+	Func NamedValue
+	// Basic block; resolved during translation to LLVM IR, as it may refer to a
+	// basic block of another function.
+	Block *LocalDummy
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*BlockAddressConst) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*BlockAddressConst) isConstant() {}
@@ -0,0 +1,22 @@
+package ast
+
+// UndefConst represents an undefined value constant.
+type UndefConst struct {
+	// Constant type.
+	Type Type
+}
+
+// PoisonConst represents a poison value constant.
+type PoisonConst struct {
+	// Constant type.
+	Type Type
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*UndefConst) isValue()  {}
+func (*PoisonConst) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*UndefConst) isConstant()  {}
+func (*PoisonConst) isConstant() {}
@@ -12,6 +12,7 @@ package ast
 //    *ast.IntConst
 //    *ast.FloatConst
 //    *ast.NullConst
+//    *ast.NoneConst
 //
 // Complex constants
 //
@@ -22,11 +23,26 @@ package ast
 //    *ast.StructConst
 //    *ast.ZeroInitializerConst
 //
-// Global variable and function addresses
+// Undefined values
+//
+// http://llvm.org/docs/LangRef.html#undefined-values
+//
+//    *ast.UndefConst
+//    *ast.PoisonConst
+//
+// Global variable, alias, IFunc and function addresses
 //
 //    *ast.Global
+//    *ast.Alias
+//    *ast.IFunc
 //    *ast.Function
 //
+// Addresses of basic blocks
+//
+// http://llvm.org/docs/LangRef.html#addresses-of-basic-blocks
+//
+//    *ast.BlockAddressConst
+//
 // Constant expressions
 //
 // http://llvm.org/docs/LangRef.html#constant-expressions
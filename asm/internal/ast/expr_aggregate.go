@@ -0,0 +1,55 @@
+package ast
+
+// --- [ extractvalue ] --------------------------------------------------------
+
+// ExprExtractValue represents an extractvalue expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#extractvalue-instruction
+type ExprExtractValue struct {
+	// Type of the constant expression.
+	Type Type
+	// Aggregate value.
+	X Constant
+	// Element indices.
+	Indices []int64
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ExprExtractValue) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*ExprExtractValue) isConstant() {}
+
+// isConstExpr ensures that only constant expressions can be assigned to the
+// ast.ConstExpr interface.
+func (*ExprExtractValue) isConstExpr() {}
+
+// --- [ insertvalue ] ---------------------------------------------------------
+
+// ExprInsertValue represents an insertvalue expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#insertvalue-instruction
+type ExprInsertValue struct {
+	// Type of the constant expression.
+	Type Type
+	// Aggregate value.
+	X Constant
+	// Element to insert.
+	Elem Constant
+	// Element indices.
+	Indices []int64
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ExprInsertValue) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*ExprInsertValue) isConstant() {}
+
+// isConstExpr ensures that only constant expressions can be assigned to the
+// ast.ConstExpr interface.
+func (*ExprInsertValue) isConstExpr() {}
@@ -18,6 +18,8 @@ type ExprAdd struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -66,6 +68,8 @@ type ExprSub struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -114,6 +118,8 @@ type ExprMul struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -162,6 +168,8 @@ type ExprUDiv struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Exact flag.
+	Exact bool
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -186,6 +194,8 @@ type ExprSDiv struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Exact flag.
+	Exact bool
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
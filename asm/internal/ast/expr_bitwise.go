@@ -18,6 +18,8 @@ type ExprShl struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -42,6 +44,8 @@ type ExprLShr struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Exact flag.
+	Exact bool
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
@@ -66,6 +70,8 @@ type ExprAShr struct {
 	Type Type
 	// Operands.
 	X, Y Constant
+	// Exact flag.
+	Exact bool
 }
 
 // isValue ensures that only values can be assigned to the ast.Value interface.
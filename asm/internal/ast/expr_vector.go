@@ -0,0 +1,81 @@
+package ast
+
+// --- [ extractelement ] ------------------------------------------------------
+
+// ExprExtractElement represents an extractelement expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#extractelement-instruction
+type ExprExtractElement struct {
+	// Type of the constant expression.
+	Type Type
+	// Vector.
+	X Constant
+	// Index.
+	Index Constant
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ExprExtractElement) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*ExprExtractElement) isConstant() {}
+
+// isConstExpr ensures that only constant expressions can be assigned to the
+// ast.ConstExpr interface.
+func (*ExprExtractElement) isConstExpr() {}
+
+// --- [ insertelement ] -------------------------------------------------------
+
+// ExprInsertElement represents an insertelement expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#insertelement-instruction
+type ExprInsertElement struct {
+	// Type of the constant expression.
+	Type Type
+	// Vector.
+	X Constant
+	// Element to insert.
+	Elem Constant
+	// Index.
+	Index Constant
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ExprInsertElement) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*ExprInsertElement) isConstant() {}
+
+// isConstExpr ensures that only constant expressions can be assigned to the
+// ast.ConstExpr interface.
+func (*ExprInsertElement) isConstExpr() {}
+
+// --- [ shufflevector ] -------------------------------------------------------
+
+// ExprShuffleVector represents a shufflevector expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#shufflevector-instruction
+type ExprShuffleVector struct {
+	// Type of the constant expression.
+	Type Type
+	// Vectors.
+	X, Y Constant
+	// Shuffle mask.
+	Mask Constant
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ExprShuffleVector) isValue() {}
+
+// isConstant ensures that only constants can be assigned to the ast.Constant
+// interface.
+func (*ExprShuffleVector) isConstant() {}
+
+// isConstExpr ensures that only constant expressions can be assigned to the
+// ast.ConstExpr interface.
+func (*ExprShuffleVector) isConstExpr() {}
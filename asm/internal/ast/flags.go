@@ -0,0 +1,53 @@
+package ast
+
+import "fmt"
+
+// OverflowFlag represents the set of overflow flags of add, sub, mul and shl
+// instructions and expressions.
+type OverflowFlag int
+
+// Overflow flags.
+const (
+	OverflowNUW OverflowFlag = iota + 1 // nuw: no unsigned wrap
+	OverflowNSW                         // nsw: no signed wrap
+)
+
+// String returns the LLVM syntax representation of the overflow flag.
+func (flag OverflowFlag) String() string {
+	m := map[OverflowFlag]string{
+		OverflowNUW: "nuw",
+		OverflowNSW: "nsw",
+	}
+	if s, ok := m[flag]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown overflow flag %d>", int(flag))
+}
+
+// FastMathFlag represents the set of fast-math flags of floating-point
+// instructions.
+type FastMathFlag int
+
+// Fast-math flags.
+const (
+	FastMathNNaN FastMathFlag = iota + 1 // nnan: no NaNs
+	FastMathNInf                         // ninf: no infinities
+	FastMathNSZ                          // nsz: no signed zeros
+	FastMathARcp                         // arcp: allow reciprocal
+	FastMathFast                         // fast: all fast-math flags
+)
+
+// String returns the LLVM syntax representation of the fast-math flag.
+func (flag FastMathFlag) String() string {
+	m := map[FastMathFlag]string{
+		FastMathNNaN: "nnan",
+		FastMathNInf: "ninf",
+		FastMathNSZ:  "nsz",
+		FastMathARcp: "arcp",
+		FastMathFast: "fast",
+	}
+	if s, ok := m[flag]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown fast-math flag %d>", int(flag))
+}
@@ -16,6 +16,32 @@ type Function struct {
 	Name string
 	// Function signature.
 	Sig *FuncType
+	// Personality function used for exception handling; or nil if not present.
+	Personality Constant
+	// Linkage type of the function.
+	Linkage Linkage
+	// Runtime preemption specifier of the function.
+	Preemption Preemption
+	// Visibility style of the function.
+	Visibility Visibility
+	// DLL storage class of the function.
+	DLLStorageClass DLLStorageClass
+	// Unnamed address specifier of the function.
+	UnnamedAddr UnnamedAddr
+	// Return value attributes of the function.
+	ReturnAttrs []ParamAttribute
+	// Function attributes of the function.
+	FuncAttrs []FuncAttribute
+	// Section name of the function; or empty if not present.
+	Section string
+	// Partition name of the function; or empty if not present.
+	Partition string
+	// Comdat name of the function; or empty if not present.
+	Comdat string
+	// Alignment in bytes of the function; or 0 if not present.
+	Align int
+	// Metadata attachments of the function.
+	Metadata []*MetadataAttachment
 	// Basic blocks of the function; or nil if defined externally.
 	Blocks []*BasicBlock
 }
@@ -69,20 +95,36 @@ func (f *Function) AssignIDs() {
 			if !ok {
 				continue
 			}
-			if inst, ok := inst.(*InstCall); ok {
-				if _, ok := inst.Type.(*VoidType); ok {
-					continue
-				}
-				if sig, ok := inst.Type.(*FuncType); ok {
-					if _, ok := sig.Ret.(*VoidType); ok {
-						continue
-					}
-				}
+			if inst, ok := inst.(*InstCall); ok && isVoidCallType(inst.Type) {
+				continue
 			}
 			// Assign local IDs to unnamed local variables.
 			setName(n)
 		}
+		// Assign local IDs to unnamed local variables produced by terminators.
+		switch term := block.Term.(type) {
+		case *TermInvoke:
+			if !isVoidCallType(term.Type) {
+				setName(term)
+			}
+		case *TermCatchSwitch:
+			setName(term)
+		}
+	}
+}
+
+// isVoidCallType reports whether the given type of a call or invoke
+// instruction denotes a void return.
+func isVoidCallType(typ Type) bool {
+	if _, ok := typ.(*VoidType); ok {
+		return true
+	}
+	if sig, ok := typ.(*FuncType); ok {
+		if _, ok := sig.Ret.(*VoidType); ok {
+			return true
+		}
 	}
+	return false
 }
 
 // isUnnamed reports whether the given identifier is unnamed.
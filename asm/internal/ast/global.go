@@ -11,6 +11,29 @@ type Global struct {
 	Init Constant
 	// Immutability of the global variable.
 	Immutable bool
+	// Linkage type of the global variable.
+	Linkage Linkage
+	// Runtime preemption specifier of the global variable.
+	Preemption Preemption
+	// Visibility style of the global variable.
+	Visibility Visibility
+	// DLL storage class of the global variable.
+	DLLStorageClass DLLStorageClass
+	// Thread-local storage model of the global variable; or TLSNone if not
+	// thread-local.
+	ThreadLocal ThreadLocalMode
+	// Unnamed address specifier of the global variable.
+	UnnamedAddr UnnamedAddr
+	// Section name of the global variable; or empty if not present.
+	Section string
+	// Partition name of the global variable; or empty if not present.
+	Partition string
+	// Comdat name of the global variable; or empty if not present.
+	Comdat string
+	// Alignment in bytes of the global variable; or 0 if not present.
+	Align int
+	// Metadata attachments of the global variable.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
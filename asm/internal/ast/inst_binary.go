@@ -18,6 +18,10 @@ type InstAdd struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -48,6 +52,10 @@ type InstFAdd struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -78,6 +86,10 @@ type InstSub struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -108,6 +120,10 @@ type InstFSub struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -138,6 +154,10 @@ type InstMul struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -168,6 +188,10 @@ type InstFMul struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -198,6 +222,10 @@ type InstUDiv struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Exact flag.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -228,6 +256,10 @@ type InstSDiv struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Exact flag.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -258,6 +290,10 @@ type InstFDiv struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -288,6 +324,8 @@ type InstURem struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -318,6 +356,8 @@ type InstSRem struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -348,6 +388,10 @@ type InstFRem struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -18,6 +18,10 @@ type InstShl struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -48,6 +52,10 @@ type InstLShr struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Exact flag.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -78,6 +86,10 @@ type InstAShr struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Exact flag.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -108,6 +120,8 @@ type InstAnd struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -138,6 +152,8 @@ type InstOr struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -168,6 +184,8 @@ type InstXor struct {
 	Name string
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -20,6 +20,8 @@ type InstTrunc struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -52,6 +54,8 @@ type InstZExt struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -84,6 +88,8 @@ type InstSExt struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -116,6 +122,8 @@ type InstFPTrunc struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -148,6 +156,8 @@ type InstFPExt struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -180,6 +190,8 @@ type InstFPToUI struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -212,6 +224,8 @@ type InstFPToSI struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -244,6 +258,8 @@ type InstUIToFP struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -276,6 +292,8 @@ type InstSIToFP struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -308,6 +326,8 @@ type InstPtrToInt struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -340,6 +360,8 @@ type InstIntToPtr struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -372,6 +394,8 @@ type InstBitCast struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -404,6 +428,8 @@ type InstAddrSpaceCast struct {
 	From Value
 	// Type after conversion.
 	To Type
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -1,5 +1,7 @@
 package ast
 
+import "fmt"
+
 // --- [ alloca ] --------------------------------------------------------------
 
 // InstAlloca represents an alloca instruction.
@@ -13,6 +15,8 @@ type InstAlloca struct {
 	Elem Type
 	// Number of elements; or nil if one element.
 	NElems Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -38,6 +42,16 @@ type InstLoad struct {
 	Elem Type
 	// Source address.
 	Src Value
+	// Volatile load.
+	Volatile bool
+	// Atomic memory ordering constraints; or OrderingNone if not atomic.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Memory alignment in bytes; or 0 if unspecified.
+	Align int
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -61,14 +75,178 @@ type InstStore struct {
 	Src Value
 	// Destination address.
 	Dst Value
+	// Volatile store.
+	Volatile bool
+	// Atomic memory ordering constraints; or OrderingNone if not atomic.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Memory alignment in bytes; or 0 if unspecified.
+	Align int
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // --- [ fence ] ---------------------------------------------------------------
 
+// InstFence represents a fence instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#fence-instruction
+type InstFence struct {
+	// Atomic memory ordering constraints.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// AtomicOrdering represents the set of atomic memory ordering constraints.
+type AtomicOrdering int
+
+// Atomic memory ordering constraints.
+const (
+	OrderingNone      AtomicOrdering = iota // not atomic
+	OrderingUnordered                       // unordered
+	OrderingMonotonic                       // monotonic
+	OrderingAcquire                         // acquire
+	OrderingRelease                         // release
+	OrderingAcqRel                          // acq_rel: acquire and release
+	OrderingSeqCst                          // seq_cst: sequentially consistent
+)
+
+// String returns the LLVM syntax representation of the atomic memory ordering
+// constraint.
+func (ordering AtomicOrdering) String() string {
+	m := map[AtomicOrdering]string{
+		OrderingNone:      "notatomic",
+		OrderingUnordered: "unordered",
+		OrderingMonotonic: "monotonic",
+		OrderingAcquire:   "acquire",
+		OrderingRelease:   "release",
+		OrderingAcqRel:    "acq_rel",
+		OrderingSeqCst:    "seq_cst",
+	}
+	if s, ok := m[ordering]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown atomic memory ordering %d>", int(ordering))
+}
+
 // --- [ cmpxchg ] -------------------------------------------------------------
 
+// InstCmpXchg represents a cmpxchg instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cmpxchg-instruction
+type InstCmpXchg struct {
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Address.
+	Ptr Value
+	// Value to compare against.
+	Cmp Value
+	// New value to store.
+	New Value
+	// Atomic memory ordering constraints on success.
+	Success AtomicOrdering
+	// Atomic memory ordering constraints on failure.
+	Failure AtomicOrdering
+	// Weak cmpxchg; may fail spuriously.
+	Weak bool
+	// Volatile cmpxchg.
+	Volatile bool
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (inst *InstCmpXchg) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the value.
+func (inst *InstCmpXchg) SetName(name string) {
+	inst.Name = name
+}
+
 // --- [ atomicrmw ] -----------------------------------------------------------
 
+// InstAtomicRMW represents an atomicrmw instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#atomicrmw-instruction
+type InstAtomicRMW struct {
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Atomic operation.
+	Op AtomicOp
+	// Address.
+	Ptr Value
+	// Operand.
+	X Value
+	// Atomic memory ordering constraints.
+	Ordering AtomicOrdering
+	// Volatile atomicrmw.
+	Volatile bool
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (inst *InstAtomicRMW) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the value.
+func (inst *InstAtomicRMW) SetName(name string) {
+	inst.Name = name
+}
+
+// AtomicOp represents the set of operations of the atomicrmw instruction.
+type AtomicOp int
+
+// Atomic operations.
+const (
+	AtomicXchg AtomicOp = iota + 1 // xchg: exchange
+	AtomicAdd                      // add: addition
+	AtomicSub                      // sub: subtraction
+	AtomicAnd                      // and: bitwise AND
+	AtomicNand                     // nand: bitwise NAND
+	AtomicOr                       // or: bitwise OR
+	AtomicXor                      // xor: bitwise XOR
+	AtomicMax                      // max: signed maximum
+	AtomicMin                      // min: signed minimum
+	AtomicUMax                     // umax: unsigned maximum
+	AtomicUMin                     // umin: unsigned minimum
+)
+
+// String returns the LLVM syntax representation of the atomic operation.
+func (op AtomicOp) String() string {
+	m := map[AtomicOp]string{
+		AtomicXchg: "xchg",
+		AtomicAdd:  "add",
+		AtomicSub:  "sub",
+		AtomicAnd:  "and",
+		AtomicNand: "nand",
+		AtomicOr:   "or",
+		AtomicXor:  "xor",
+		AtomicMax:  "max",
+		AtomicMin:  "min",
+		AtomicUMax: "umax",
+		AtomicUMin: "umin",
+	}
+	if s, ok := m[op]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown atomic operation %d>", int(op))
+}
+
 // --- [ getelementptr ] -------------------------------------------------------
 
 // InstGetElementPtr represents a getelementptr instruction.
@@ -84,6 +262,8 @@ type InstGetElementPtr struct {
 	Src Value
 	// Element indices.
 	Indices []Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -100,6 +280,9 @@ func (inst *InstGetElementPtr) SetName(name string) {
 func (*InstAlloca) isValue()        {}
 func (*InstLoad) isValue()          {}
 func (*InstStore) isValue()         {}
+func (*InstFence) isValue()         {}
+func (*InstCmpXchg) isValue()       {}
+func (*InstAtomicRMW) isValue()     {}
 func (*InstGetElementPtr) isValue() {}
 
 // isInst ensures that only instructions can be assigned to the ast.Instruction
@@ -107,4 +290,7 @@ func (*InstGetElementPtr) isValue() {}
 func (*InstAlloca) isInst()        {}
 func (*InstLoad) isInst()          {}
 func (*InstStore) isInst()         {}
+func (*InstFence) isInst()         {}
+func (*InstCmpXchg) isInst()       {}
+func (*InstAtomicRMW) isInst()     {}
 func (*InstGetElementPtr) isInst() {}
@@ -1,5 +1,7 @@
 package ast
 
+import "fmt"
+
 // --- [ icmp ] ----------------------------------------------------------------
 
 // InstICmp represents an icmp instruction.
@@ -13,6 +15,8 @@ type InstICmp struct {
 	Cond IntPred
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -38,6 +42,10 @@ type InstFCmp struct {
 	Cond FloatPred
 	// Operands.
 	X, Y Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -63,6 +71,8 @@ type InstPhi struct {
 	Type Type
 	// Incoming values.
 	Incs []*Incoming
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -96,6 +106,8 @@ type InstSelect struct {
 	Cond Value
 	// Operands.
 	X, Y Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -123,6 +135,14 @@ type InstCall struct {
 	Callee NamedValue
 	// Function arguments.
 	Args []Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Return value attributes of the call site.
+	ReturnAttrs []ParamAttribute
+	// Function attributes of the call site.
+	FuncAttrs []FuncAttribute
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
 }
 
 // GetName returns the name of the value.
@@ -139,21 +159,134 @@ func (inst *InstCall) SetName(name string) {
 
 // --- [ landingpad ] ----------------------------------------------------------
 
+// InstLandingPad represents a landingpad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#landingpad-instruction
+type InstLandingPad struct {
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Result type.
+	Type Type
+	// Specifies whether the landing pad is a cleanup landing pad.
+	Cleanup bool
+	// Landing pad clauses.
+	Clauses []*Clause
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (inst *InstLandingPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the value.
+func (inst *InstLandingPad) SetName(name string) {
+	inst.Name = name
+}
+
+// Clause represents a clause of a landingpad instruction.
+type Clause struct {
+	// Clause kind.
+	Kind ClauseKind
+	// Type info of a catch clause, or array of type infos of a filter clause.
+	X Constant
+}
+
+// ClauseKind represents the set of clause kinds of the landingpad instruction.
+type ClauseKind int
+
+// Landing pad clause kinds.
+const (
+	ClauseCatch  ClauseKind = iota + 1 // catch
+	ClauseFilter                       // filter
+)
+
+// String returns the LLVM syntax representation of the clause kind.
+func (kind ClauseKind) String() string {
+	m := map[ClauseKind]string{
+		ClauseCatch:  "catch",
+		ClauseFilter: "filter",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown clause kind %d>", int(kind))
+}
+
 // --- [ catchpad ] ------------------------------------------------------------
 
+// InstCatchPad represents a catchpad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchpad-instruction
+type InstCatchPad struct {
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Parent catchswitch terminator.
+	CatchSwitch NamedValue
+	// Exception arguments.
+	Args []Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (inst *InstCatchPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the value.
+func (inst *InstCatchPad) SetName(name string) {
+	inst.Name = name
+}
+
 // --- [ cleanuppad ] ----------------------------------------------------------
 
+// InstCleanupPad represents a cleanuppad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cleanuppad-instruction
+type InstCleanupPad struct {
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Parent exception pad; or a none token constant if not nested within
+	// another exception pad.
+	ParentPad Value
+	// Exception arguments.
+	Args []Value
+	// Metadata attachments of the instruction.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (inst *InstCleanupPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the value.
+func (inst *InstCleanupPad) SetName(name string) {
+	inst.Name = name
+}
+
 // isValue ensures that only values can be assigned to the ast.Value interface.
-func (*InstICmp) isValue()   {}
-func (*InstFCmp) isValue()   {}
-func (*InstPhi) isValue()    {}
-func (*InstSelect) isValue() {}
-func (*InstCall) isValue()   {}
+func (*InstICmp) isValue()       {}
+func (*InstFCmp) isValue()       {}
+func (*InstPhi) isValue()        {}
+func (*InstSelect) isValue()     {}
+func (*InstCall) isValue()       {}
+func (*InstLandingPad) isValue() {}
+func (*InstCatchPad) isValue()   {}
+func (*InstCleanupPad) isValue() {}
 
 // isInst ensures that only instructions can be assigned to the ast.Instruction
 // interface.
-func (*InstICmp) isInst()   {}
-func (*InstFCmp) isInst()   {}
-func (*InstPhi) isInst()    {}
-func (*InstSelect) isInst() {}
-func (*InstCall) isInst()   {}
+func (*InstICmp) isInst()       {}
+func (*InstFCmp) isInst()       {}
+func (*InstPhi) isInst()        {}
+func (*InstSelect) isInst()     {}
+func (*InstCall) isInst()       {}
+func (*InstLandingPad) isInst() {}
+func (*InstCatchPad) isInst()   {}
+func (*InstCleanupPad) isInst() {}
@@ -32,6 +32,21 @@ package ast
 //    *ast.InstOr
 //    *ast.InstXor
 //
+// Vector instructions
+//
+// http://llvm.org/docs/LangRef.html#vector-operations
+//
+//    *ast.InstExtractElement
+//    *ast.InstInsertElement
+//    *ast.InstShuffleVector
+//
+// Aggregate instructions
+//
+// http://llvm.org/docs/LangRef.html#aggregate-operations
+//
+//    *ast.InstExtractValue
+//    *ast.InstInsertValue
+//
 // Memory instructions
 //
 // http://llvm.org/docs/LangRef.html#memory-access-and-addressing-operations
@@ -39,6 +54,9 @@ package ast
 //    *ast.InstAlloca
 //    *ast.InstLoad
 //    *ast.InstStore
+//    *ast.InstFence
+//    *ast.InstCmpXchg
+//    *ast.InstAtomicRMW
 //    *ast.InstGetElementPtr
 //
 // Conversion instructions
@@ -68,6 +86,9 @@ package ast
 //    *ast.InstPhi
 //    *ast.InstSelect
 //    *ast.InstCall
+//    *ast.InstLandingPad
+//    *ast.InstCatchPad
+//    *ast.InstCleanupPad
 type Instruction interface {
 	// isInst ensures that only instructions can be assigned to the
 	// ast.Instruction interface.
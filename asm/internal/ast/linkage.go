@@ -0,0 +1,167 @@
+package ast
+
+import "fmt"
+
+// Linkage represents the linkage type of a global variable or function.
+type Linkage int
+
+// Linkage types.
+const (
+	LinkageExternal            Linkage = iota // external
+	LinkagePrivate                            // private
+	LinkageInternal                           // internal
+	LinkageAvailableExternally                // available_externally
+	LinkageLinkOnce                           // linkonce
+	LinkageWeak                               // weak
+	LinkageCommon                             // common
+	LinkageAppending                          // appending
+	LinkageExternWeak                         // extern_weak
+	LinkageLinkOnceODR                        // linkonce_odr
+	LinkageWeakODR                            // weak_odr
+)
+
+// String returns the LLVM syntax representation of the linkage type.
+func (linkage Linkage) String() string {
+	m := map[Linkage]string{
+		LinkageExternal:            "external",
+		LinkagePrivate:             "private",
+		LinkageInternal:            "internal",
+		LinkageAvailableExternally: "available_externally",
+		LinkageLinkOnce:            "linkonce",
+		LinkageWeak:                "weak",
+		LinkageCommon:              "common",
+		LinkageAppending:           "appending",
+		LinkageExternWeak:          "extern_weak",
+		LinkageLinkOnceODR:         "linkonce_odr",
+		LinkageWeakODR:             "weak_odr",
+	}
+	if s, ok := m[linkage]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown linkage type %d>", int(linkage))
+}
+
+// Preemption represents the runtime preemption specifier of a global variable
+// or function; i.e. whether it may be replaced by a symbol from outside the
+// linkage unit.
+type Preemption int
+
+// Runtime preemption specifiers.
+const (
+	PreemptionDSOPreemptable Preemption = iota // dso_preemptable
+	PreemptionDSOLocal                         // dso_local
+)
+
+// String returns the LLVM syntax representation of the runtime preemption
+// specifier.
+func (preemption Preemption) String() string {
+	m := map[Preemption]string{
+		PreemptionDSOPreemptable: "dso_preemptable",
+		PreemptionDSOLocal:       "dso_local",
+	}
+	if s, ok := m[preemption]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown runtime preemption specifier %d>", int(preemption))
+}
+
+// Visibility represents the visibility style of a global variable or function.
+type Visibility int
+
+// Visibility styles.
+const (
+	VisibilityDefault   Visibility = iota // default
+	VisibilityHidden                      // hidden
+	VisibilityProtected                   // protected
+)
+
+// String returns the LLVM syntax representation of the visibility style.
+func (visibility Visibility) String() string {
+	m := map[Visibility]string{
+		VisibilityDefault:   "default",
+		VisibilityHidden:    "hidden",
+		VisibilityProtected: "protected",
+	}
+	if s, ok := m[visibility]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown visibility style %d>", int(visibility))
+}
+
+// DLLStorageClass represents the DLL storage class of a global variable or
+// function.
+type DLLStorageClass int
+
+// DLL storage classes.
+const (
+	DLLStorageNone   DLLStorageClass = iota // no DLL storage class
+	DLLStorageImport                        // dllimport
+	DLLStorageExport                        // dllexport
+)
+
+// String returns the LLVM syntax representation of the DLL storage class.
+func (class DLLStorageClass) String() string {
+	m := map[DLLStorageClass]string{
+		DLLStorageNone:   "none",
+		DLLStorageImport: "dllimport",
+		DLLStorageExport: "dllexport",
+	}
+	if s, ok := m[class]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown DLL storage class %d>", int(class))
+}
+
+// ThreadLocalMode represents the thread-local storage model of a global
+// variable.
+type ThreadLocalMode int
+
+// Thread-local storage models.
+const (
+	TLSNone           ThreadLocalMode = iota // not thread-local
+	TLSGeneralDynamic                        // thread_local
+	TLSLocalDynamic                          // thread_local(localdynamic)
+	TLSInitialExec                           // thread_local(initialexec)
+	TLSLocalExec                             // thread_local(localexec)
+)
+
+// String returns the LLVM syntax representation of the thread-local storage
+// model.
+func (mode ThreadLocalMode) String() string {
+	m := map[ThreadLocalMode]string{
+		TLSNone:           "none",
+		TLSGeneralDynamic: "thread_local",
+		TLSLocalDynamic:   "thread_local(localdynamic)",
+		TLSInitialExec:    "thread_local(initialexec)",
+		TLSLocalExec:      "thread_local(localexec)",
+	}
+	if s, ok := m[mode]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown thread-local storage model %d>", int(mode))
+}
+
+// UnnamedAddr specifies whether the address of a global variable or function
+// is significant.
+type UnnamedAddr int
+
+// Unnamed address specifiers.
+const (
+	UnnamedAddrNone   UnnamedAddr = iota // address is significant
+	UnnamedAddrLocal                     // local_unnamed_addr
+	UnnamedAddrGlobal                    // unnamed_addr
+)
+
+// String returns the LLVM syntax representation of the unnamed address
+// specifier.
+func (addr UnnamedAddr) String() string {
+	m := map[UnnamedAddr]string{
+		UnnamedAddrNone:   "none",
+		UnnamedAddrLocal:  "local_unnamed_addr",
+		UnnamedAddrGlobal: "unnamed_addr",
+	}
+	if s, ok := m[addr]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown unnamed address specifier %d>", int(addr))
+}
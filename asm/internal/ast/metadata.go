@@ -0,0 +1,618 @@
+package ast
+
+// Metadata represents a metadata node or a metadata value.
+//
+// Metadata may have one of the following underlying types.
+//
+//    ast.MetadataNode
+//    ast.MetadataID
+//    *ast.MDString
+//    *ast.ValueAsMetadata
+type Metadata interface {
+	Value
+	// isMetadata ensures that only metadata can be assigned to the
+	// ast.Metadata interface.
+	isMetadata()
+}
+
+// --- [ Metadata nodes ] ------------------------------------------------------
+
+// MetadataNode represents a metadata node, which may be defined at the
+// top-level of a module.
+//
+// MetadataNode may have one of the following underlying types.
+//
+//    *ast.MDNode
+//    *ast.DICompileUnit
+//    *ast.DIFile
+//    *ast.DISubprogram
+//    *ast.DILocation
+//    *ast.DILocalVariable
+//    *ast.DIBasicType
+//    *ast.DICompositeType
+//    *ast.DIDerivedType
+//    *ast.DISubroutineType
+//    *ast.DILexicalBlock
+//    *ast.DISubrange
+//    *ast.DIGlobalVariable
+//    *ast.DIGlobalVariableExpression
+//    *ast.DIExpression
+type MetadataNode interface {
+	Metadata
+	// isMetadataNode ensures that only metadata nodes can be assigned to the
+	// ast.MetadataNode interface.
+	isMetadataNode()
+}
+
+// A MetadataDef represents a metadata node definition.
+type MetadataDef struct {
+	// Metadata ID.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Metadata node.
+	Node MetadataNode
+}
+
+// An MDNode represents a metadata node; i.e. a tuple of metadata operands.
+type MDNode struct {
+	// Metadata operands; a nil operand represents null.
+	Nodes []Metadata
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*MDNode) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*MDNode) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*MDNode) isMetadataNode() {}
+
+// MetadataID represents a reference to a metadata node, as identified by its
+// metadata ID.
+type MetadataID int64
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (MetadataID) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (MetadataID) isMetadata() {}
+
+// --- [ Metadata strings ] ----------------------------------------------------
+
+// An MDString represents a metadata string.
+type MDString struct {
+	// String value.
+	Val string
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*MDString) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*MDString) isMetadata() {}
+
+// --- [ Metadata values ] -----------------------------------------------------
+
+// A ValueAsMetadata represents an LLVM IR value used as metadata.
+type ValueAsMetadata struct {
+	// Wrapped value.
+	Value Value
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*ValueAsMetadata) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*ValueAsMetadata) isMetadata() {}
+
+// --- [ Specialized metadata nodes ] ------------------------------------------
+
+// A DICompileUnit represents a compile unit debug information metadata node.
+type DICompileUnit struct {
+	// Source language; e.g. DW_LANG_C99.
+	Language string
+	// Source file.
+	File Metadata
+	// Producer.
+	Producer string
+	// Optimized.
+	IsOptimized bool
+	// Command line flags of the producer.
+	Flags string
+	// Objective-C runtime version.
+	RuntimeVersion int64
+	// Split debug filename.
+	SplitDebugFilename string
+	// Emission kind; e.g. FullDebug.
+	EmissionKind string
+	// Enum types; or nil if not present.
+	Enums Metadata
+	// Retained types; or nil if not present.
+	RetainedTypes Metadata
+	// Global variables; or nil if not present.
+	Globals Metadata
+	// Imported entities; or nil if not present.
+	Imports Metadata
+	// Split DWARF object ID.
+	DwoID int64
+	// Name table kind; e.g. None.
+	NameTableKind string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DICompileUnit) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DICompileUnit) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DICompileUnit) isMetadataNode() {}
+
+// A DIFile represents a source file debug information metadata node.
+type DIFile struct {
+	// Source filename.
+	Filename string
+	// Source directory.
+	Directory string
+	// Checksum kind; e.g. CSK_MD5.
+	ChecksumKind string
+	// Checksum of the source file.
+	Checksum string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIFile) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIFile) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIFile) isMetadataNode() {}
+
+// A DISubprogram represents a subprogram debug information metadata node.
+type DISubprogram struct {
+	// Source name of the subprogram.
+	Name string
+	// Linkage name of the subprogram.
+	LinkageName string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Subprogram type; or nil if not present.
+	Sig Metadata
+	// Local to the compile unit.
+	IsLocal bool
+	// Definition.
+	IsDefinition bool
+	// Source line of the subprogram scope.
+	ScopeLine int64
+	// Debug information flags.
+	Flags []string
+	// Subprogram flags.
+	SPFlags []string
+	// Optimized.
+	IsOptimized bool
+	// Compile unit; or nil if not present.
+	Unit Metadata
+	// Declaration of the subprogram; or nil if not present.
+	Declaration Metadata
+	// Retained nodes; or nil if not present.
+	RetainedNodes Metadata
+	// Fields not modelled by the node type (e.g. virtualIndex), in order of
+	// appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DISubprogram) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DISubprogram) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DISubprogram) isMetadataNode() {}
+
+// A DILocation represents a source location debug information metadata node.
+type DILocation struct {
+	// Source line.
+	Line int64
+	// Source column.
+	Column int64
+	// Enclosing scope.
+	Scope Metadata
+	// Source location of the call site into which the scope was inlined; or nil
+	// if not present.
+	InlinedAt Metadata
+	// Implicit code generated by the compiler.
+	IsImplicitCode bool
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DILocation) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DILocation) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DILocation) isMetadataNode() {}
+
+// A DILocalVariable represents a local variable debug information metadata
+// node.
+type DILocalVariable struct {
+	// Source name of the local variable.
+	Name string
+	// Argument number of function parameters; or 0 if not a parameter.
+	Arg int64
+	// Enclosing scope.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Source type of the variable; or nil if not present.
+	VarType Metadata
+	// Debug information flags.
+	Flags []string
+	// Alignment in bits.
+	Align int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DILocalVariable) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DILocalVariable) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DILocalVariable) isMetadataNode() {}
+
+// A DIBasicType represents a basic type debug information metadata node.
+type DIBasicType struct {
+	// DWARF tag.
+	Tag string
+	// Source name of the type.
+	Name string
+	// Size in bits.
+	Size int64
+	// Alignment in bits.
+	Align int64
+	// DWARF attribute type encoding.
+	Encoding string
+	// Debug information flags.
+	Flags []string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIBasicType) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIBasicType) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIBasicType) isMetadataNode() {}
+
+// A DICompositeType represents a composite type debug information metadata
+// node.
+type DICompositeType struct {
+	// DWARF tag.
+	Tag string
+	// Source name of the type.
+	Name string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Base type; or nil if not present.
+	BaseType Metadata
+	// Size in bits.
+	Size int64
+	// Alignment in bits.
+	Align int64
+	// Offset in bits.
+	Offset int64
+	// Debug information flags.
+	Flags []string
+	// Elements; or nil if not present.
+	Elements Metadata
+	// Type containing the vtable pointer; or nil if not present.
+	VtableHolder Metadata
+	// Template parameters; or nil if not present.
+	TemplateParams Metadata
+	// Unique identifier of the type.
+	Identifier string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DICompositeType) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DICompositeType) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DICompositeType) isMetadataNode() {}
+
+// A DIDerivedType represents a derived type debug information metadata node.
+type DIDerivedType struct {
+	// DWARF tag.
+	Tag string
+	// Source name of the type.
+	Name string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Base type; or nil if not present.
+	BaseType Metadata
+	// Size in bits.
+	Size int64
+	// Alignment in bits.
+	Align int64
+	// Offset in bits.
+	Offset int64
+	// Debug information flags.
+	Flags []string
+	// Extra data; or nil if not present.
+	ExtraData Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIDerivedType) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIDerivedType) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIDerivedType) isMetadataNode() {}
+
+// A DISubroutineType represents a subroutine type debug information metadata
+// node.
+type DISubroutineType struct {
+	// Debug information flags.
+	Flags []string
+	// Return type followed by parameter types; or nil if not present.
+	Types Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DISubroutineType) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DISubroutineType) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DISubroutineType) isMetadataNode() {}
+
+// A DILexicalBlock represents a lexical block debug information metadata node.
+type DILexicalBlock struct {
+	// Enclosing scope.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Source column.
+	Column int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DILexicalBlock) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DILexicalBlock) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DILexicalBlock) isMetadataNode() {}
+
+// A DISubrange represents an array subrange debug information metadata node.
+type DISubrange struct {
+	// Number of elements.
+	Count int64
+	// Variable holding the number of elements (e.g. of a variable length
+	// array); or nil if the number of elements is constant.
+	CountVar Metadata
+	// Lower bound of the subrange.
+	LowerBound int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DISubrange) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DISubrange) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DISubrange) isMetadataNode() {}
+
+// A DIGlobalVariable represents a global variable debug information metadata
+// node.
+type DIGlobalVariable struct {
+	// Source name of the global variable.
+	Name string
+	// Linkage name of the global variable.
+	LinkageName string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file; or nil if not present.
+	File Metadata
+	// Source line.
+	Line int64
+	// Source type of the variable; or nil if not present.
+	VarType Metadata
+	// Local to the compile unit.
+	IsLocal bool
+	// Definition.
+	IsDefinition bool
+	// Declaration of the static data member; or nil if not present.
+	Declaration Metadata
+	// Alignment in bits.
+	Align int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIGlobalVariable) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIGlobalVariable) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIGlobalVariable) isMetadataNode() {}
+
+// A DIGlobalVariableExpression represents a global variable expression debug
+// information metadata node; i.e. the pairing of a global variable and the
+// DWARF expression computing its location.
+type DIGlobalVariableExpression struct {
+	// Global variable.
+	Var Metadata
+	// DWARF expression.
+	Expr Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIGlobalVariableExpression) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIGlobalVariableExpression) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIGlobalVariableExpression) isMetadataNode() {}
+
+// A DIExpression represents a DWARF expression debug information metadata
+// node.
+type DIExpression struct {
+	// DWARF operations and their operands.
+	Elems []DIExpressionElem
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*DIExpression) isValue() {}
+
+// isMetadata ensures that only metadata can be assigned to the ast.Metadata
+// interface.
+func (*DIExpression) isMetadata() {}
+
+// isMetadataNode ensures that only metadata nodes can be assigned to the
+// ast.MetadataNode interface.
+func (*DIExpression) isMetadataNode() {}
+
+// DIExpressionElem is a DWARF operation or an operand of a DWARF expression.
+//
+// DIExpressionElem may have one of the following underlying types.
+//
+//    ast.DwarfOp
+//    ast.Uint
+type DIExpressionElem interface {
+	// isDIExpressionElem ensures that only DWARF operations and operands can be
+	// assigned to the ast.DIExpressionElem interface.
+	isDIExpressionElem()
+}
+
+// DwarfOp is a DWARF operation of a DWARF expression; e.g. DW_OP_deref.
+type DwarfOp string
+
+// isDIExpressionElem ensures that only DWARF operations and operands can be
+// assigned to the ast.DIExpressionElem interface.
+func (DwarfOp) isDIExpressionElem() {}
+
+// Uint is an unsigned integer operand of a DWARF expression.
+type Uint uint64
+
+// isDIExpressionElem ensures that only DWARF operations and operands can be
+// assigned to the ast.DIExpressionElem interface.
+func (Uint) isDIExpressionElem() {}
+
+// A DIField represents a field of a specialized metadata node which is not
+// modelled by the node type; e.g. `virtualIndex: 2`.
+type DIField struct {
+	// Field name; e.g. virtualIndex.
+	Name string
+	// Metadata value; or nil if the field holds a literal value or null.
+	MD Metadata
+	// Literal value in LLVM syntax (e.g. 2, "foo" or DW_VIRTUALITY_virtual); or
+	// null.
+	Lit string
+}
+
+// --- [ Named metadata ] ------------------------------------------------------
+
+// A NamedMetadataDef represents a named metadata definition.
+type NamedMetadataDef struct {
+	// Metadata name.
+	Name string
+	// Metadata IDs of the metadata nodes.
+	IDs []MetadataID
+}
+
+// --- [ Metadata attachments ] ------------------------------------------------
+
+// A MetadataAttachment represents a metadata attachment of an instruction, a
+// global variable or a function.
+type MetadataAttachment struct {
+	// Metadata kind name.
+	Name string
+	// Metadata ID of the attached metadata node.
+	ID MetadataID
+}
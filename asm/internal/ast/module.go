@@ -5,12 +5,32 @@
 package ast
 
 // A Module represents an LLVM IR module, which consists of top-level type
-// definitions, global variables, functions, and metadata.
+// definitions, global variables, aliases, IFuncs, functions, and metadata.
 type Module struct {
+	// Source filename of the module; or empty if not present.
+	SourceFilename string
+	// Data layout of the target; or empty if not present.
+	DataLayout string
+	// Target triple of the module; or empty if not present.
+	TargetTriple string
+	// Module-level inline assembly, one line per entry.
+	ModuleAsm []string
 	// Type definitions.
 	Types []*NamedType
+	// Comdat definitions.
+	Comdats []*ComdatDef
 	// Global variables of the module.
 	Globals []*Global
+	// Aliases of the module.
+	Aliases []*Alias
+	// IFuncs of the module.
+	IFuncs []*IFunc
 	// Functions of the module.
 	Funcs []*Function
+	// Attribute group definitions of the module.
+	AttrGroupDefs []*AttrGroupDef
+	// Named metadata definitions of the module.
+	NamedMetadataDefs []*NamedMetadataDef
+	// Metadata node definitions of the module.
+	MetadataDefs []*MetadataDef
 }
@@ -12,6 +12,12 @@ package ast
 //    *ast.TermBr
 //    *ast.TermCondBr
 //    *ast.TermSwitch
+//    *ast.TermIndirectBr
+//    *ast.TermInvoke
+//    *ast.TermResume
+//    *ast.TermCatchSwitch
+//    *ast.TermCatchRet
+//    *ast.TermCleanupRet
 //    *ast.TermUnreachable
 type Terminator interface {
 	// isTerm ensures that only terminators can be assigned to the ast.Terminator
@@ -28,6 +34,8 @@ type Terminator interface {
 type TermRet struct {
 	// Return value; or nil if "void" return.
 	X Value
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
 }
 
 // --- [ br ] ------------------------------------------------------------------
@@ -39,6 +47,8 @@ type TermRet struct {
 type TermBr struct {
 	// Target branch.
 	Target NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
 }
 
 // --- [ conditional br ] ------------------------------------------------------
@@ -54,6 +64,8 @@ type TermCondBr struct {
 	TargetTrue NamedValue
 	// Target branch when condition is false.
 	TargetFalse NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
 }
 
 // --- [ switch ] --------------------------------------------------------------
@@ -69,6 +81,8 @@ type TermSwitch struct {
 	TargetDefault NamedValue
 	// Switch cases.
 	Cases []*Case
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
 }
 
 // Case represents a case of a switch terminator.
@@ -81,16 +95,131 @@ type Case struct {
 
 // --- [ indirectbr ] ----------------------------------------------------------
 
+// TermIndirectBr represents an indirectbr terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#indirectbr-instruction
+type TermIndirectBr struct {
+	// Target address.
+	Addr Value
+	// List of possible destinations of the target address.
+	ValidTargets []NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
 // --- [ invoke ] --------------------------------------------------------------
 
+// TermInvoke represents an invoke terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#invoke-instruction
+type TermInvoke struct {
+	// Name of the local variable associated with the terminator.
+	Name string
+	// Type of the terminator; or callee type signature.
+	Type Type
+	// Callee.
+	Callee NamedValue
+	// Function arguments.
+	Args []Value
+	// Target branch when the callee returns normally.
+	TargetNormal NamedValue
+	// Target branch when the callee unwinds through an exception.
+	TargetUnwind NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (term *TermInvoke) GetName() string {
+	return term.Name
+}
+
+// SetName sets the name of the value.
+func (term *TermInvoke) SetName(name string) {
+	term.Name = name
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*TermInvoke) isValue() {}
+
 // --- [ resume ] --------------------------------------------------------------
 
+// TermResume represents a resume terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#resume-instruction
+type TermResume struct {
+	// Exception value to propagate.
+	X Value
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
 // --- [ catchswitch ] ---------------------------------------------------------
 
+// TermCatchSwitch represents a catchswitch terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchswitch-instruction
+type TermCatchSwitch struct {
+	// Name of the local variable associated with the terminator.
+	Name string
+	// Parent exception pad; or a none token constant if not nested within
+	// another exception pad.
+	ParentPad Value
+	// Exception handlers.
+	Handlers []NamedValue
+	// Target branch when no handler matches; or nil if unwinding to the caller.
+	UnwindTarget NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
+// GetName returns the name of the value.
+func (term *TermCatchSwitch) GetName() string {
+	return term.Name
+}
+
+// SetName sets the name of the value.
+func (term *TermCatchSwitch) SetName(name string) {
+	term.Name = name
+}
+
+// isValue ensures that only values can be assigned to the ast.Value interface.
+func (*TermCatchSwitch) isValue() {}
+
 // --- [ catchret ] ------------------------------------------------------------
 
+// TermCatchRet represents a catchret terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchret-instruction
+type TermCatchRet struct {
+	// Exited catchpad instruction.
+	CatchPad NamedValue
+	// Target branch.
+	Target NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
 // --- [ cleanupret ] ----------------------------------------------------------
 
+// TermCleanupRet represents a cleanupret terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cleanupret-instruction
+type TermCleanupRet struct {
+	// Exited cleanuppad instruction.
+	CleanupPad NamedValue
+	// Target branch to unwind to; or nil if unwinding to the caller.
+	UnwindTarget NamedValue
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
+}
+
 // --- [ unreachable ] ---------------------------------------------------------
 
 // TermUnreachable represents an unreachable terminator.
@@ -98,6 +227,8 @@ type Case struct {
 // References:
 //    http://llvm.org/docs/LangRef.html#unreachable-instruction
 type TermUnreachable struct {
+	// Metadata attachments of the terminator.
+	Metadata []*MetadataAttachment
 }
 
 // isTerm ensures that only terminators can be assigned to the ast.Terminator
@@ -106,4 +237,10 @@ func (*TermRet) isTerm()         {}
 func (*TermBr) isTerm()          {}
 func (*TermCondBr) isTerm()      {}
 func (*TermSwitch) isTerm()      {}
+func (*TermIndirectBr) isTerm()  {}
+func (*TermInvoke) isTerm()      {}
+func (*TermResume) isTerm()      {}
+func (*TermCatchSwitch) isTerm() {}
+func (*TermCatchRet) isTerm()    {}
+func (*TermCleanupRet) isTerm()  {}
 func (*TermUnreachable) isTerm() {}
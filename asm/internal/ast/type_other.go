@@ -30,6 +30,8 @@ type Param struct {
 	Name string
 	// Parameter type.
 	Type Type
+	// Parameter attributes.
+	Attrs []ParamAttribute
 }
 
 // GetName returns the name of the value.
@@ -60,6 +62,15 @@ type LabelType struct {
 type MetadataType struct {
 }
 
+// --- [ token ] ---------------------------------------------------------------
+
+// TokenType represents a token type.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#token-type
+type TokenType struct {
+}
+
 // isValue ensures that only values can be assigned to the ast.Value interface.
 func (*Param) isValue() {}
 
@@ -68,3 +79,4 @@ func (*VoidType) isType()     {}
 func (*FuncType) isType()     {}
 func (*LabelType) isType()    {}
 func (*MetadataType) isType() {}
+func (*TokenType) isType()    {}
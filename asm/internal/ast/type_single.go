@@ -35,6 +35,7 @@ const (
 	FloatKindIEEE_128                           // fp128:     128-bit floating point type (112-bit mantissa)
 	FloatKindDoubleExtended_80                  // x86_fp80:  80-bit floating point type (x87)
 	FloatKindDoubleDouble_128                   // ppc_fp128: 128-bit floating point type (two 64-bits, PowerPC)
+	FloatKindBrain_16                           // bfloat:    16-bit brain floating point type (7-bit mantissa)
 )
 
 // String returns the LLVM syntax representation of the floating-point kind.
@@ -52,6 +53,8 @@ func (kind FloatKind) String() string {
 		return "x86_fp80"
 	case FloatKindDoubleDouble_128:
 		return "ppc_fp128"
+	case FloatKindBrain_16:
+		return "bfloat"
 	}
 	return fmt.Sprintf("<unknown floating-point kind %d>", int(kind))
 }
@@ -78,8 +81,19 @@ type PointerType struct {
 type VectorType struct {
 	// Element type.
 	Elem Type
-	// Vector length.
+	// Vector length; or the minimum vector length if scalable.
 	Len int64
+	// Scalable vector type.
+	Scalable bool
+}
+
+// --- [ x86_mmx ] -------------------------------------------------------------
+
+// MMXType represents an x86_mmx type.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#x86-mmx-type
+type MMXType struct {
 }
 
 // isType ensures that only types can be assigned to the ast.Type interface.
@@ -87,3 +101,4 @@ func (*IntType) isType()     {}
 func (*FloatType) isType()   {}
 func (*PointerType) isType() {}
 func (*VectorType) isType()  {}
+func (*MMXType) isType()     {}
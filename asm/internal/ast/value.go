@@ -7,6 +7,8 @@ package ast
 //
 //    ast.Constant
 //    ast.NamedValue
+//    *ast.Arg
+//    ast.Metadata
 type Value interface {
 	// isValue ensures that only values can be assigned to the ast.Value
 	// interface.
@@ -18,6 +20,8 @@ type Value interface {
 // NamedValue may have one of the following underlying types.
 //
 //    *ast.Global
+//    *ast.Alias
+//    *ast.IFunc
 //    *ast.GlobalDummy
 //    *ast.Function
 //    *ast.Param
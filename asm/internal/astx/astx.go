@@ -37,12 +37,32 @@ func NewModule(decls interface{}) (*ast.Module, error) {
 	m := &ast.Module{}
 	for _, d := range ds {
 		switch d := d.(type) {
+		case *SourceFilename:
+			m.SourceFilename = d.name
+		case *DataLayout:
+			m.DataLayout = d.layout
+		case *TargetTriple:
+			m.TargetTriple = d.triple
+		case *ModuleAsm:
+			m.ModuleAsm = append(m.ModuleAsm, d.asm)
 		case *ast.NamedType:
 			m.Types = append(m.Types, d)
+		case *ast.ComdatDef:
+			m.Comdats = append(m.Comdats, d)
 		case *ast.Global:
 			m.Globals = append(m.Globals, d)
+		case *ast.Alias:
+			m.Aliases = append(m.Aliases, d)
+		case *ast.IFunc:
+			m.IFuncs = append(m.IFuncs, d)
 		case *ast.Function:
 			m.Funcs = append(m.Funcs, d)
+		case *ast.AttrGroupDef:
+			m.AttrGroupDefs = append(m.AttrGroupDefs, d)
+		case *ast.NamedMetadataDef:
+			m.NamedMetadataDefs = append(m.NamedMetadataDefs, d)
+		case *ast.MetadataDef:
+			m.MetadataDefs = append(m.MetadataDefs, d)
 		default:
 			dbg.Printf("support for %T not yet implemented", d)
 		}
@@ -57,7 +77,7 @@ type TopLevelDecl interface{}
 // NewTopLevelDeclList returns a new top-level declaration list based on the
 // given top-level declaration.
 func NewTopLevelDeclList(decl interface{}) ([]TopLevelDecl, error) {
-	// Skip ignored top-level declaration; e.g. "source_filename".
+	// Skip ignored top-level declaration.
 	if decl == nil {
 		return []TopLevelDecl{}, nil
 	}
@@ -75,7 +95,7 @@ func AppendTopLevelDecl(decls, decl interface{}) ([]TopLevelDecl, error) {
 	if !ok {
 		return nil, errors.Errorf("invalid top-level declaration list type; expected []astx.TopLevelDecl, got %T", decls)
 	}
-	// Skip ignored top-level declaration; e.g. "source_filename".
+	// Skip ignored top-level declaration.
 	if decl == nil {
 		return ds, nil
 	}
@@ -86,6 +106,76 @@ func AppendTopLevelDecl(decls, decl interface{}) ([]TopLevelDecl, error) {
 	return append(ds, d), nil
 }
 
+// === [ Source filename ] =====================================================
+
+// SourceFilename represents a source filename top-level declaration.
+type SourceFilename struct {
+	// Source filename.
+	name string
+}
+
+// NewSourceFilename returns a new source filename top-level declaration based
+// on the given string literal.
+func NewSourceFilename(name interface{}) (*SourceFilename, error) {
+	s, err := getStringLit(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &SourceFilename{name: s}, nil
+}
+
+// === [ Target specifiers ] ===================================================
+
+// DataLayout represents a target data layout top-level declaration.
+type DataLayout struct {
+	// Data layout string.
+	layout string
+}
+
+// NewDataLayout returns a new target data layout top-level declaration based
+// on the given string literal.
+func NewDataLayout(layout interface{}) (*DataLayout, error) {
+	s, err := getStringLit(layout)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &DataLayout{layout: s}, nil
+}
+
+// TargetTriple represents a target triple top-level declaration.
+type TargetTriple struct {
+	// Target triple string.
+	triple string
+}
+
+// NewTargetTriple returns a new target triple top-level declaration based on
+// the given string literal.
+func NewTargetTriple(triple interface{}) (*TargetTriple, error) {
+	s, err := getStringLit(triple)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &TargetTriple{triple: s}, nil
+}
+
+// === [ Module-level inline assembly ] ========================================
+
+// ModuleAsm represents a module-level inline assembly top-level declaration.
+type ModuleAsm struct {
+	// Line of assembly.
+	asm string
+}
+
+// NewModuleAsm returns a new module-level inline assembly top-level declaration
+// based on the given string literal.
+func NewModuleAsm(asm interface{}) (*ModuleAsm, error) {
+	s, err := getStringLit(asm)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ModuleAsm{asm: s}, nil
+}
+
 // === [ Type definitions ] ====================================================
 
 // NewTypeDef returns a new type definition based on the given type name and
@@ -113,11 +203,29 @@ func NewTypeDefOpaque(name interface{}) (*ast.NamedType, error) {
 	return &ast.NamedType{Name: n.name, Def: t}, nil
 }
 
+// === [ Comdat definitions ] ==================================================
+
+// NewComdatDef returns a new comdat definition based on the given comdat name
+// and selection kind.
+func NewComdatDef(name, kind interface{}) (*ast.ComdatDef, error) {
+	n, ok := name.(*ComdatIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid comdat name type; expected *astx.ComdatIdent, got %T", name)
+	}
+	k, ok := kind.(ast.SelectionKind)
+	if !ok {
+		return nil, errors.Errorf("invalid comdat selection kind type; expected ast.SelectionKind, got %T", kind)
+	}
+	return &ast.ComdatDef{Name: n.name, Kind: k}, nil
+}
+
 // === [ Global variables ] ====================================================
 
 // NewGlobalDecl returns a new global variable declaration based on the given
-// global variable name, immutability and type.
-func NewGlobalDecl(name, immutable, typ interface{}) (*ast.Global, error) {
+// global variable name, linkage type, runtime preemption specifier, visibility
+// style, DLL storage class, thread-local storage model, unnamed address
+// specifier, immutability, type and global variable attributes.
+func NewGlobalDecl(name, linkage, preemption, visibility, dllStorageClass, threadLocal, unnamedAddr, immutable, typ, attrs interface{}) (*ast.Global, error) {
 	n, ok := name.(*GlobalIdent)
 	if !ok {
 		return nil, errors.Errorf("invalid global name type; expected *astx.GlobalIdent, got %T", name)
@@ -130,14 +238,49 @@ func NewGlobalDecl(name, immutable, typ interface{}) (*ast.Global, error) {
 	if !ok {
 		return nil, errors.Errorf("invalid content type; expected ast.Type, got %T", typ)
 	}
+	l, err := getLinkage(linkage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p, err := getPreemption(preemption)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	v, err := getVisibility(visibility)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	d, err := getDLLStorageClass(dllStorageClass)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tls, err := getThreadLocal(threadLocal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	u, err := getUnnamedAddr(unnamedAddr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	global := &ast.Global{Name: n.name, Content: t}
 	global.Immutable = imm
+	global.Linkage = l
+	global.Preemption = p
+	global.Visibility = v
+	global.DLLStorageClass = d
+	global.ThreadLocal = tls
+	global.UnnamedAddr = u
+	if err := setGlobalAttrs(global, attrs); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	return global, nil
 }
 
 // NewGlobalDef returns a new global variable definition based on the given
-// global variable name, immutability, type and value.
-func NewGlobalDef(name, immutable, typ, val interface{}) (*ast.Global, error) {
+// global variable name, linkage type, runtime preemption specifier, visibility
+// style, DLL storage class, thread-local storage model, unnamed address
+// specifier, immutability, type, value and global variable attributes.
+func NewGlobalDef(name, linkage, preemption, visibility, dllStorageClass, threadLocal, unnamedAddr, immutable, typ, val, attrs interface{}) (*ast.Global, error) {
 	n, ok := name.(*GlobalIdent)
 	if !ok {
 		return nil, errors.Errorf("invalid global name type; expected *astx.GlobalIdent, got %T", name)
@@ -158,16 +301,278 @@ func NewGlobalDef(name, immutable, typ, val interface{}) (*ast.Global, error) {
 	if !ok {
 		return nil, errors.Errorf("invalid init type; expected ast.Constant, got %T", init)
 	}
+	l, err := getLinkage(linkage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p, err := getPreemption(preemption)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	v, err := getVisibility(visibility)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	d, err := getDLLStorageClass(dllStorageClass)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tls, err := getThreadLocal(threadLocal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	u, err := getUnnamedAddr(unnamedAddr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	global := &ast.Global{Name: n.name, Content: t, Init: i}
 	global.Immutable = imm
+	global.Linkage = l
+	global.Preemption = p
+	global.Visibility = v
+	global.DLLStorageClass = d
+	global.ThreadLocal = tls
+	global.UnnamedAddr = u
+	if err := setGlobalAttrs(global, attrs); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	return global, nil
 }
 
+// GlobalAttr represents a global variable attribute; i.e. a section, partition,
+// comdat or alignment.
+type GlobalAttr interface{}
+
+// NewGlobalAttrList returns a new global variable attribute list based on the
+// given global variable attribute.
+func NewGlobalAttrList(attr interface{}) ([]GlobalAttr, error) {
+	return []GlobalAttr{attr}, nil
+}
+
+// AppendGlobalAttr appends the given global variable attribute to the global
+// variable attribute list.
+func AppendGlobalAttr(attrs, attr interface{}) ([]GlobalAttr, error) {
+	as, ok := attrs.([]GlobalAttr)
+	if !ok {
+		return nil, errors.Errorf("invalid global variable attribute list type; expected []astx.GlobalAttr, got %T", attrs)
+	}
+	return append(as, attr), nil
+}
+
+// setGlobalAttrs sets the section, partition, comdat, alignment and metadata
+// attachments of the given global variable based on the given optional global
+// variable attribute list.
+func setGlobalAttrs(global *ast.Global, attrs interface{}) error {
+	var as []GlobalAttr
+	switch attrs := attrs.(type) {
+	case []GlobalAttr:
+		as = attrs
+	case nil:
+		// no global variable attributes.
+	default:
+		return errors.Errorf("invalid global variable attribute list type; expected []astx.GlobalAttr or nil, got %T", attrs)
+	}
+	for _, attr := range as {
+		switch attr := attr.(type) {
+		case *Section:
+			global.Section = attr.name
+		case *Partition:
+			global.Partition = attr.name
+		case *Comdat:
+			global.Comdat = attr.name
+			if attr.implicit {
+				global.Comdat = global.Name
+			}
+		case *Alignment:
+			global.Align = attr.n
+		case *ast.MetadataAttachment:
+			global.Metadata = append(global.Metadata, attr)
+		default:
+			return errors.Errorf("invalid global variable attribute type; expected *astx.Section, *astx.Partition, *astx.Comdat, *astx.Alignment or *ast.MetadataAttachment, got %T", attr)
+		}
+	}
+	return nil
+}
+
+// Section represents a section specifier.
+type Section struct {
+	// Section name.
+	name string
+}
+
+// NewSection returns a new section specifier based on the given string
+// literal token.
+func NewSection(name interface{}) (*Section, error) {
+	s, err := getStringLit(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Section{name: s}, nil
+}
+
+// Partition represents a partition specifier.
+type Partition struct {
+	// Partition name.
+	name string
+}
+
+// NewPartition returns a new partition specifier based on the given string
+// literal token.
+func NewPartition(name interface{}) (*Partition, error) {
+	s, err := getStringLit(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Partition{name: s}, nil
+}
+
+// Comdat represents a comdat specifier.
+type Comdat struct {
+	// Comdat name.
+	name string
+	// Comdat name is implicitly that of the global variable or function.
+	implicit bool
+}
+
+// NewComdat returns a new comdat specifier based on the given optional comdat
+// name.
+func NewComdat(name interface{}) (*Comdat, error) {
+	switch name := name.(type) {
+	case *ComdatIdent:
+		return &Comdat{name: name.name}, nil
+	case nil:
+		// comdat name implied by global variable or function name.
+		return &Comdat{implicit: true}, nil
+	default:
+		return nil, errors.Errorf("invalid comdat name type; expected *astx.ComdatIdent or nil, got %T", name)
+	}
+}
+
+// Alignment represents an alignment specifier.
+type Alignment struct {
+	// Alignment in bytes.
+	n int
+}
+
+// NewAlignment returns a new alignment specifier based on the given integer
+// literal token.
+func NewAlignment(align interface{}) (*Alignment, error) {
+	n, err := getAlign(align)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Alignment{n: n}, nil
+}
+
+// === [ Aliases ] =============================================================
+
+// NewAlias returns a new global alias based on the given alias name, linkage
+// type, runtime preemption specifier, visibility style, DLL storage class,
+// thread-local storage model, unnamed address specifier, content type and
+// aliasee.
+func NewAlias(name, linkage, preemption, visibility, dllStorageClass, threadLocal, unnamedAddr, content, aliaseeTyp, aliasee interface{}) (*ast.Alias, error) {
+	n, ok := name.(*GlobalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid alias name type; expected *astx.GlobalIdent, got %T", name)
+	}
+	t, ok := content.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid content type; expected ast.Type, got %T", content)
+	}
+	a, err := NewConstant(aliaseeTyp, aliasee)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	l, err := getLinkage(linkage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p, err := getPreemption(preemption)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	v, err := getVisibility(visibility)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	d, err := getDLLStorageClass(dllStorageClass)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tls, err := getThreadLocal(threadLocal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	u, err := getUnnamedAddr(unnamedAddr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	alias := &ast.Alias{
+		Name:            n.name,
+		Content:         t,
+		Aliasee:         a,
+		Linkage:         l,
+		Preemption:      p,
+		Visibility:      v,
+		DLLStorageClass: d,
+		ThreadLocal:     tls,
+		UnnamedAddr:     u,
+	}
+	return alias, nil
+}
+
+// === [ IFuncs ] ==============================================================
+
+// NewIFunc returns a new indirect function based on the given IFunc name,
+// linkage type, runtime preemption specifier, visibility style, content type
+// and resolver function.
+func NewIFunc(name, linkage, preemption, visibility, content, resolverTyp, resolver interface{}) (*ast.IFunc, error) {
+	n, ok := name.(*GlobalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid IFunc name type; expected *astx.GlobalIdent, got %T", name)
+	}
+	t, ok := content.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid content type; expected ast.Type, got %T", content)
+	}
+	r, err := NewConstant(resolverTyp, resolver)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	l, err := getLinkage(linkage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p, err := getPreemption(preemption)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	v, err := getVisibility(visibility)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	ifunc := &ast.IFunc{
+		Name:       n.name,
+		Content:    t,
+		Resolver:   r,
+		Linkage:    l,
+		Preemption: p,
+		Visibility: v,
+	}
+	return ifunc, nil
+}
+
 // === [ Functions ] ===========================================================
 
 // NewFunctionDecl returns a new function declaration based on the given
-// return type, function name and parameters.
-func NewFunctionDecl(ret, name, params interface{}) (*ast.Function, error) {
+// linkage type, runtime preemption specifier, visibility style, DLL storage
+// class, thread-local storage model, return value attributes, return type, function name, parameters,
+// unnamed address specifier, function attributes, section, partition, comdat
+// and alignment.
+//
+// Functions may not be thread-local; the thread-local storage model is only
+// parsed to report a descriptive error.
+func NewFunctionDecl(linkage, preemption, visibility, dllStorageClass, threadLocal, retAttrs, ret, name, params, unnamedAddr, funcAttrs, section, partition, comdat, align interface{}) (*ast.Function, error) {
 	r, ok := ret.(ast.Type)
 	if !ok {
 		return nil, errors.Errorf("invalid function return type; expected ast.Type, got %T", ret)
@@ -176,6 +581,9 @@ func NewFunctionDecl(ret, name, params interface{}) (*ast.Function, error) {
 	if !ok {
 		return nil, errors.Errorf("invalid function name type; expected *astx.GlobalIdent, got %T", name)
 	}
+	if threadLocal != nil {
+		return nil, errors.Errorf("invalid thread-local storage model of function %s; functions may not be thread-local", enc.Global(n.name))
+	}
 	sig := &ast.FuncType{Ret: r}
 	switch ps := params.(type) {
 	case *Params:
@@ -188,20 +596,115 @@ func NewFunctionDecl(ret, name, params interface{}) (*ast.Function, error) {
 	default:
 		return nil, errors.Errorf("invalid function parameters type; expected *astx.Params or nil, got %T", params)
 	}
+	l, err := getLinkage(linkage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p, err := getPreemption(preemption)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	v, err := getVisibility(visibility)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	d, err := getDLLStorageClass(dllStorageClass)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	u, err := getUnnamedAddr(unnamedAddr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	ras, err := getParamAttrs(retAttrs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	fas, err := getFuncAttrs(funcAttrs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	a, err := getAlign(align)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	f := &ast.Function{
-		Name: n.name,
-		Sig:  sig,
+		Name:            n.name,
+		Sig:             sig,
+		Linkage:         l,
+		Preemption:      p,
+		Visibility:      v,
+		DLLStorageClass: d,
+		UnnamedAddr:     u,
+		ReturnAttrs:     ras,
+		FuncAttrs:       fas,
+		Align:           a,
+	}
+	switch section := section.(type) {
+	case *Section:
+		f.Section = section.name
+	case nil:
+		// no section.
+	default:
+		return nil, errors.Errorf("invalid section type; expected *astx.Section or nil, got %T", section)
+	}
+	switch partition := partition.(type) {
+	case *Partition:
+		f.Partition = partition.name
+	case nil:
+		// no partition.
+	default:
+		return nil, errors.Errorf("invalid partition type; expected *astx.Partition or nil, got %T", partition)
+	}
+	switch comdat := comdat.(type) {
+	case *Comdat:
+		f.Comdat = comdat.name
+		if comdat.implicit {
+			f.Comdat = f.Name
+		}
+	case nil:
+		// no comdat.
+	default:
+		return nil, errors.Errorf("invalid comdat type; expected *astx.Comdat or nil, got %T", comdat)
+	}
+	return f, nil
+}
+
+// NewExternalFunctionDecl returns a new external function declaration based on
+// the given metadata attachments and function header.
+func NewExternalFunctionDecl(mds, header interface{}) (*ast.Function, error) {
+	f, ok := header.(*ast.Function)
+	if !ok {
+		return nil, errors.Errorf("invalid function header type; expected *ast.Function, got %T", header)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
+	f.Metadata = md
 	return f, nil
 }
 
 // NewFunctionDef returns a new function definition based on the given function
-// header and body.
-func NewFunctionDef(header, body interface{}) (*ast.Function, error) {
+// header, optional personality function, metadata attachments and body.
+func NewFunctionDef(header, personality, mds, body interface{}) (*ast.Function, error) {
 	f, ok := header.(*ast.Function)
 	if !ok {
 		return nil, errors.Errorf("invalid function header type; expected *ast.Function, got %T", header)
 	}
+	switch personality := personality.(type) {
+	case ast.Constant:
+		f.Personality = personality
+	case nil:
+		// no personality function.
+	default:
+		return nil, errors.Errorf("invalid personality function type; expected ast.Constant or nil, got %T", personality)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	f.Metadata = md
 	blocks, ok := body.([]*ast.BasicBlock)
 	if !ok {
 		return nil, errors.Errorf("invalid function body type; expected []*ast.BasicBlock, got %T", body)
@@ -254,13 +757,17 @@ func AppendParam(params, param interface{}) ([]*ast.Param, error) {
 	return append(ps, p), nil
 }
 
-// NewParam returns a new function parameter based on the given parameter type
-// and name.
-func NewParam(typ, name interface{}) (*ast.Param, error) {
+// NewParam returns a new function parameter based on the given parameter type,
+// parameter attributes and name.
+func NewParam(typ, attrs, name interface{}) (*ast.Param, error) {
 	t, ok := typ.(ast.Type)
 	if !ok {
 		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
 	}
+	as, err := getParamAttrs(attrs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	var n string
 	switch name := name.(type) {
 	case *LocalIdent:
@@ -270,1485 +777,3682 @@ func NewParam(typ, name interface{}) (*ast.Param, error) {
 	default:
 		return nil, errors.Errorf("invalid local name type; expected *astx.LocalIdent or nil, got %T", name)
 	}
-	return &ast.Param{Name: n, Type: t}, nil
+	return &ast.Param{Name: n, Type: t, Attrs: as}, nil
 }
 
-// === [ Identifiers ] =========================================================
-
-// GlobalIdent represents a global identifier.
-type GlobalIdent struct {
-	// Global identifier name the without "@" prefix.
-	name string
-}
+// === [ Attributes ] ==========================================================
 
-// NewGlobalIdent returns a new global identifier based on the given global
-// identifier token.
-func NewGlobalIdent(ident interface{}) (*GlobalIdent, error) {
-	s, err := getTokenString(ident)
+// NewAttrGroupDef returns a new attribute group definition based on the given
+// attribute group ID and function attributes.
+func NewAttrGroupDef(id, attrs interface{}) (*ast.AttrGroupDef, error) {
+	i, ok := id.(ast.AttrGroupID)
+	if !ok {
+		return nil, errors.Errorf("invalid attribute group ID type; expected ast.AttrGroupID, got %T", id)
+	}
+	as, err := getFuncAttrs(attrs)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	if !strings.HasPrefix(s, "@") {
-		return nil, errors.Errorf(`invalid global identifier %q; missing "@" prefix`, s)
-	}
-	s = s[1:]
-	return &GlobalIdent{name: s}, nil
+	return &ast.AttrGroupDef{ID: int64(i), FuncAttrs: as}, nil
 }
 
-// LocalIdent represents a local identifier.
-type LocalIdent struct {
-	// Local identifier name the without "%" prefix.
-	name string
+// NewFuncAttrList returns a new function attribute list based on the given
+// function attribute.
+func NewFuncAttrList(attr interface{}) ([]ast.FuncAttribute, error) {
+	a, ok := attr.(ast.FuncAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid function attribute type; expected ast.FuncAttribute, got %T", attr)
+	}
+	return []ast.FuncAttribute{a}, nil
 }
 
-// NewLocalIdent returns a new local identifier based on the given local
-// identifier token.
-func NewLocalIdent(ident interface{}) (*LocalIdent, error) {
-	s, err := getTokenString(ident)
+// AppendFuncAttr appends the given function attribute to the function
+// attribute list.
+func AppendFuncAttr(attrs, attr interface{}) ([]ast.FuncAttribute, error) {
+	as, ok := attrs.([]ast.FuncAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid function attribute list type; expected []ast.FuncAttribute, got %T", attrs)
+	}
+	a, ok := attr.(ast.FuncAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid function attribute type; expected ast.FuncAttribute, got %T", attr)
+	}
+	return append(as, a), nil
+}
+
+// NewParamAttrList returns a new parameter attribute list based on the given
+// parameter attribute.
+func NewParamAttrList(attr interface{}) ([]ast.ParamAttribute, error) {
+	a, ok := attr.(ast.ParamAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid parameter attribute type; expected ast.ParamAttribute, got %T", attr)
+	}
+	return []ast.ParamAttribute{a}, nil
+}
+
+// AppendParamAttr appends the given parameter attribute to the parameter
+// attribute list.
+func AppendParamAttr(attrs, attr interface{}) ([]ast.ParamAttribute, error) {
+	as, ok := attrs.([]ast.ParamAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid parameter attribute list type; expected []ast.ParamAttribute, got %T", attrs)
+	}
+	a, ok := attr.(ast.ParamAttribute)
+	if !ok {
+		return nil, errors.Errorf("invalid parameter attribute type; expected ast.ParamAttribute, got %T", attr)
+	}
+	return append(as, a), nil
+}
+
+// NewAttrInt returns a new integer attribute based on the given integer
+// attribute kind and integer literal token.
+func NewAttrInt(kind ast.AttrIntKind, n interface{}) (*ast.AttrInt, error) {
+	s, err := getTokenString(n)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	if !strings.HasPrefix(s, "%") {
-		return nil, errors.Errorf(`invalid local identifier %q; missing "%%" prefix`, s)
+	x, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	s = s[1:]
-	return &LocalIdent{name: s}, nil
+	return &ast.AttrInt{Kind: kind, N: x}, nil
 }
 
-// LabelIdent represents a label identifier.
-type LabelIdent struct {
-	// Label identifier name the without ":" suffix.
-	name string
+// NewAttrAllocSize returns a new allocsize attribute based on the given element
+// size and optional number of elements integer literal tokens.
+func NewAttrAllocSize(elemSize, numElems interface{}) (*ast.AttrAllocSize, error) {
+	s, err := getTokenString(elemSize)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	attr := &ast.AttrAllocSize{ElemSize: e, NumElems: -1}
+	if numElems != nil {
+		s, err := getTokenString(numElems)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		attr.NumElems = n
+	}
+	return attr, nil
 }
 
-// NewLabelIdent returns a new label identifier based on the given label
-// identifier token.
-func NewLabelIdent(ident interface{}) (*LabelIdent, error) {
-	s, err := getTokenString(ident)
+// NewAttrString returns a new string attribute based on the given key and
+// optional value string literal tokens.
+func NewAttrString(key, val interface{}) (*ast.AttrString, error) {
+	k, err := getStringLit(key)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	if !strings.HasSuffix(s, ":") {
-		return nil, errors.Errorf(`invalid label identifier %q; missing ":" suffix`, s)
+	attr := &ast.AttrString{Key: k}
+	if val != nil {
+		v, err := getStringLit(val)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		attr.Val = v
 	}
-	s = s[:len(s)-1]
-	return &LabelIdent{name: s}, nil
+	return attr, nil
 }
 
-// === [ Types ] ===============================================================
+// === [ Metadata ] ============================================================
 
-// NewTypeList returns a new type list based on the given type.
-func NewTypeList(typ interface{}) ([]ast.Type, error) {
-	t, ok := typ.(ast.Type)
+// NewNamedMetadataDef returns a new named metadata definition based on the
+// given metadata name and optional metadata IDs.
+func NewNamedMetadataDef(name, ids interface{}) (*ast.NamedMetadataDef, error) {
+	n, ok := name.(*MetadataName)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
+		return nil, errors.Errorf("invalid metadata name type; expected *astx.MetadataName, got %T", name)
 	}
-	return []ast.Type{t}, nil
+	var is []ast.MetadataID
+	switch ids := ids.(type) {
+	case []ast.MetadataID:
+		is = ids
+	case nil:
+		// no metadata IDs.
+	default:
+		return nil, errors.Errorf("invalid metadata ID list type; expected []ast.MetadataID or nil, got %T", ids)
+	}
+	return &ast.NamedMetadataDef{Name: n.name, IDs: is}, nil
 }
 
-// AppendType appends the given type to the type list.
-func AppendType(typs, typ interface{}) ([]ast.Type, error) {
-	ts, ok := typs.([]ast.Type)
+// NewMetadataIDList returns a new metadata ID list based on the given metadata
+// ID.
+func NewMetadataIDList(id interface{}) ([]ast.MetadataID, error) {
+	i, ok := id.(ast.MetadataID)
 	if !ok {
-		return nil, errors.Errorf("invalid type list type; expected []ast.Type, got %T", typs)
+		return nil, errors.Errorf("invalid metadata ID type; expected ast.MetadataID, got %T", id)
 	}
-	t, ok := typ.(ast.Type)
+	return []ast.MetadataID{i}, nil
+}
+
+// AppendMetadataID appends the given metadata ID to the metadata ID list.
+func AppendMetadataID(ids, id interface{}) ([]ast.MetadataID, error) {
+	is, ok := ids.([]ast.MetadataID)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
+		return nil, errors.Errorf("invalid metadata ID list type; expected []ast.MetadataID, got %T", ids)
 	}
-	return append(ts, t), nil
+	i, ok := id.(ast.MetadataID)
+	if !ok {
+		return nil, errors.Errorf("invalid metadata ID type; expected ast.MetadataID, got %T", id)
+	}
+	return append(is, i), nil
 }
 
-// NewIntType returns a new integer type based on the given integer type token.
-func NewIntType(typeTok interface{}) (*ast.IntType, error) {
-	s, err := getTokenString(typeTok)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	if !strings.HasPrefix(s, "i") {
-		return nil, errors.Errorf(`invalid integer type %q; missing "i" prefix`, s)
+// NewMetadataDef returns a new metadata node definition based on the given
+// metadata ID, distinct flag and metadata node.
+func NewMetadataDef(id, distinct, node interface{}) (*ast.MetadataDef, error) {
+	i, ok := id.(ast.MetadataID)
+	if !ok {
+		return nil, errors.Errorf("invalid metadata ID type; expected ast.MetadataID, got %T", id)
 	}
-	s = s[1:]
-	size, err := strconv.Atoi(s)
+	d, err := getFlag(distinct)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.IntType{Size: size}, nil
-}
-
-// NewFuncType returns a new function type based on the given return type and
-// function parameters.
-func NewFuncType(ret, params interface{}) (*ast.FuncType, error) {
-	r, ok := ret.(ast.Type)
+	n, ok := node.(ast.MetadataNode)
 	if !ok {
-		return nil, errors.Errorf("invalid function return type; expected ast.Type, got %T", ret)
+		return nil, errors.Errorf("invalid metadata node type; expected ast.MetadataNode, got %T", node)
 	}
-	sig := &ast.FuncType{Ret: r}
-	switch ps := params.(type) {
-	case *Params:
-		for _, param := range ps.params {
-			sig.Params = append(sig.Params, param)
-		}
-		sig.Variadic = ps.variadic
+	return &ast.MetadataDef{ID: int64(i), Distinct: d, Node: n}, nil
+}
+
+// NewMDNode returns a new inline metadata node based on the given optional
+// metadata operands.
+func NewMDNode(nodes interface{}) (*ast.MDNode, error) {
+	var ns []ast.Metadata
+	switch nodes := nodes.(type) {
+	case []ast.Metadata:
+		ns = nodes
 	case nil:
-		// no parameters.
+		// no metadata operands.
 	default:
-		return nil, errors.Errorf("invalid function parameters type; expected *astx.Params or nil, got %T", params)
+		return nil, errors.Errorf("invalid metadata operand list type; expected []ast.Metadata or nil, got %T", nodes)
 	}
-	return sig, nil
+	return &ast.MDNode{Nodes: ns}, nil
 }
 
-// NewPointerType returns a new pointer type based on the given element type.
-func NewPointerType(elem, space interface{}) (*ast.PointerType, error) {
-	e, ok := elem.(ast.Type)
+// NewMetadataList returns a new metadata operand list based on the given
+// metadata operand; a nil operand represents null.
+func NewMetadataList(node interface{}) ([]ast.Metadata, error) {
+	n, err := getMetadata(node)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return []ast.Metadata{n}, nil
+}
+
+// AppendMetadata appends the given metadata operand to the metadata operand
+// list; a nil operand represents null.
+func AppendMetadata(nodes, node interface{}) ([]ast.Metadata, error) {
+	ns, ok := nodes.([]ast.Metadata)
 	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+		return nil, errors.Errorf("invalid metadata operand list type; expected []ast.Metadata, got %T", nodes)
 	}
-	t := &ast.PointerType{Elem: e}
-	switch space := space.(type) {
-	case *AddrSpace:
-		t.AddrSpace = space.space
-	case nil:
-		// no address space.
-	default:
-		return nil, errors.Errorf("invalid address space type; expected *astx.AddrSpace or nil, got %T", space)
+	n, err := getMetadata(node)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return t, nil
+	return append(ns, n), nil
 }
 
-// AddrSpace represents the address space of a pointer type.
-type AddrSpace struct {
-	// Address space.
-	space int64
+// NewMDString returns a new metadata string based on the given string literal.
+func NewMDString(val interface{}) (*ast.MDString, error) {
+	v, err := getStringLit(val)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.MDString{Val: v}, nil
 }
 
-// NewAddrSpace returns a new address space pointer based on the given space.
-func NewAddrSpace(space interface{}) (*AddrSpace, error) {
-	s, err := getInt64(space)
+// NewValueAsMetadata returns a new metadata value based on the given type and
+// value.
+func NewValueAsMetadata(typ, val interface{}) (*ast.ValueAsMetadata, error) {
+	v, err := NewValue(typ, val)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &AddrSpace{space: s}, nil
+	return &ast.ValueAsMetadata{Value: v}, nil
 }
 
-// NewVectorType returns a new vector type based on the given vector length and
-// element type.
-func NewVectorType(len, elem interface{}) (*ast.VectorType, error) {
-	e, ok := elem.(ast.Type)
+// NewMetadataAttachmentList returns a new metadata attachment list based on the
+// given metadata attachment.
+func NewMetadataAttachmentList(md interface{}) ([]*ast.MetadataAttachment, error) {
+	m, ok := md.(*ast.MetadataAttachment)
 	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+		return nil, errors.Errorf("invalid metadata attachment type; expected *ast.MetadataAttachment, got %T", md)
 	}
-	l, err := getInt64(len)
-	if err != nil {
-		return nil, errors.WithStack(err)
+	return []*ast.MetadataAttachment{m}, nil
+}
+
+// AppendMetadataAttachment appends the given metadata attachment to the
+// metadata attachment list.
+func AppendMetadataAttachment(mds, md interface{}) ([]*ast.MetadataAttachment, error) {
+	ms, ok := mds.([]*ast.MetadataAttachment)
+	if !ok {
+		return nil, errors.Errorf("invalid metadata attachment list type; expected []*ast.MetadataAttachment, got %T", mds)
 	}
-	return &ast.VectorType{Elem: e, Len: l}, nil
+	m, ok := md.(*ast.MetadataAttachment)
+	if !ok {
+		return nil, errors.Errorf("invalid metadata attachment type; expected *ast.MetadataAttachment, got %T", md)
+	}
+	return append(ms, m), nil
 }
 
-// NewArrayType returns a new array type based on the given array length and
-// element type.
-func NewArrayType(len, elem interface{}) (*ast.ArrayType, error) {
-	e, ok := elem.(ast.Type)
+// NewMetadataAttachment returns a new metadata attachment based on the given
+// metadata kind name and metadata ID.
+func NewMetadataAttachment(name, id interface{}) (*ast.MetadataAttachment, error) {
+	n, ok := name.(*MetadataName)
 	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+		return nil, errors.Errorf("invalid metadata name type; expected *astx.MetadataName, got %T", name)
 	}
-	l, err := getInt64(len)
+	i, ok := id.(ast.MetadataID)
+	if !ok {
+		return nil, errors.Errorf("invalid metadata ID type; expected ast.MetadataID, got %T", id)
+	}
+	return &ast.MetadataAttachment{Name: n.name, ID: i}, nil
+}
+
+// --- [ Specialized metadata nodes ] ------------------------------------------
+
+// DIField represents a field of a specialized metadata node; e.g. `line: 42`.
+type DIField struct {
+	// Field name without the ":" suffix.
+	name string
+	// Field value; one of int64, string, bool, []string, or ast.Metadata (nil if
+	// null).
+	val interface{}
+}
+
+// NewDIField returns a new specialized metadata node field based on the given
+// field name and value.
+func NewDIField(name string, val interface{}) (*DIField, error) {
+	switch val.(type) {
+	case bool, []string, ast.Metadata, nil:
+		// valid field value.
+	default:
+		return nil, errors.Errorf("invalid %q field value type; expected bool, []string, ast.Metadata or nil, got %T", name, val)
+	}
+	return &DIField{name: name, val: val}, nil
+}
+
+// NewDIIntField returns a new specialized metadata node field based on the
+// given field name and integer literal token.
+func NewDIIntField(name string, lit interface{}) (*DIField, error) {
+	s, err := getTokenString(lit)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ArrayType{Elem: e, Len: l}, nil
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		// Large unsigned values; e.g. split DWARF object IDs.
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		n = int64(u)
+	}
+	return &DIField{name: name, val: n}, nil
 }
 
-// NewStructType returns a new struct type based on the given struct fields.
-func NewStructType(fields interface{}) (*ast.StructType, error) {
-	var fs []ast.Type
-	switch fields := fields.(type) {
-	case []ast.Type:
-		fs = fields
-	case nil:
-		// no struct fields.
-	default:
-		return nil, errors.Errorf("invalid struct fields type; expected []ast.Type, got %T", fields)
+// NewDIStringField returns a new specialized metadata node field based on the
+// given field name and string literal token.
+func NewDIStringField(name string, lit interface{}) (*DIField, error) {
+	s, err := getStringLit(lit)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.StructType{Fields: fs}, nil
+	return &DIField{name: name, val: s}, nil
 }
 
-// NewTypeIdent returns a new type identifier based on the given local
-// identifier.
-func NewTypeIdent(name interface{}) (*ast.NamedTypeDummy, error) {
-	n, ok := name.(*LocalIdent)
-	if !ok {
-		return nil, errors.Errorf("invalid type name type; expected *astx.LocalIdent, got %T", name)
+// NewDIEnumField returns a new specialized metadata node field based on the
+// given field name and enumeration token; e.g. DW_TAG_pointer_type.
+func NewDIEnumField(name string, tok interface{}) (*DIField, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.NamedTypeDummy{Name: n.name}, nil
+	return &DIField{name: name, val: s}, nil
 }
 
-// === [ Values ] ==============================================================
+// NewDIExtraField returns a new specialized metadata node field based on the
+// given field name label token and value, for fields not modelled by the node
+// types; e.g. `virtualIndex: 2`.
+func NewDIExtraField(name, val interface{}) (*DIField, error) {
+	s, err := getTokenString(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e := &ast.DIField{Name: strings.TrimSuffix(s, ":")}
+	switch val := val.(type) {
+	case *token.Token:
+		e.Lit = string(val.Lit)
+	case []string:
+		e.Lit = strings.Join(val, " | ")
+	case ast.Metadata:
+		e.MD = val
+	case nil:
+		e.Lit = "null"
+	default:
+		return nil, errors.Errorf("invalid %q field value type; expected *token.Token, []string, ast.Metadata or nil, got %T", e.Name, val)
+	}
+	return &DIField{name: e.Name, val: e}, nil
+}
 
-// NewValueList returns a new value list based on the given
-// value.
-func NewValueList(val interface{}) ([]ast.Value, error) {
-	v, ok := val.(ast.Value)
+// NewDIFieldList returns a new specialized metadata node field list based on
+// the given field.
+func NewDIFieldList(field interface{}) ([]*DIField, error) {
+	f, ok := field.(*DIField)
 	if !ok {
-		return nil, errors.Errorf("invalid value type; expected ast.Value, got %T", val)
+		return nil, errors.Errorf("invalid specialized metadata node field type; expected *astx.DIField, got %T", field)
 	}
-	return []ast.Value{v}, nil
+	return []*DIField{f}, nil
 }
 
-// AppendValue appends the given value to the value list.
-func AppendValue(vals, val interface{}) ([]ast.Value, error) {
-	vs, ok := vals.([]ast.Value)
+// AppendDIField appends the given field to the specialized metadata node field
+// list.
+func AppendDIField(fields, field interface{}) ([]*DIField, error) {
+	fs, ok := fields.([]*DIField)
 	if !ok {
-		return nil, errors.Errorf("invalid value list type; expected []ast.Value, got %T", vals)
+		return nil, errors.Errorf("invalid specialized metadata node field list type; expected []*astx.DIField, got %T", fields)
 	}
-	v, ok := val.(ast.Value)
+	f, ok := field.(*DIField)
 	if !ok {
-		return nil, errors.Errorf("invalid value type; expected ast.Value, got %T", val)
+		return nil, errors.Errorf("invalid specialized metadata node field type; expected *astx.DIField, got %T", field)
 	}
-	return append(vs, v), nil
+	return append(fs, f), nil
 }
 
-// NewValue returns a value based on the given type and value.
-func NewValue(typ, val interface{}) (ast.Value, error) {
-	t, ok := typ.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid value type; expected ast.Type, got %T", typ)
+// NewDIFlagList returns a new debug information flag list based on the given
+// flag token.
+func NewDIFlagList(flag interface{}) ([]string, error) {
+	s, err := getTokenString(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	switch val := val.(type) {
-	case *LocalIdent:
-		return &ast.LocalDummy{Name: val.name, Type: t}, nil
-	case *GlobalIdent:
-		return &ast.GlobalDummy{Name: val.name, Type: t}, nil
-	case *IntLit:
-		return &ast.IntConst{Type: t, Lit: val.lit}, nil
-	case *FloatLit:
-		return &ast.FloatConst{Type: t, Lit: val.lit}, nil
-	case *NullLit:
-		return &ast.NullConst{Type: t}, nil
-	case *ZeroInitializerLit:
-		return &ast.ZeroInitializerConst{Type: t}, nil
+	return []string{s}, nil
+}
 
-	// Replace *ast.TypeDummy with real type; as used by incoming values of phi
-	// instructions.
-	case *ast.GlobalDummy:
-		// Global dummy identifier type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid global dummy identifier type, expected *ast.TypeDummy, got %T", val.Type)
+// AppendDIFlag appends the given flag token to the debug information flag list.
+func AppendDIFlag(flags, flag interface{}) ([]string, error) {
+	fs, ok := flags.([]string)
+	if !ok {
+		return nil, errors.Errorf("invalid debug information flag list type; expected []string, got %T", flags)
+	}
+	s, err := getTokenString(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(fs, s), nil
+}
+
+// NewDICompileUnit returns a new compile unit debug information metadata node
+// based on the given optional fields.
+func NewDICompileUnit(fields interface{}) (*ast.DICompileUnit, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DICompileUnit{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "language":
+			md.Language, err = f.str()
+		case "file":
+			md.File, err = f.metadata()
+		case "producer":
+			md.Producer, err = f.str()
+		case "isOptimized":
+			md.IsOptimized, err = f.bool()
+		case "flags":
+			md.Flags, err = f.str()
+		case "runtimeVersion":
+			md.RuntimeVersion, err = f.int()
+		case "splitDebugFilename":
+			md.SplitDebugFilename, err = f.str()
+		case "emissionKind":
+			md.EmissionKind, err = f.str()
+		case "enums":
+			md.Enums, err = f.metadata()
+		case "retainedTypes":
+			md.RetainedTypes, err = f.metadata()
+		case "globals":
+			md.Globals, err = f.metadata()
+		case "imports":
+			md.Imports, err = f.metadata()
+		case "dwoId":
+			md.DwoID, err = f.int()
+		case "nameTableKind":
+			md.NameTableKind, err = f.str()
+		default:
+			return nil, errors.Errorf("invalid DICompileUnit field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.LocalDummy:
-		// Local dummy identifier type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid local dummy identifier type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.IntConst:
-		// Integer constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid integer constant type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.FloatConst:
-		// Floating-point constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid floating-point constant type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.NullConst:
-		// Null pointer constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid null pointer constant type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.VectorConst:
-		// Vector constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid vector constant type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ArrayConst:
-		// Array constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid array constant type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDIFile returns a new source file debug information metadata node based on
+// the given optional fields.
+func NewDIFile(fields interface{}) (*ast.DIFile, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DIFile{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "filename":
+			md.Filename, err = f.str()
+		case "directory":
+			md.Directory, err = f.str()
+		case "checksumkind":
+			md.ChecksumKind, err = f.str()
+		case "checksum":
+			md.Checksum, err = f.str()
+		default:
+			return nil, errors.Errorf("invalid DIFile field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.CharArrayConst:
-		// Character array constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid character array constant type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.StructConst:
-		// Struct constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid struct constant type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDISubprogram returns a new subprogram debug information metadata node
+// based on the given optional fields.
+func NewDISubprogram(fields interface{}) (*ast.DISubprogram, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DISubprogram{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "name":
+			md.Name, err = f.str()
+		case "linkageName":
+			md.LinkageName, err = f.str()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "type":
+			md.Sig, err = f.metadata()
+		case "isLocal":
+			md.IsLocal, err = f.bool()
+		case "isDefinition":
+			md.IsDefinition, err = f.bool()
+		case "scopeLine":
+			md.ScopeLine, err = f.int()
+		case "flags":
+			md.Flags, err = f.flags()
+		case "spFlags":
+			md.SPFlags, err = f.flags()
+		case "isOptimized":
+			md.IsOptimized, err = f.bool()
+		case "unit":
+			md.Unit, err = f.metadata()
+		case "declaration":
+			md.Declaration, err = f.metadata()
+		case "retainedNodes":
+			md.RetainedNodes, err = f.metadata()
+		default:
+			return nil, errors.Errorf("invalid DISubprogram field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ZeroInitializerConst:
-		// zeroinitializer constant type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid zeroinitializer constant type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
+	}
+	return md, nil
+}
 
-	// Binary instructions
-	case *ast.ExprAdd:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid add expression type, expected *ast.TypeDummy, got %T", val.Type)
+// NewDILocation returns a new source location debug information metadata node
+// based on the given optional fields.
+func NewDILocation(fields interface{}) (*ast.DILocation, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DILocation{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "line":
+			md.Line, err = f.int()
+		case "column":
+			md.Column, err = f.int()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "inlinedAt":
+			md.InlinedAt, err = f.metadata()
+		case "isImplicitCode":
+			md.IsImplicitCode, err = f.bool()
+		default:
+			return nil, errors.Errorf("invalid DILocation field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFAdd:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fadd expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSub:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid sub expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDILocalVariable returns a new local variable debug information metadata
+// node based on the given optional fields.
+func NewDILocalVariable(fields interface{}) (*ast.DILocalVariable, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DILocalVariable{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "name":
+			md.Name, err = f.str()
+		case "arg":
+			md.Arg, err = f.int()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "type":
+			md.VarType, err = f.metadata()
+		case "flags":
+			md.Flags, err = f.flags()
+		case "align":
+			md.Align, err = f.int()
+		default:
+			return nil, errors.Errorf("invalid DILocalVariable field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFSub:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fsub expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprMul:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid mul expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDIBasicType returns a new basic type debug information metadata node
+// based on the given optional fields.
+func NewDIBasicType(fields interface{}) (*ast.DIBasicType, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DIBasicType{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "tag":
+			md.Tag, err = f.str()
+		case "name":
+			md.Name, err = f.str()
+		case "size":
+			md.Size, err = f.int()
+		case "align":
+			md.Align, err = f.int()
+		case "encoding":
+			md.Encoding, err = f.str()
+		case "flags":
+			md.Flags, err = f.flags()
+		default:
+			return nil, errors.Errorf("invalid DIBasicType field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFMul:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fmul expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprUDiv:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid udiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDICompositeType returns a new composite type debug information metadata
+// node based on the given optional fields.
+func NewDICompositeType(fields interface{}) (*ast.DICompositeType, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DICompositeType{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "tag":
+			md.Tag, err = f.str()
+		case "name":
+			md.Name, err = f.str()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "baseType":
+			md.BaseType, err = f.metadata()
+		case "size":
+			md.Size, err = f.int()
+		case "align":
+			md.Align, err = f.int()
+		case "offset":
+			md.Offset, err = f.int()
+		case "flags":
+			md.Flags, err = f.flags()
+		case "elements":
+			md.Elements, err = f.metadata()
+		case "vtableHolder":
+			md.VtableHolder, err = f.metadata()
+		case "templateParams":
+			md.TemplateParams, err = f.metadata()
+		case "identifier":
+			md.Identifier, err = f.str()
+		default:
+			return nil, errors.Errorf("invalid DICompositeType field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSDiv:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid sdiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFDiv:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fdiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDIDerivedType returns a new derived type debug information metadata node
+// based on the given optional fields.
+func NewDIDerivedType(fields interface{}) (*ast.DIDerivedType, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DIDerivedType{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "tag":
+			md.Tag, err = f.str()
+		case "name":
+			md.Name, err = f.str()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "baseType":
+			md.BaseType, err = f.metadata()
+		case "size":
+			md.Size, err = f.int()
+		case "align":
+			md.Align, err = f.int()
+		case "offset":
+			md.Offset, err = f.int()
+		case "flags":
+			md.Flags, err = f.flags()
+		case "extraData":
+			md.ExtraData, err = f.metadata()
+		default:
+			return nil, errors.Errorf("invalid DIDerivedType field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprURem:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid urem expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSRem:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid srem expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDISubroutineType returns a new subroutine type debug information metadata
+// node based on the given optional fields.
+func NewDISubroutineType(fields interface{}) (*ast.DISubroutineType, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DISubroutineType{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "flags":
+			md.Flags, err = f.flags()
+		case "types":
+			md.Types, err = f.metadata()
+		default:
+			return nil, errors.Errorf("invalid DISubroutineType field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFRem:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid frem expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
+	}
+	return md, nil
+}
 
-	// Bitwise instructions
-	case *ast.ExprShl:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid shl expression type, expected *ast.TypeDummy, got %T", val.Type)
+// NewDILexicalBlock returns a new lexical block debug information metadata node
+// based on the given optional fields.
+func NewDILexicalBlock(fields interface{}) (*ast.DILexicalBlock, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DILexicalBlock{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "column":
+			md.Column, err = f.int()
+		default:
+			return nil, errors.Errorf("invalid DILexicalBlock field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprLShr:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid lshr expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprAShr:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid ashr expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDISubrange returns a new array subrange debug information metadata node
+// based on the given optional fields.
+func NewDISubrange(fields interface{}) (*ast.DISubrange, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DISubrange{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "count":
+			// The number of elements is either constant or held by a variable.
+			if _, ok := f.val.(int64); ok {
+				md.Count, err = f.int()
+			} else {
+				md.CountVar, err = f.metadata()
+			}
+		case "lowerBound":
+			md.LowerBound, err = f.int()
+		default:
+			return nil, errors.Errorf("invalid DISubrange field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprAnd:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid and expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprOr:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid or expression type, expected *ast.TypeDummy, got %T", val.Type)
+	}
+	return md, nil
+}
+
+// NewDIGlobalVariable returns a new global variable debug information metadata
+// node based on the given optional fields.
+func NewDIGlobalVariable(fields interface{}) (*ast.DIGlobalVariable, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DIGlobalVariable{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "name":
+			md.Name, err = f.str()
+		case "linkageName":
+			md.LinkageName, err = f.str()
+		case "scope":
+			md.Scope, err = f.metadata()
+		case "file":
+			md.File, err = f.metadata()
+		case "line":
+			md.Line, err = f.int()
+		case "type":
+			md.VarType, err = f.metadata()
+		case "isLocal":
+			md.IsLocal, err = f.bool()
+		case "isDefinition":
+			md.IsDefinition, err = f.bool()
+		case "declaration":
+			md.Declaration, err = f.metadata()
+		case "align":
+			md.Align, err = f.int()
+		default:
+			return nil, errors.Errorf("invalid DIGlobalVariable field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprXor:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid xor expression type, expected *ast.TypeDummy, got %T", val.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
-		val.Type = t
-		return val, nil
+	}
+	return md, nil
+}
 
-	// Memory instructions
-	case *ast.ExprGetElementPtr:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid getelementptr expression type, expected *ast.TypeDummy, got %T", val.Type)
+// NewDIGlobalVariableExpression returns a new global variable expression debug
+// information metadata node based on the given optional fields.
+func NewDIGlobalVariableExpression(fields interface{}) (*ast.DIGlobalVariableExpression, error) {
+	fs, extra, err := getDIFields(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md := &ast.DIGlobalVariableExpression{Extra: extra}
+	for _, f := range fs {
+		switch f.name {
+		case "var":
+			md.Var, err = f.metadata()
+		case "expr":
+			md.Expr, err = f.metadata()
+		default:
+			return nil, errors.Errorf("invalid DIGlobalVariableExpression field %q", f.name)
 		}
-		val.Type = t
-		return val, nil
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return md, nil
+}
 
-	// Conversion instructions
-	case *ast.ExprTrunc:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid trunc expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprZExt:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid zext expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSExt:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid sext expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFPTrunc:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fptrunc expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFPExt:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fpext expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFPToUI:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fptoui expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFPToSI:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fptosi expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprUIToFP:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid uitofp expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSIToFP:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid sitofp expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprPtrToInt:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid ptrtoint expression type, expected *ast.TypeDummy, got %T", val.Type)
+// NewDIExpression returns a new DWARF expression debug information metadata
+// node based on the given optional DWARF operations and operands.
+func NewDIExpression(elems interface{}) (*ast.DIExpression, error) {
+	var es []ast.DIExpressionElem
+	switch elems := elems.(type) {
+	case []ast.DIExpressionElem:
+		es = elems
+	case nil:
+		// empty DWARF expression.
+	default:
+		return nil, errors.Errorf("invalid DWARF expression element list type; expected []ast.DIExpressionElem or nil, got %T", elems)
+	}
+	return &ast.DIExpression{Elems: es}, nil
+}
+
+// NewDIExpressionElemList returns a new DWARF expression element list based on
+// the given DWARF operation or operand.
+func NewDIExpressionElemList(elem interface{}) ([]ast.DIExpressionElem, error) {
+	e, ok := elem.(ast.DIExpressionElem)
+	if !ok {
+		return nil, errors.Errorf("invalid DWARF expression element type; expected ast.DIExpressionElem, got %T", elem)
+	}
+	return []ast.DIExpressionElem{e}, nil
+}
+
+// AppendDIExpressionElem appends the given DWARF operation or operand to the
+// DWARF expression element list.
+func AppendDIExpressionElem(elems, elem interface{}) ([]ast.DIExpressionElem, error) {
+	es, ok := elems.([]ast.DIExpressionElem)
+	if !ok {
+		return nil, errors.Errorf("invalid DWARF expression element list type; expected []ast.DIExpressionElem, got %T", elems)
+	}
+	e, ok := elem.(ast.DIExpressionElem)
+	if !ok {
+		return nil, errors.Errorf("invalid DWARF expression element type; expected ast.DIExpressionElem, got %T", elem)
+	}
+	return append(es, e), nil
+}
+
+// NewDwarfOp returns a new DWARF operation based on the given DWARF operation
+// token.
+func NewDwarfOp(tok interface{}) (ast.DwarfOp, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return ast.DwarfOp(s), nil
+}
+
+// NewDIExpressionUint returns a new unsigned integer operand of a DWARF
+// expression based on the given integer literal token.
+func NewDIExpressionUint(tok interface{}) (ast.Uint, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return ast.Uint(n), nil
+}
+
+// === [ Identifiers ] =========================================================
+
+// GlobalIdent represents a global identifier.
+type GlobalIdent struct {
+	// Global identifier name the without "@" prefix.
+	name string
+}
+
+// NewGlobalIdent returns a new global identifier based on the given global
+// identifier token.
+func NewGlobalIdent(ident interface{}) (*GlobalIdent, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "@") {
+		return nil, errors.Errorf(`invalid global identifier %q; missing "@" prefix`, s)
+	}
+	s = s[1:]
+	return &GlobalIdent{name: s}, nil
+}
+
+// LocalIdent represents a local identifier.
+type LocalIdent struct {
+	// Local identifier name the without "%" prefix.
+	name string
+}
+
+// NewLocalIdent returns a new local identifier based on the given local
+// identifier token.
+func NewLocalIdent(ident interface{}) (*LocalIdent, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "%") {
+		return nil, errors.Errorf(`invalid local identifier %q; missing "%%" prefix`, s)
+	}
+	s = s[1:]
+	return &LocalIdent{name: s}, nil
+}
+
+// ComdatIdent represents a comdat identifier.
+type ComdatIdent struct {
+	// Comdat identifier name the without "$" prefix.
+	name string
+}
+
+// NewComdatIdent returns a new comdat identifier based on the given comdat
+// identifier token.
+func NewComdatIdent(ident interface{}) (*ComdatIdent, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "$") {
+		return nil, errors.Errorf(`invalid comdat identifier %q; missing "$" prefix`, s)
+	}
+	s = s[1:]
+	return &ComdatIdent{name: s}, nil
+}
+
+// NewAttrGroupID returns a new attribute group ID based on the given attribute
+// group identifier token.
+func NewAttrGroupID(ident interface{}) (ast.AttrGroupID, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "#") {
+		return 0, errors.Errorf(`invalid attribute group ID %q; missing "#" prefix`, s)
+	}
+	id, err := strconv.ParseInt(s[1:], 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return ast.AttrGroupID(id), nil
+}
+
+// MetadataName represents a metadata name.
+type MetadataName struct {
+	// Metadata name without the "!" prefix.
+	name string
+}
+
+// NewMetadataName returns a new metadata name based on the given metadata name
+// token.
+func NewMetadataName(ident interface{}) (*MetadataName, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "!") {
+		return nil, errors.Errorf(`invalid metadata name %q; missing "!" prefix`, s)
+	}
+	s = s[1:]
+	return &MetadataName{name: enc.Unescape(s)}, nil
+}
+
+// NewMetadataID returns a new metadata ID based on the given metadata ID token.
+func NewMetadataID(ident interface{}) (ast.MetadataID, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "!") {
+		return 0, errors.Errorf(`invalid metadata ID %q; missing "!" prefix`, s)
+	}
+	id, err := strconv.ParseInt(s[1:], 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return ast.MetadataID(id), nil
+}
+
+// LabelIdent represents a label identifier.
+type LabelIdent struct {
+	// Label identifier name the without ":" suffix.
+	name string
+}
+
+// NewLabelIdent returns a new label identifier based on the given label
+// identifier token.
+func NewLabelIdent(ident interface{}) (*LabelIdent, error) {
+	s, err := getTokenString(ident)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasSuffix(s, ":") {
+		return nil, errors.Errorf(`invalid label identifier %q; missing ":" suffix`, s)
+	}
+	s = s[:len(s)-1]
+	return &LabelIdent{name: s}, nil
+}
+
+// === [ Types ] ===============================================================
+
+// NewTypeList returns a new type list based on the given type.
+func NewTypeList(typ interface{}) ([]ast.Type, error) {
+	t, ok := typ.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
+	}
+	return []ast.Type{t}, nil
+}
+
+// AppendType appends the given type to the type list.
+func AppendType(typs, typ interface{}) ([]ast.Type, error) {
+	ts, ok := typs.([]ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type list type; expected []ast.Type, got %T", typs)
+	}
+	t, ok := typ.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
+	}
+	return append(ts, t), nil
+}
+
+// NewIntType returns a new integer type based on the given integer type token.
+func NewIntType(typeTok interface{}) (*ast.IntType, error) {
+	s, err := getTokenString(typeTok)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !strings.HasPrefix(s, "i") {
+		return nil, errors.Errorf(`invalid integer type %q; missing "i" prefix`, s)
+	}
+	s = s[1:]
+	size, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.IntType{Size: size}, nil
+}
+
+// NewFuncType returns a new function type based on the given return type and
+// function parameters.
+func NewFuncType(ret, params interface{}) (*ast.FuncType, error) {
+	r, ok := ret.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid function return type; expected ast.Type, got %T", ret)
+	}
+	sig := &ast.FuncType{Ret: r}
+	switch ps := params.(type) {
+	case *Params:
+		for _, param := range ps.params {
+			sig.Params = append(sig.Params, param)
 		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprIntToPtr:
-		// Constant expression type should be of dummy type.
+		sig.Variadic = ps.variadic
+	case nil:
+		// no parameters.
+	default:
+		return nil, errors.Errorf("invalid function parameters type; expected *astx.Params or nil, got %T", params)
+	}
+	return sig, nil
+}
+
+// NewPointerType returns a new pointer type based on the given element type.
+func NewPointerType(elem, space interface{}) (*ast.PointerType, error) {
+	e, ok := elem.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+	}
+	t := &ast.PointerType{Elem: e}
+	switch space := space.(type) {
+	case *AddrSpace:
+		t.AddrSpace = space.space
+	case nil:
+		// no address space.
+	default:
+		return nil, errors.Errorf("invalid address space type; expected *astx.AddrSpace or nil, got %T", space)
+	}
+	return t, nil
+}
+
+// AddrSpace represents the address space of a pointer type.
+type AddrSpace struct {
+	// Address space.
+	space int64
+}
+
+// NewAddrSpace returns a new address space pointer based on the given space.
+func NewAddrSpace(space interface{}) (*AddrSpace, error) {
+	s, err := getInt64(space)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &AddrSpace{space: s}, nil
+}
+
+// NewVectorType returns a new vector type based on the given vector length and
+// element type.
+func NewVectorType(len, elem interface{}) (*ast.VectorType, error) {
+	e, ok := elem.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+	}
+	l, err := getInt64(len)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.VectorType{Elem: e, Len: l}, nil
+}
+
+// NewScalableVectorType returns a new scalable vector type based on the given
+// minimum vector length and element type.
+func NewScalableVectorType(len, elem interface{}) (*ast.VectorType, error) {
+	t, err := NewVectorType(len, elem)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t.Scalable = true
+	return t, nil
+}
+
+// NewArrayType returns a new array type based on the given array length and
+// element type.
+func NewArrayType(len, elem interface{}) (*ast.ArrayType, error) {
+	e, ok := elem.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+	}
+	l, err := getInt64(len)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ArrayType{Elem: e, Len: l}, nil
+}
+
+// NewStructType returns a new struct type based on the given struct fields.
+func NewStructType(fields interface{}) (*ast.StructType, error) {
+	var fs []ast.Type
+	switch fields := fields.(type) {
+	case []ast.Type:
+		fs = fields
+	case nil:
+		// no struct fields.
+	default:
+		return nil, errors.Errorf("invalid struct fields type; expected []ast.Type, got %T", fields)
+	}
+	return &ast.StructType{Fields: fs}, nil
+}
+
+// NewPackedStructType returns a new packed struct type based on the given
+// struct fields.
+func NewPackedStructType(fields interface{}) (*ast.StructType, error) {
+	t, err := NewStructType(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t.Packed = true
+	return t, nil
+}
+
+// NewTypeIdent returns a new type identifier based on the given local
+// identifier.
+func NewTypeIdent(name interface{}) (*ast.NamedTypeDummy, error) {
+	n, ok := name.(*LocalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid type name type; expected *astx.LocalIdent, got %T", name)
+	}
+	return &ast.NamedTypeDummy{Name: n.name}, nil
+}
+
+// === [ Values ] ==============================================================
+
+// NewValueList returns a new value list based on the given
+// value.
+func NewValueList(val interface{}) ([]ast.Value, error) {
+	v, ok := val.(ast.Value)
+	if !ok {
+		return nil, errors.Errorf("invalid value type; expected ast.Value, got %T", val)
+	}
+	return []ast.Value{v}, nil
+}
+
+// AppendValue appends the given value to the value list.
+func AppendValue(vals, val interface{}) ([]ast.Value, error) {
+	vs, ok := vals.([]ast.Value)
+	if !ok {
+		return nil, errors.Errorf("invalid value list type; expected []ast.Value, got %T", vals)
+	}
+	v, ok := val.(ast.Value)
+	if !ok {
+		return nil, errors.Errorf("invalid value type; expected ast.Value, got %T", val)
+	}
+	return append(vs, v), nil
+}
+
+// NewArg returns a new function argument based on the given type, parameter
+// attributes and value.
+func NewArg(typ, attrs, val interface{}) (ast.Value, error) {
+	v, err := NewValue(typ, val)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	as, err := getParamAttrs(attrs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(as) == 0 {
+		// no parameter attributes.
+		return v, nil
+	}
+	return &ast.Arg{Value: v, Attrs: as}, nil
+}
+
+// NewValue returns a value based on the given type and value.
+func NewValue(typ, val interface{}) (ast.Value, error) {
+	t, ok := typ.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid value type; expected ast.Type, got %T", typ)
+	}
+	switch val := val.(type) {
+	case *LocalIdent:
+		return &ast.LocalDummy{Name: val.name, Type: t}, nil
+	case *GlobalIdent:
+		return &ast.GlobalDummy{Name: val.name, Type: t}, nil
+	case *IntLit:
+		return &ast.IntConst{Type: t, Lit: val.lit}, nil
+	case *FloatLit:
+		return &ast.FloatConst{Type: t, Lit: val.lit}, nil
+	case *NullLit:
+		return &ast.NullConst{Type: t}, nil
+	case *NoneLit:
+		return &ast.NoneConst{Type: t}, nil
+	case *ZeroInitializerLit:
+		return &ast.ZeroInitializerConst{Type: t}, nil
+	case *UndefLit:
+		return &ast.UndefConst{Type: t}, nil
+	case *PoisonLit:
+		return &ast.PoisonConst{Type: t}, nil
+
+	// Replace *ast.TypeDummy with real type; as used by incoming values of phi
+	// instructions.
+	case *ast.GlobalDummy:
+		// Global dummy identifier type should be of dummy type.
 		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid inttoptr expression type, expected *ast.TypeDummy, got %T", val.Type)
+			return nil, errors.Errorf("invalid global dummy identifier type, expected *ast.TypeDummy, got %T", val.Type)
 		}
 		val.Type = t
 		return val, nil
-	case *ast.ExprBitCast:
+	case *ast.LocalDummy:
+		// Local dummy identifier type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid local dummy identifier type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.IntConst:
+		// Integer constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid integer constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.FloatConst:
+		// Floating-point constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid floating-point constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.NullConst:
+		// Null pointer constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid null pointer constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.VectorConst:
+		// Vector constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid vector constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ArrayConst:
+		// Array constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid array constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.CharArrayConst:
+		// Character array constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid character array constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.StructConst:
+		// Struct constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid struct constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ZeroInitializerConst:
+		// zeroinitializer constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid zeroinitializer constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.UndefConst:
+		// undef constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid undef constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.PoisonConst:
+		// poison constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid poison constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.BlockAddressConst:
+		// blockaddress constant type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid blockaddress constant type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Binary instructions
+	case *ast.ExprAdd:
 		// Constant expression type should be of dummy type.
 		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid bitcast expression type, expected *ast.TypeDummy, got %T", val.Type)
+			return nil, errors.Errorf("invalid add expression type, expected *ast.TypeDummy, got %T", val.Type)
 		}
 		val.Type = t
 		return val, nil
-	case *ast.ExprAddrSpaceCast:
+	case *ast.ExprFAdd:
 		// Constant expression type should be of dummy type.
 		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid addrspacecast expression type, expected *ast.TypeDummy, got %T", val.Type)
+			return nil, errors.Errorf("invalid fadd expression type, expected *ast.TypeDummy, got %T", val.Type)
 		}
 		val.Type = t
 		return val, nil
+	case *ast.ExprSub:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid sub expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFSub:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fsub expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprMul:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid mul expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFMul:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fmul expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprUDiv:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid udiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprSDiv:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid sdiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFDiv:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fdiv expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprURem:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid urem expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprSRem:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid srem expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFRem:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid frem expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Bitwise instructions
+	case *ast.ExprShl:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid shl expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprLShr:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid lshr expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprAShr:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid ashr expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprAnd:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid and expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprOr:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid or expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprXor:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid xor expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Vector instructions
+	case *ast.ExprExtractElement:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid extractelement expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprInsertElement:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid insertelement expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprShuffleVector:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid shufflevector expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Aggregate instructions
+	case *ast.ExprExtractValue:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid extractvalue expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprInsertValue:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid insertvalue expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Memory instructions
+	case *ast.ExprGetElementPtr:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid getelementptr expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Conversion instructions
+	case *ast.ExprTrunc:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid trunc expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprZExt:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid zext expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprSExt:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid sext expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFPTrunc:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fptrunc expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFPExt:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fpext expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFPToUI:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fptoui expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFPToSI:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fptosi expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprUIToFP:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid uitofp expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprSIToFP:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid sitofp expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprPtrToInt:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid ptrtoint expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprIntToPtr:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid inttoptr expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprBitCast:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid bitcast expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprAddrSpaceCast:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid addrspacecast expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+
+	// Other instructions
+	case *ast.ExprICmp:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid icmp expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprFCmp:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid fcmp expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	case *ast.ExprSelect:
+		// Constant expression type should be of dummy type.
+		if _, ok := val.Type.(*ast.TypeDummy); !ok {
+			return nil, errors.Errorf("invalid select expression type, expected *ast.TypeDummy, got %T", val.Type)
+		}
+		val.Type = t
+		return val, nil
+	default:
+		panic(fmt.Errorf("support for value type %T not yet implemented", val))
+	}
+}
+
+// === [ Constants ] ===========================================================
+
+// NewConstantList returns a new constant list based on the given constant.
+func NewConstantList(x interface{}) ([]ast.Constant, error) {
+	c, ok := x.(ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", x)
+	}
+	return []ast.Constant{c}, nil
+}
+
+// AppendConstant appends the given constant to the constant list.
+func AppendConstant(xs, x interface{}) ([]ast.Constant, error) {
+	cs, ok := xs.([]ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid constant list type; expected []ast.Constant, got %T", xs)
+	}
+	c, ok := x.(ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", x)
+	}
+	return append(cs, c), nil
+}
+
+// NewConstant returns a constant based on the given type and value.
+func NewConstant(typ, val interface{}) (ast.Constant, error) {
+	v, err := NewValue(typ, val)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c, ok := v.(ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", v)
+	}
+	return c, nil
+}
+
+// IntLit represents an integer literal.
+type IntLit struct {
+	// Integer literal.
+	lit string
+}
+
+// NewIntLit returns a new integer literal based on the given integer token.
+func NewIntLit(tok interface{}) (*IntLit, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &IntLit{lit: s}, nil
+}
+
+// FloatLit represents an floating-point literal.
+type FloatLit struct {
+	// Floating-point literal.
+	lit string
+}
+
+// NewFloatLit returns a new floating-point literal based on the given floating-point  token.
+func NewFloatLit(tok interface{}) (*FloatLit, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &FloatLit{lit: s}, nil
+}
+
+// NullLit represents a null literal.
+type NullLit struct {
+}
+
+// NoneLit represents a none token literal.
+type NoneLit struct {
+}
+
+// NewBlockAddressConst returns a new blockaddress constant based on the given
+// parent function and basic block.
+func NewBlockAddressConst(f, block interface{}) (*ast.BlockAddressConst, error) {
+	g, ok := f.(*GlobalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid function identifier type; expected *astx.GlobalIdent, got %T", f)
+	}
+	l, ok := block.(*LocalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid basic block identifier type; expected *astx.LocalIdent, got %T", block)
+	}
+	fn := &ast.GlobalDummy{Name: g.name, Type: &ast.TypeDummy{}}
+	b := &ast.LocalDummy{Name: l.name, Type: &ast.LabelType{}}
+	return &ast.BlockAddressConst{Type: &ast.TypeDummy{}, Func: fn, Block: b}, nil
+}
+
+// NewVectorConst returns a new vector constant based on the given elements.
+func NewVectorConst(elems interface{}) (*ast.VectorConst, error) {
+	es, ok := elems.([]ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid vector elements type; expected []ast.Constant, got %T", elems)
+	}
+	return &ast.VectorConst{Type: &ast.TypeDummy{}, Elems: es}, nil
+}
+
+// NewArrayConst returns a new array constant based on the given elements.
+func NewArrayConst(elems interface{}) (*ast.ArrayConst, error) {
+	es, ok := elems.([]ast.Constant)
+	if !ok {
+		return nil, errors.Errorf("invalid array elements type; expected []ast.Constant, got %T", elems)
+	}
+	return &ast.ArrayConst{Type: &ast.TypeDummy{}, Elems: es}, nil
+}
+
+// NewCharArrayConst returns a new character array constant based on the given
+// string.
+func NewCharArrayConst(str interface{}) (*ast.CharArrayConst, error) {
+	s, err := getTokenString(str)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Skip double-quotes.
+	s = s[1 : len(s)-1]
+	s = enc.Unescape(s)
+	c := &ast.CharArrayConst{Type: &ast.TypeDummy{}, Lit: s}
+	return c, nil
+}
+
+// NewStructConst returns a new struct constant based on the given fields.
+func NewStructConst(fields interface{}) (*ast.StructConst, error) {
+	var fs []ast.Constant
+	switch fields := fields.(type) {
+	case []ast.Constant:
+		fs = fields
+	case nil:
+		// no struct fields.
+	default:
+		return nil, errors.Errorf("invalid struct fields type; expected []ast.Constant, got %T", fields)
+	}
+	return &ast.StructConst{Type: &ast.TypeDummy{}, Fields: fs}, nil
+}
+
+// NewPackedStructConst returns a new packed struct constant based on the given
+// fields.
+func NewPackedStructConst(fields interface{}) (*ast.StructConst, error) {
+	c, err := NewStructConst(fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c.Packed = true
+	return c, nil
+}
+
+// ZeroInitializerLit represents a zeroinitializer literal.
+type ZeroInitializerLit struct {
+}
+
+// UndefLit represents an undef literal.
+type UndefLit struct {
+}
+
+// PoisonLit represents a poison literal.
+type PoisonLit struct {
+}
+
+// --- [ Binary expressions ] --------------------------------------------------
+
+// NewAddExpr returns a new add expression based on the given overflow flags,
+// type and operands.
+func NewAddExpr(overflow, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAdd, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprAdd{Type: &ast.TypeDummy{}, X: x, Y: y, OverflowFlags: flags}, nil
+}
+
+// NewFAddExpr returns a new fadd expression based on the given type and
+// operands.
+func NewFAddExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFAdd, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFAdd{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewSubExpr returns a new sub expression based on the given overflow flags,
+// type and operands.
+func NewSubExpr(overflow, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSub, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprSub{Type: &ast.TypeDummy{}, X: x, Y: y, OverflowFlags: flags}, nil
+}
+
+// NewFSubExpr returns a new fsub expression based on the given type and
+// operands.
+func NewFSubExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFSub, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFSub{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewMulExpr returns a new mul expression based on the given overflow flags,
+// type and operands.
+func NewMulExpr(overflow, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprMul, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprMul{Type: &ast.TypeDummy{}, X: x, Y: y, OverflowFlags: flags}, nil
+}
+
+// NewFMulExpr returns a new fmul expression based on the given type and
+// operands.
+func NewFMulExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFMul, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFMul{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewUDivExpr returns a new udiv expression based on the given exact flag, type
+// and operands.
+func NewUDivExpr(exact, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprUDiv, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprUDiv{Type: &ast.TypeDummy{}, X: x, Y: y, Exact: e}, nil
+}
+
+// NewSDivExpr returns a new sdiv expression based on the given exact flag, type
+// and operands.
+func NewSDivExpr(exact, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSDiv, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprSDiv{Type: &ast.TypeDummy{}, X: x, Y: y, Exact: e}, nil
+}
+
+// NewFDivExpr returns a new fdiv expression based on the given type and
+// operands.
+func NewFDivExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFDiv, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFDiv{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewURemExpr returns a new urem expression based on the given type and
+// operands.
+func NewURemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprURem, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprURem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewSRemExpr returns a new srem expression based on the given type and
+// operands.
+func NewSRemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSRem, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprSRem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewFRemExpr returns a new frem expression based on the given type and
+// operands.
+func NewFRemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFRem, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFRem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// --- [ Bitwise expressions ] -------------------------------------------------
+
+// NewShlExpr returns a new shl expression based on the given overflow flags,
+// type and operands.
+func NewShlExpr(overflow, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprShl, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprShl{Type: &ast.TypeDummy{}, X: x, Y: y, OverflowFlags: flags}, nil
+}
+
+// NewLShrExpr returns a new lshr expression based on the given exact flag, type
+// and operands.
+func NewLShrExpr(exact, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprLShr, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprLShr{Type: &ast.TypeDummy{}, X: x, Y: y, Exact: e}, nil
+}
+
+// NewAShrExpr returns a new ashr expression based on the given exact flag, type
+// and operands.
+func NewAShrExpr(exact, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAShr, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprAShr{Type: &ast.TypeDummy{}, X: x, Y: y, Exact: e}, nil
+}
+
+// NewAndExpr returns a new and expression based on the given type and operands.
+func NewAndExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAnd, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprAnd{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewOrExpr returns a new or expression based on the given type and operands.
+func NewOrExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprOr, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprOr{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
+
+// NewXorExpr returns a new xor expression based on the given type and operands.
+func NewXorExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprXor, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprXor{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+}
 
-	// Other instructions
-	case *ast.ExprICmp:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid icmp expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprFCmp:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid fcmp expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
-	case *ast.ExprSelect:
-		// Constant expression type should be of dummy type.
-		if _, ok := val.Type.(*ast.TypeDummy); !ok {
-			return nil, errors.Errorf("invalid select expression type, expected *ast.TypeDummy, got %T", val.Type)
-		}
-		val.Type = t
-		return val, nil
+// --- [ Vector expressions ] --------------------------------------------------
+
+// NewExtractElementExpr returns a new extractelement expression based on the
+// given vector and index.
+func NewExtractElementExpr(xTyp, xVal, indexTyp, indexVal interface{}) (*ast.ExprExtractElement, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	index, err := NewConstant(indexTyp, indexVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprExtractElement{Type: &ast.TypeDummy{}, X: x, Index: index}, nil
+}
+
+// NewInsertElementExpr returns a new insertelement expression based on the
+// given vector, element and index.
+func NewInsertElementExpr(xTyp, xVal, elemTyp, elemVal, indexTyp, indexVal interface{}) (*ast.ExprInsertElement, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	elem, err := NewConstant(elemTyp, elemVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	index, err := NewConstant(indexTyp, indexVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprInsertElement{Type: &ast.TypeDummy{}, X: x, Elem: elem, Index: index}, nil
+}
+
+// NewShuffleVectorExpr returns a new shufflevector expression based on the
+// given vectors and shuffle mask.
+func NewShuffleVectorExpr(xTyp, xVal, yTyp, yVal, maskTyp, maskVal interface{}) (*ast.ExprShuffleVector, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	mask, err := NewConstant(maskTyp, maskVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprShuffleVector{Type: &ast.TypeDummy{}, X: x, Y: y, Mask: mask}, nil
+}
+
+// --- [ Aggregate expressions ] -----------------------------------------------
+
+// NewExtractValueExpr returns a new extractvalue expression based on the given
+// aggregate value and indices.
+func NewExtractValueExpr(xTyp, xVal, indices interface{}) (*ast.ExprExtractValue, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	is, ok := indices.([]int64)
+	if !ok {
+		return nil, errors.Errorf("invalid indices type; expected []int64, got %T", indices)
+	}
+	return &ast.ExprExtractValue{Type: &ast.TypeDummy{}, X: x, Indices: is}, nil
+}
+
+// NewInsertValueExpr returns a new insertvalue expression based on the given
+// aggregate value, element and indices.
+func NewInsertValueExpr(xTyp, xVal, elemTyp, elemVal, indices interface{}) (*ast.ExprInsertValue, error) {
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	elem, err := NewConstant(elemTyp, elemVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	is, ok := indices.([]int64)
+	if !ok {
+		return nil, errors.Errorf("invalid indices type; expected []int64, got %T", indices)
+	}
+	return &ast.ExprInsertValue{Type: &ast.TypeDummy{}, X: x, Elem: elem, Indices: is}, nil
+}
+
+// NewIndexList returns a new aggregate index list based on the given index.
+func NewIndexList(index interface{}) ([]int64, error) {
+	i, err := getIndex(index)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return []int64{i}, nil
+}
+
+// AppendIndex appends the given index to the aggregate index list.
+func AppendIndex(indices, index interface{}) ([]int64, error) {
+	is, ok := indices.([]int64)
+	if !ok {
+		return nil, errors.Errorf("invalid index list type; expected []int64, got %T", indices)
+	}
+	i, err := getIndex(index)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(is, i), nil
+}
+
+// --- [ Memory expressions ] --------------------------------------------------
+
+// NewGetElementPtrExpr returns a new getelementptr expression based on the
+// given element type, source address type and value, and element indices.
+func NewGetElementPtrExpr(elem, srcTyp, srcVal, indices interface{}) (*ast.ExprGetElementPtr, error) {
+	e, ok := elem.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+	}
+	src, err := NewConstant(srcTyp, srcVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var is []ast.Constant
+	switch indices := indices.(type) {
+	case []ast.Constant:
+		is = indices
+	case nil:
+		// no indices.
 	default:
-		panic(fmt.Errorf("support for value type %T not yet implemented", val))
+		return nil, errors.Errorf("invalid indices type; expected []ast.Constant or nil, got %T", indices)
 	}
+	return &ast.ExprGetElementPtr{Type: &ast.TypeDummy{}, Elem: e, Src: src, Indices: is}, nil
 }
 
-// === [ Constants ] ===========================================================
+// --- [ Conversion expressions ] ----------------------------------------------
 
-// NewConstantList returns a new constant list based on the given constant.
-func NewConstantList(x interface{}) ([]ast.Constant, error) {
-	c, ok := x.(ast.Constant)
+// NewTruncExpr returns a new trunc expression based on the given source value
+// and target type.
+func NewTruncExpr(fromTyp, fromVal, to interface{}) (*ast.ExprTrunc, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprTrunc{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewZExtExpr returns a new zext expression based on the given source value and
+// target type.
+func NewZExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprZExt, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprZExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewSExtExpr returns a new sext expression based on the given source value and
+// target type.
+func NewSExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprSExt, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprSExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewFPTruncExpr returns a new fptrunc expression based on the given source
+// value and target type.
+func NewFPTruncExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPTrunc, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprFPTrunc{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewFPExtExpr returns a new fpext expression based on the given source value
+// and target type.
+func NewFPExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPExt, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprFPExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewFPToUIExpr returns a new fptoui expression based on the given source value
+// and target type.
+func NewFPToUIExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPToUI, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprFPToUI{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewFPToSIExpr returns a new fptosi expression based on the given source value
+// and target type.
+func NewFPToSIExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPToSI, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprFPToSI{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewUIToFPExpr returns a new uitofp expression based on the given source value
+// and target type.
+func NewUIToFPExpr(fromTyp, fromVal, to interface{}) (*ast.ExprUIToFP, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprUIToFP{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewSIToFPExpr returns a new sitofp expression based on the given source value
+// and target type.
+func NewSIToFPExpr(fromTyp, fromVal, to interface{}) (*ast.ExprSIToFP, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprSIToFP{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+}
+
+// NewPtrToIntExpr returns a new ptrtoint expression based on the given source
+// value and target type.
+func NewPtrToIntExpr(fromTyp, fromVal, to interface{}) (*ast.ExprPtrToInt, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", x)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
 	}
-	return []ast.Constant{c}, nil
+	return &ast.ExprPtrToInt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
 }
 
-// AppendConstant appends the given constant to the constant list.
-func AppendConstant(xs, x interface{}) ([]ast.Constant, error) {
-	cs, ok := xs.([]ast.Constant)
-	if !ok {
-		return nil, errors.Errorf("invalid constant list type; expected []ast.Constant, got %T", xs)
+// NewIntToPtrExpr returns a new inttoptr expression based on the given source
+// value and target type.
+func NewIntToPtrExpr(fromTyp, fromVal, to interface{}) (*ast.ExprIntToPtr, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	c, ok := x.(ast.Constant)
+	t, ok := to.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", x)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
 	}
-	return append(cs, c), nil
+	return &ast.ExprIntToPtr{Type: &ast.TypeDummy{}, From: from, To: t}, nil
 }
 
-// NewConstant returns a constant based on the given type and value.
-func NewConstant(typ, val interface{}) (ast.Constant, error) {
-	v, err := NewValue(typ, val)
+// NewBitCastExpr returns a new bitcast expression based on the given source
+// value and target type.
+func NewBitCastExpr(fromTyp, fromVal, to interface{}) (*ast.ExprBitCast, error) {
+	from, err := NewConstant(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	c, ok := v.(ast.Constant)
+	t, ok := to.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid constant type; expected ast.Constant, got %T", v)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
 	}
-	return c, nil
+	return &ast.ExprBitCast{Type: &ast.TypeDummy{}, From: from, To: t}, nil
 }
 
-// IntLit represents an integer literal.
-type IntLit struct {
-	// Integer literal.
-	lit string
+// NewAddrSpaceCastExpr returns a new addrspacecast expression based on the
+// given source value and target type.
+func NewAddrSpaceCastExpr(fromTyp, fromVal, to interface{}) (*ast.ExprAddrSpaceCast, error) {
+	from, err := NewConstant(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	return &ast.ExprAddrSpaceCast{Type: &ast.TypeDummy{}, From: from, To: t}, nil
 }
 
-// NewIntLit returns a new integer literal based on the given integer token.
-func NewIntLit(tok interface{}) (*IntLit, error) {
-	s, err := getTokenString(tok)
+// --- [ Other expressions ] ---------------------------------------------------
+
+// NewICmpExpr returns a new icmp expression based on the given integer
+// condition code, type and operands.
+func NewICmpExpr(cond, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprICmp, error) {
+	c, ok := cond.(ast.IntPred)
+	if !ok {
+		return nil, errors.Errorf("invalid integer predicate type; expected ast.IntPred, got %T", cond)
+	}
+	x, err := NewConstant(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &IntLit{lit: s}, nil
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprICmp{Type: &ast.TypeDummy{}, Cond: c, X: x, Y: y}, nil
 }
 
-// FloatLit represents an floating-point literal.
-type FloatLit struct {
-	// Floating-point literal.
-	lit string
+// NewFCmpExpr returns a new fcmp expression based on the given floating-point
+// condition code, type and operands.
+func NewFCmpExpr(cond, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFCmp, error) {
+	c, ok := cond.(ast.FloatPred)
+	if !ok {
+		return nil, errors.Errorf("invalid floating-point predicate type; expected ast.FloatPred, got %T", cond)
+	}
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprFCmp{Type: &ast.TypeDummy{}, Cond: c, X: x, Y: y}, nil
 }
 
-// NewFloatLit returns a new floating-point literal based on the given floating-point  token.
-func NewFloatLit(tok interface{}) (*FloatLit, error) {
-	s, err := getTokenString(tok)
+// NewSeExpr returns a new select expression based on the given selection
+// condition type and value, and operands.
+func NewSelectExpr(condTyp, condVal, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSelect, error) {
+	cond, err := NewConstant(condTyp, condVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &FloatLit{lit: s}, nil
+	x, err := NewConstant(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewConstant(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.ExprSelect{Type: &ast.TypeDummy{}, Cond: cond, X: x, Y: y}, nil
 }
 
-// NullLit represents a null literal.
-type NullLit struct {
+// === [ Basic blocks ] ========================================================
+
+// NewBasicBlockList returns a new basic block list based on the given basic
+// block.
+func NewBasicBlockList(block interface{}) ([]*ast.BasicBlock, error) {
+	b, ok := block.(*ast.BasicBlock)
+	if !ok {
+		return nil, errors.Errorf("invalid basic block type; expected *ast.BasicBlock, got %T", block)
+	}
+	return []*ast.BasicBlock{b}, nil
 }
 
-// NewVectorConst returns a new vector constant based on the given elements.
-func NewVectorConst(elems interface{}) (*ast.VectorConst, error) {
-	es, ok := elems.([]ast.Constant)
+// AppendBasicBlock appends the given basic block to the basic block list.
+func AppendBasicBlock(blocks, block interface{}) ([]*ast.BasicBlock, error) {
+	bs, ok := blocks.([]*ast.BasicBlock)
 	if !ok {
-		return nil, errors.Errorf("invalid vector elements type; expected []ast.Constant, got %T", elems)
+		return nil, errors.Errorf("invalid basic block list type; expected []*ast.BasicBlock, got %T", blocks)
 	}
-	return &ast.VectorConst{Type: &ast.TypeDummy{}, Elems: es}, nil
+	b, ok := block.(*ast.BasicBlock)
+	if !ok {
+		return nil, errors.Errorf("invalid basic block type; expected *ast.BasicBlock, got %T", block)
+	}
+	return append(bs, b), nil
 }
 
-// NewArrayConst returns a new array constant based on the given elements.
-func NewArrayConst(elems interface{}) (*ast.ArrayConst, error) {
-	es, ok := elems.([]ast.Constant)
+// NewBasicBlock returns a new basic block based on the given label name, non-
+// branching instructions and terminator.
+func NewBasicBlock(name, insts, term interface{}) (*ast.BasicBlock, error) {
+	block := &ast.BasicBlock{}
+	switch name := name.(type) {
+	case *LabelIdent:
+		block.Name = name.name
+	case nil:
+		// unnamed basic block.
+	default:
+		return nil, errors.Errorf("invalid label name type; expected *astx.LabelIdent or nil, got %T", name)
+	}
+	var is []ast.Instruction
+	switch insts := insts.(type) {
+	case []ast.Instruction:
+		is = insts
+	case nil:
+		// no instructions.
+	default:
+		return nil, errors.Errorf("invalid instruction list type; expected []ast.Instruction, got %T", insts)
+	}
+	t, ok := term.(ast.Terminator)
 	if !ok {
-		return nil, errors.Errorf("invalid array elements type; expected []ast.Constant, got %T", elems)
+		return nil, errors.Errorf("invalid terminator type; expected ast.Terminator, got %T", term)
 	}
-	return &ast.ArrayConst{Type: &ast.TypeDummy{}, Elems: es}, nil
+	block.Insts = is
+	block.Term = t
+	return block, nil
 }
 
-// NewCharArrayConst returns a new character array constant based on the given
-// string.
-func NewCharArrayConst(str interface{}) (*ast.CharArrayConst, error) {
-	s, err := getTokenString(str)
+// === [ Instructions ] ========================================================
+
+// NewInstructionList returns a new instruction list based on the given
+// instruction.
+func NewInstructionList(inst interface{}) ([]ast.Instruction, error) {
+	i, ok := inst.(ast.Instruction)
+	if !ok {
+		return nil, errors.Errorf("invalid instruction type; expected ast.Instruction, got %T", inst)
+	}
+	return []ast.Instruction{i}, nil
+}
+
+// AppendInstruction appends the given instruction to the instruction list.
+func AppendInstruction(insts, inst interface{}) ([]ast.Instruction, error) {
+	is, ok := insts.([]ast.Instruction)
+	if !ok {
+		return nil, errors.Errorf("invalid instruction list type; expected []ast.Instruction, got %T", insts)
+	}
+	i, ok := inst.(ast.Instruction)
+	if !ok {
+		return nil, errors.Errorf("invalid instruction type; expected ast.Instruction, got %T", inst)
+	}
+	return append(is, i), nil
+}
+
+// NewNamedInstruction returns a named instruction based on the given local
+// variable name and instruction.
+func NewNamedInstruction(name, inst interface{}) (ast.Instruction, error) {
+	// namedInstruction represents a namedInstruction instruction.
+	type namedInstruction interface {
+		ast.Instruction
+		ast.NamedValue
+	}
+	n, ok := name.(*LocalIdent)
+	if !ok {
+		return nil, errors.Errorf("invalid local variable name type; expected *astx.LocalIdent, got %T", name)
+	}
+	i, ok := inst.(namedInstruction)
+	if !ok {
+		return nil, errors.Errorf("invalid instruction type; expected namedInstruction, got %T", inst)
+	}
+	i.SetName(n.name)
+	return i, nil
+}
+
+// --- [ Binary instructions ] -------------------------------------------------
+
+// NewAddInst returns a new add instruction based on the given overflow flags,
+// type, operands and metadata attachments.
+func NewAddInst(overflow, typ, xVal, yVal, mds interface{}) (*ast.InstAdd, error) {
+	x, err := NewValue(typ, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstAdd{X: x, Y: y, OverflowFlags: flags, Metadata: md}, nil
+}
+
+// NewFAddInst returns a new fadd instruction based on the given fast-math
+// flags, type, operands and metadata attachments.
+func NewFAddInst(fastmath, typ, xVal, yVal, mds interface{}) (*ast.InstFAdd, error) {
+	x, err := NewValue(typ, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getFastMathFlags(fastmath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstFAdd{X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
+}
+
+// NewSubInst returns a new sub instruction based on the given overflow flags,
+// type, operands and metadata attachments.
+func NewSubInst(overflow, typ, xVal, yVal, mds interface{}) (*ast.InstSub, error) {
+	x, err := NewValue(typ, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	// Skip double-quotes.
-	s = s[1 : len(s)-1]
-	s = enc.Unescape(s)
-	c := &ast.CharArrayConst{Type: &ast.TypeDummy{}, Lit: s}
-	return c, nil
+	return &ast.InstSub{X: x, Y: y, OverflowFlags: flags, Metadata: md}, nil
 }
 
-// NewStructConst returns a new struct constant based on the given fields.
-func NewStructConst(fields interface{}) (*ast.StructConst, error) {
-	fs, ok := fields.([]ast.Constant)
-	if !ok {
-		return nil, errors.Errorf("invalid struct fields type; expected []ast.Constant, got %T", fields)
+// NewFSubInst returns a new fsub instruction based on the given fast-math
+// flags, type, operands and metadata attachments.
+func NewFSubInst(fastmath, typ, xVal, yVal, mds interface{}) (*ast.InstFSub, error) {
+	x, err := NewValue(typ, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.StructConst{Type: &ast.TypeDummy{}, Fields: fs}, nil
-}
-
-// ZeroInitializerLit represents a zeroinitializer literal.
-type ZeroInitializerLit struct {
-}
-
-// --- [ Binary expressions ] --------------------------------------------------
-
-// NewAddExpr returns a new add expression based on the given type and operands.
-func NewAddExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAdd, error) {
-	x, err := NewConstant(xTyp, xVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	flags, err := getFastMathFlags(fastmath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprAdd{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstFSub{X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
 }
 
-// NewFAddExpr returns a new fadd expression based on the given type and
-// operands.
-func NewFAddExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFAdd, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewMulInst returns a new mul instruction based on the given overflow flags,
+// type, operands and metadata attachments.
+func NewMulInst(overflow, typ, xVal, yVal, mds interface{}) (*ast.InstMul, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFAdd{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
-}
-
-// NewSubExpr returns a new sub expression based on the given type and operands.
-func NewSubExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSub, error) {
-	x, err := NewConstant(xTyp, xVal)
+	flags, err := getOverflowFlags(overflow)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprSub{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstMul{X: x, Y: y, OverflowFlags: flags, Metadata: md}, nil
 }
 
-// NewFSubExpr returns a new fsub expression based on the given type and
-// operands.
-func NewFSubExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFSub, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewFMulInst returns a new fmul instruction based on the given fast-math
+// flags, type, operands and metadata attachments.
+func NewFMulInst(fastmath, typ, xVal, yVal, mds interface{}) (*ast.InstFMul, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFSub{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
-}
-
-// NewMulExpr returns a new mul expression based on the given type and operands.
-func NewMulExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprMul, error) {
-	x, err := NewConstant(xTyp, xVal)
+	flags, err := getFastMathFlags(fastmath)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprMul{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstFMul{X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
 }
 
-// NewFMulExpr returns a new fmul expression based on the given type and
-// operands.
-func NewFMulExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFMul, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewUDivInst returns a new udiv instruction based on the given exact flag,
+// type, operands and metadata attachments.
+func NewUDivInst(exact, typ, xVal, yVal, mds interface{}) (*ast.InstUDiv, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFMul{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
-}
-
-// NewUDivExpr returns a new udiv expression based on the given type and
-// operands.
-func NewUDivExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprUDiv, error) {
-	x, err := NewConstant(xTyp, xVal)
+	e, err := getFlag(exact)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprUDiv{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstUDiv{X: x, Y: y, Exact: e, Metadata: md}, nil
 }
 
-// NewSDivExpr returns a new sdiv expression based on the given type and
-// operands.
-func NewSDivExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSDiv, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewSDivInst returns a new sdiv instruction based on the given exact flag,
+// type, operands and metadata attachments.
+func NewSDivInst(exact, typ, xVal, yVal, mds interface{}) (*ast.InstSDiv, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprSDiv{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstSDiv{X: x, Y: y, Exact: e, Metadata: md}, nil
 }
 
-// NewFDivExpr returns a new fdiv expression based on the given type and
-// operands.
-func NewFDivExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFDiv, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewFDivInst returns a new fdiv instruction based on the given fast-math
+// flags, type, operands and metadata attachments.
+func NewFDivInst(fastmath, typ, xVal, yVal, mds interface{}) (*ast.InstFDiv, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFDiv{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	flags, err := getFastMathFlags(fastmath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstFDiv{X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
 }
 
-// NewURemExpr returns a new urem expression based on the given type and
-// operands.
-func NewURemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprURem, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewURemInst returns a new urem instruction based on the given type, operands
+// and metadata attachments.
+func NewURemInst(typ, xVal, yVal, mds interface{}) (*ast.InstURem, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprURem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstURem{X: x, Y: y, Metadata: md}, nil
 }
 
-// NewSRemExpr returns a new srem expression based on the given type and
-// operands.
-func NewSRemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSRem, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewSRemInst returns a new srem instruction based on the given type, operands
+// and metadata attachments.
+func NewSRemInst(typ, xVal, yVal, mds interface{}) (*ast.InstSRem, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprSRem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstSRem{X: x, Y: y, Metadata: md}, nil
 }
 
-// NewFRemExpr returns a new frem expression based on the given type and
-// operands.
-func NewFRemExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFRem, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewFRemInst returns a new frem instruction based on the given fast-math
+// flags, type, operands and metadata attachments.
+func NewFRemInst(fastmath, typ, xVal, yVal, mds interface{}) (*ast.InstFRem, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFRem{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	flags, err := getFastMathFlags(fastmath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstFRem{X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
 }
 
-// --- [ Bitwise expressions ] -------------------------------------------------
+// NewOverflowFlagList returns a new overflow flag list based on the given
+// overflow flag.
+func NewOverflowFlagList(flag interface{}) ([]ast.OverflowFlag, error) {
+	f, ok := flag.(ast.OverflowFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid overflow flag type; expected ast.OverflowFlag, got %T", flag)
+	}
+	return []ast.OverflowFlag{f}, nil
+}
 
-// NewShlExpr returns a new shl expression based on the given type and operands.
-func NewShlExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprShl, error) {
-	x, err := NewConstant(xTyp, xVal)
+// AppendOverflowFlag appends the given overflow flag to the overflow flag
+// list.
+func AppendOverflowFlag(flags, flag interface{}) ([]ast.OverflowFlag, error) {
+	fs, ok := flags.([]ast.OverflowFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid overflow flag list type; expected []ast.OverflowFlag, got %T", flags)
+	}
+	f, ok := flag.(ast.OverflowFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid overflow flag type; expected ast.OverflowFlag, got %T", flag)
+	}
+	return append(fs, f), nil
+}
+
+// NewFastMathFlagList returns a new fast-math flag list based on the given
+// fast-math flag.
+func NewFastMathFlagList(flag interface{}) ([]ast.FastMathFlag, error) {
+	f, ok := flag.(ast.FastMathFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid fast-math flag type; expected ast.FastMathFlag, got %T", flag)
+	}
+	return []ast.FastMathFlag{f}, nil
+}
+
+// AppendFastMathFlag appends the given fast-math flag to the fast-math flag
+// list.
+func AppendFastMathFlag(flags, flag interface{}) ([]ast.FastMathFlag, error) {
+	fs, ok := flags.([]ast.FastMathFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid fast-math flag list type; expected []ast.FastMathFlag, got %T", flags)
+	}
+	f, ok := flag.(ast.FastMathFlag)
+	if !ok {
+		return nil, errors.Errorf("invalid fast-math flag type; expected ast.FastMathFlag, got %T", flag)
+	}
+	return append(fs, f), nil
+}
+
+// --- [ Bitwise instructions ] ------------------------------------------------
+
+// NewShlInst returns a new shl instruction based on the given overflow flags,
+// type, operands and metadata attachments.
+func NewShlInst(overflow, typ, xVal, yVal, mds interface{}) (*ast.InstShl, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprShl{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	flags, err := getOverflowFlags(overflow)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstShl{X: x, Y: y, OverflowFlags: flags, Metadata: md}, nil
 }
 
-// NewLShrExpr returns a new lshr expression based on the given type and
-// operands.
-func NewLShrExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprLShr, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewLShrInst returns a new lshr instruction based on the given exact flag,
+// type, operands and metadata attachments.
+func NewLShrInst(exact, typ, xVal, yVal, mds interface{}) (*ast.InstLShr, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprLShr{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstLShr{X: x, Y: y, Exact: e, Metadata: md}, nil
 }
 
-// NewAShrExpr returns a new ashr expression based on the given type and
-// operands.
-func NewAShrExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAShr, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewAShrInst returns a new ashr instruction based on the given exact flag,
+// type, operands and metadata attachments.
+func NewAShrInst(exact, typ, xVal, yVal, mds interface{}) (*ast.InstAShr, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	e, err := getFlag(exact)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprAShr{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	return &ast.InstAShr{X: x, Y: y, Exact: e, Metadata: md}, nil
 }
 
-// NewAndExpr returns a new and expression based on the given type and operands.
-func NewAndExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprAnd, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewAndInst returns a new and instruction based on the given type, operands
+// and metadata attachments.
+func NewAndInst(typ, xVal, yVal, mds interface{}) (*ast.InstAnd, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprAnd{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstAnd{X: x, Y: y, Metadata: md}, nil
 }
 
-// NewOrExpr returns a new or expression based on the given type and operands.
-func NewOrExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprOr, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewOrInst returns a new or instruction based on the given type, operands and
+// metadata attachments.
+func NewOrInst(typ, xVal, yVal, mds interface{}) (*ast.InstOr, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprOr{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstOr{X: x, Y: y, Metadata: md}, nil
 }
 
-// NewXorExpr returns a new xor expression based on the given type and operands.
-func NewXorExpr(xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprXor, error) {
-	x, err := NewConstant(xTyp, xVal)
+// NewXorInst returns a new xor instruction based on the given type, operands
+// and metadata attachments.
+func NewXorInst(typ, xVal, yVal, mds interface{}) (*ast.InstXor, error) {
+	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	y, err := NewValue(typ, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprXor{Type: &ast.TypeDummy{}, X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstXor{X: x, Y: y, Metadata: md}, nil
 }
 
-// --- [ Memory expressions ] --------------------------------------------------
+// --- [ Vector instructions ] -------------------------------------------------
 
-// NewGetElementPtrExpr returns a new getelementptr expression based on the
-// given element type, source address type and value, and element indices.
-func NewGetElementPtrExpr(elem, srcTyp, srcVal, indices interface{}) (*ast.ExprGetElementPtr, error) {
-	e, ok := elem.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+// NewExtractElementInst returns a new extractelement instruction based on the
+// given vector, index and metadata attachments.
+func NewExtractElementInst(xTyp, xVal, indexTyp, indexVal, mds interface{}) (*ast.InstExtractElement, error) {
+	x, err := NewValue(xTyp, xVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	src, err := NewConstant(srcTyp, srcVal)
+	index, err := NewValue(indexTyp, indexVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var is []ast.Constant
-	switch indices := indices.(type) {
-	case []ast.Constant:
-		is = indices
-	case nil:
-		// no indices.
-	default:
-		return nil, errors.Errorf("invalid indices type; expected []ast.Constant or nil, got %T", indices)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprGetElementPtr{Type: &ast.TypeDummy{}, Elem: e, Src: src, Indices: is}, nil
+	return &ast.InstExtractElement{X: x, Index: index, Metadata: md}, nil
 }
 
-// --- [ Conversion expressions ] ----------------------------------------------
-
-// NewTruncExpr returns a new trunc expression based on the given source value
-// and target type.
-func NewTruncExpr(fromTyp, fromVal, to interface{}) (*ast.ExprTrunc, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewInsertElementInst returns a new insertelement instruction based on the
+// given vector, element, index and metadata attachments.
+func NewInsertElementInst(xTyp, xVal, elemTyp, elemVal, indexTyp, indexVal, mds interface{}) (*ast.InstInsertElement, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	elem, err := NewValue(elemTyp, elemVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprTrunc{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	index, err := NewValue(indexTyp, indexVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstInsertElement{X: x, Elem: elem, Index: index, Metadata: md}, nil
 }
 
-// NewZExtExpr returns a new zext expression based on the given source value and
-// target type.
-func NewZExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprZExt, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewShuffleVectorInst returns a new shufflevector instruction based on the
+// given vectors, shuffle mask and metadata attachments.
+func NewShuffleVectorInst(xTyp, xVal, yTyp, yVal, maskTyp, maskVal, mds interface{}) (*ast.InstShuffleVector, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	y, err := NewValue(yTyp, yVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprZExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
-}
-
-// NewSExtExpr returns a new sext expression based on the given source value and
-// target type.
-func NewSExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprSExt, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+	mask, err := NewValue(maskTyp, maskVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprSExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	return &ast.InstShuffleVector{X: x, Y: y, Mask: mask, Metadata: md}, nil
 }
 
-// NewFPTruncExpr returns a new fptrunc expression based on the given source
-// value and target type.
-func NewFPTruncExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPTrunc, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// --- [ Aggregate instructions ] ----------------------------------------------
+
+// NewExtractValueInst returns a new extractvalue instruction based on the given
+// aggregate value, indices and metadata attachments.
+func NewExtractValueInst(xTyp, xVal, indices, mds interface{}) (*ast.InstExtractValue, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	is, ok := indices.([]int64)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid indices type; expected []int64, got %T", indices)
 	}
-	return &ast.ExprFPTrunc{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstExtractValue{X: x, Indices: is, Metadata: md}, nil
 }
 
-// NewFPExtExpr returns a new fpext expression based on the given source value
-// and target type.
-func NewFPExtExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPExt, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewInsertValueInst returns a new insertvalue instruction based on the given
+// aggregate value, element, indices and metadata attachments.
+func NewInsertValueInst(xTyp, xVal, elemTyp, elemVal, indices, mds interface{}) (*ast.InstInsertValue, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	elem, err := NewValue(elemTyp, elemVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	is, ok := indices.([]int64)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid indices type; expected []int64, got %T", indices)
 	}
-	return &ast.ExprFPExt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
-}
-
-// NewFPToUIExpr returns a new fptoui expression based on the given source value
-// and target type.
-func NewFPToUIExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPToUI, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	return &ast.InstInsertValue{X: x, Elem: elem, Indices: is, Metadata: md}, nil
+}
+
+// --- [ Memory instructions ] -------------------------------------------------
+
+// NewAllocaInst returns a new alloca instruction based on the given element
+// type, number of elements and metadata attachments.
+func NewAllocaInst(elem, nelems, mds interface{}) (*ast.InstAlloca, error) {
+	e, ok := elem.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
 	}
-	return &ast.ExprFPToUI{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	inst := &ast.InstAlloca{Elem: e}
+	switch nelems := nelems.(type) {
+	case ast.Value:
+		inst.NElems = nelems
+	case nil:
+		// no nelems.
+	default:
+		return nil, errors.Errorf("invalid number of elements type; expected ast.Value or nil, got %T", nelems)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	inst.Metadata = md
+	return inst, nil
 }
 
-// NewFPToSIExpr returns a new fptosi expression based on the given source value
-// and target type.
-func NewFPToSIExpr(fromTyp, fromVal, to interface{}) (*ast.ExprFPToSI, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewLoadInst returns a new load instruction based on the given volatile flag,
+// element type, source address type and value, alignment and metadata
+// attachments.
+func NewLoadInst(volatile, elem, srcTyp, srcVal, align, mds interface{}) (*ast.InstLoad, error) {
+	v, err := getFlag(volatile)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	e, ok := elem.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
 	}
-	return &ast.ExprFPToSI{Type: &ast.TypeDummy{}, From: from, To: t}, nil
-}
-
-// NewUIToFPExpr returns a new uitofp expression based on the given source value
-// and target type.
-func NewUIToFPExpr(fromTyp, fromVal, to interface{}) (*ast.ExprUIToFP, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+	src, err := NewValue(srcTyp, srcVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	a, err := getAlign(align)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprUIToFP{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// Store e in InstLoad to evaluate against src.Type().Elem() after type
+	// resolution.
+	return &ast.InstLoad{Elem: e, Src: src, Volatile: v, Align: a, Metadata: md}, nil
 }
 
-// NewSIToFPExpr returns a new sitofp expression based on the given source value
-// and target type.
-func NewSIToFPExpr(fromTyp, fromVal, to interface{}) (*ast.ExprSIToFP, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewAtomicLoadInst returns a new atomic load instruction based on the given
+// volatile flag, element type, source address type and value, synchronization
+// scope, atomic memory ordering constraints, alignment and metadata
+// attachments.
+func NewAtomicLoadInst(volatile, elem, srcTyp, srcVal, syncScope, ordering, align, mds interface{}) (*ast.InstLoad, error) {
+	inst, err := NewLoadInst(volatile, elem, srcTyp, srcVal, align, mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	s, err := getSyncScope(syncScope)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	o, ok := ordering.(ast.AtomicOrdering)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid atomic memory ordering type; expected ast.AtomicOrdering, got %T", ordering)
 	}
-	return &ast.ExprSIToFP{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	inst.SyncScope = s
+	inst.Ordering = o
+	return inst, nil
 }
 
-// NewPtrToIntExpr returns a new ptrtoint expression based on the given source
-// value and target type.
-func NewPtrToIntExpr(fromTyp, fromVal, to interface{}) (*ast.ExprPtrToInt, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewStoreInst returns a new store instruction based on the given volatile
+// flag, source value type and value, destination address type and value,
+// alignment and metadata attachments.
+func NewStoreInst(volatile, srcTyp, srcVal, dstTyp, dstVal, align, mds interface{}) (*ast.InstStore, error) {
+	v, err := getFlag(volatile)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	src, err := NewValue(srcTyp, srcVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprPtrToInt{Type: &ast.TypeDummy{}, From: from, To: t}, nil
-}
-
-// NewIntToPtrExpr returns a new inttoptr expression based on the given source
-// value and target type.
-func NewIntToPtrExpr(fromTyp, fromVal, to interface{}) (*ast.ExprIntToPtr, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+	dst, err := NewValue(dstTyp, dstVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	a, err := getAlign(align)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprIntToPtr{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstStore{Src: src, Dst: dst, Volatile: v, Align: a, Metadata: md}, nil
 }
 
-// NewBitCastExpr returns a new bitcast expression based on the given source
-// value and target type.
-func NewBitCastExpr(fromTyp, fromVal, to interface{}) (*ast.ExprBitCast, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewAtomicStoreInst returns a new atomic store instruction based on the given
+// volatile flag, source value type and value, destination address type and
+// value, synchronization scope, atomic memory ordering constraints, alignment
+// and metadata attachments.
+func NewAtomicStoreInst(volatile, srcTyp, srcVal, dstTyp, dstVal, syncScope, ordering, align, mds interface{}) (*ast.InstStore, error) {
+	inst, err := NewStoreInst(volatile, srcTyp, srcVal, dstTyp, dstVal, align, mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	s, err := getSyncScope(syncScope)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	o, ok := ordering.(ast.AtomicOrdering)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid atomic memory ordering type; expected ast.AtomicOrdering, got %T", ordering)
 	}
-	return &ast.ExprBitCast{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	inst.SyncScope = s
+	inst.Ordering = o
+	return inst, nil
 }
 
-// NewAddrSpaceCastExpr returns a new addrspacecast expression based on the
-// given source value and target type.
-func NewAddrSpaceCastExpr(fromTyp, fromVal, to interface{}) (*ast.ExprAddrSpaceCast, error) {
-	from, err := NewConstant(fromTyp, fromVal)
+// NewFenceInst returns a new fence instruction based on the given
+// synchronization scope, atomic memory ordering constraints and metadata
+// attachments.
+func NewFenceInst(syncScope, ordering, mds interface{}) (*ast.InstFence, error) {
+	s, err := getSyncScope(syncScope)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	o, ok := ordering.(ast.AtomicOrdering)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid atomic memory ordering type; expected ast.AtomicOrdering, got %T", ordering)
 	}
-	return &ast.ExprAddrSpaceCast{Type: &ast.TypeDummy{}, From: from, To: t}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstFence{Ordering: o, SyncScope: s, Metadata: md}, nil
 }
 
-// --- [ Other expressions ] ---------------------------------------------------
-
-// NewICmpExpr returns a new icmp expression based on the given integer
-// condition code, type and operands.
-func NewICmpExpr(cond, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprICmp, error) {
-	c, ok := cond.(ast.IntPred)
-	if !ok {
-		return nil, errors.Errorf("invalid integer predicate type; expected ast.IntPred, got %T", cond)
+// NewCmpXchgInst returns a new cmpxchg instruction based on the given weak and
+// volatile flags, address type and value, type and value to compare against,
+// type and value of the new value, synchronization scope, and atomic memory
+// ordering constraints on success and failure, and metadata attachments.
+func NewCmpXchgInst(weak, volatile, ptrTyp, ptrVal, cmpTyp, cmpVal, newTyp, newVal, syncScope, success, failure, mds interface{}) (*ast.InstCmpXchg, error) {
+	w, err := getFlag(weak)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	x, err := NewConstant(xTyp, xVal)
+	v, err := getFlag(volatile)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	ptr, err := NewValue(ptrTyp, ptrVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprICmp{Type: &ast.TypeDummy{}, Cond: c, X: x, Y: y}, nil
-}
-
-// NewFCmpExpr returns a new fcmp expression based on the given floating-point
-// condition code, type and operands.
-func NewFCmpExpr(cond, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprFCmp, error) {
-	c, ok := cond.(ast.FloatPred)
-	if !ok {
-		return nil, errors.Errorf("invalid floating-point predicate type; expected ast.FloatPred, got %T", cond)
+	cmp, err := NewValue(cmpTyp, cmpVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	x, err := NewConstant(xTyp, xVal)
+	n, err := NewValue(newTyp, newVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	s, err := getSyncScope(syncScope)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprFCmp{Type: &ast.TypeDummy{}, Cond: c, X: x, Y: y}, nil
+	succ, ok := success.(ast.AtomicOrdering)
+	if !ok {
+		return nil, errors.Errorf("invalid success atomic memory ordering type; expected ast.AtomicOrdering, got %T", success)
+	}
+	fail, ok := failure.(ast.AtomicOrdering)
+	if !ok {
+		return nil, errors.Errorf("invalid failure atomic memory ordering type; expected ast.AtomicOrdering, got %T", failure)
+	}
+	inst := &ast.InstCmpXchg{
+		Ptr:       ptr,
+		Cmp:       cmp,
+		New:       n,
+		Success:   succ,
+		Failure:   fail,
+		Weak:      w,
+		Volatile:  v,
+		SyncScope: s,
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	inst.Metadata = md
+	return inst, nil
 }
 
-// NewSeExpr returns a new select expression based on the given selection
-// condition type and value, and operands.
-func NewSelectExpr(condTyp, condVal, xTyp, xVal, yTyp, yVal interface{}) (*ast.ExprSelect, error) {
-	cond, err := NewConstant(condTyp, condVal)
+// NewAtomicRMWInst returns a new atomicrmw instruction based on the given
+// volatile flag, atomic operation, address type and value, operand type and
+// value, synchronization scope, atomic memory ordering constraints and metadata
+// attachments.
+func NewAtomicRMWInst(volatile, op, ptrTyp, ptrVal, xTyp, xVal, syncScope, ordering, mds interface{}) (*ast.InstAtomicRMW, error) {
+	v, err := getFlag(volatile)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	x, err := NewConstant(xTyp, xVal)
+	o, ok := op.(ast.AtomicOp)
+	if !ok {
+		return nil, errors.Errorf("invalid atomic operation type; expected ast.AtomicOp, got %T", op)
+	}
+	ptr, err := NewValue(ptrTyp, ptrVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewConstant(yTyp, yVal)
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.ExprSelect{Type: &ast.TypeDummy{}, Cond: cond, X: x, Y: y}, nil
-}
-
-// === [ Basic blocks ] ========================================================
-
-// NewBasicBlockList returns a new basic block list based on the given basic
-// block.
-func NewBasicBlockList(block interface{}) ([]*ast.BasicBlock, error) {
-	b, ok := block.(*ast.BasicBlock)
-	if !ok {
-		return nil, errors.Errorf("invalid basic block type; expected *ast.BasicBlock, got %T", block)
+	s, err := getSyncScope(syncScope)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return []*ast.BasicBlock{b}, nil
-}
-
-// AppendBasicBlock appends the given basic block to the basic block list.
-func AppendBasicBlock(blocks, block interface{}) ([]*ast.BasicBlock, error) {
-	bs, ok := blocks.([]*ast.BasicBlock)
+	ord, ok := ordering.(ast.AtomicOrdering)
 	if !ok {
-		return nil, errors.Errorf("invalid basic block list type; expected []*ast.BasicBlock, got %T", blocks)
+		return nil, errors.Errorf("invalid atomic memory ordering type; expected ast.AtomicOrdering, got %T", ordering)
 	}
-	b, ok := block.(*ast.BasicBlock)
-	if !ok {
-		return nil, errors.Errorf("invalid basic block type; expected *ast.BasicBlock, got %T", block)
+	inst := &ast.InstAtomicRMW{
+		Op:        o,
+		Ptr:       ptr,
+		X:         x,
+		Ordering:  ord,
+		Volatile:  v,
+		SyncScope: s,
 	}
-	return append(bs, b), nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	inst.Metadata = md
+	return inst, nil
 }
 
-// NewBasicBlock returns a new basic block based on the given label name, non-
-// branching instructions and terminator.
-func NewBasicBlock(name, insts, term interface{}) (*ast.BasicBlock, error) {
-	block := &ast.BasicBlock{}
-	switch name := name.(type) {
-	case *LabelIdent:
-		block.Name = name.name
-	case nil:
-		// unnamed basic block.
-	default:
-		return nil, errors.Errorf("invalid label name type; expected *astx.LabelIdent or nil, got %T", name)
+// NewGetElementPtrInst returns a new getelementptr instruction based on the
+// given element type, source address type and value, and element indices, and
+// metadata attachments.
+func NewGetElementPtrInst(elem, srcTyp, srcVal, indices, mds interface{}) (*ast.InstGetElementPtr, error) {
+	e, ok := elem.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
 	}
-	var is []ast.Instruction
-	switch insts := insts.(type) {
-	case []ast.Instruction:
-		is = insts
+	src, err := NewValue(srcTyp, srcVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var is []ast.Value
+	switch indices := indices.(type) {
+	case []ast.Value:
+		is = indices
 	case nil:
-		// no instructions.
+		// no indices.
 	default:
-		return nil, errors.Errorf("invalid instruction list type; expected []ast.Instruction, got %T", insts)
+		return nil, errors.Errorf("invalid indices type; expected []ast.Value or nil, got %T", indices)
 	}
-	t, ok := term.(ast.Terminator)
-	if !ok {
-		return nil, errors.Errorf("invalid terminator type; expected ast.Terminator, got %T", term)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	block.Insts = is
-	block.Term = t
-	return block, nil
+	// Store e in InstGetElementPtr to evaluate against src.Type().Elem() after
+	// type resolution.
+	return &ast.InstGetElementPtr{Elem: e, Src: src, Indices: is, Metadata: md}, nil
 }
 
-// === [ Instructions ] ========================================================
+// --- [ Conversion instructions ] ---------------------------------------------
 
-// NewInstructionList returns a new instruction list based on the given
-// instruction.
-func NewInstructionList(inst interface{}) ([]ast.Instruction, error) {
-	i, ok := inst.(ast.Instruction)
-	if !ok {
-		return nil, errors.Errorf("invalid instruction type; expected ast.Instruction, got %T", inst)
+// NewTruncInst returns a new trunc instruction based on the given source value,
+// target type and metadata attachments.
+func NewTruncInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstTrunc, error) {
+	from, err := NewValue(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return []ast.Instruction{i}, nil
-}
-
-// AppendInstruction appends the given instruction to the instruction list.
-func AppendInstruction(insts, inst interface{}) ([]ast.Instruction, error) {
-	is, ok := insts.([]ast.Instruction)
+	t, ok := to.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid instruction list type; expected []ast.Instruction, got %T", insts)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
 	}
-	i, ok := inst.(ast.Instruction)
-	if !ok {
-		return nil, errors.Errorf("invalid instruction type; expected ast.Instruction, got %T", inst)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return append(is, i), nil
+	return &ast.InstTrunc{From: from, To: t, Metadata: md}, nil
 }
 
-// NewNamedInstruction returns a named instruction based on the given local
-// variable name and instruction.
-func NewNamedInstruction(name, inst interface{}) (ast.Instruction, error) {
-	// namedInstruction represents a namedInstruction instruction.
-	type namedInstruction interface {
-		ast.Instruction
-		ast.NamedValue
+// NewZExtInst returns a new zext instruction based on the given source value,
+// target type and metadata attachments.
+func NewZExtInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstZExt, error) {
+	from, err := NewValue(fromTyp, fromVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	n, ok := name.(*LocalIdent)
+	t, ok := to.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid local variable name type; expected *astx.LocalIdent, got %T", name)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
 	}
-	i, ok := inst.(namedInstruction)
-	if !ok {
-		return nil, errors.Errorf("invalid instruction type; expected namedInstruction, got %T", inst)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	i.SetName(n.name)
-	return i, nil
+	return &ast.InstZExt{From: from, To: t, Metadata: md}, nil
 }
 
-// --- [ Binary instructions ] -------------------------------------------------
-
-// NewAddInst returns a new add instruction based on the given type and
-// operands.
-func NewAddInst(typ, xVal, yVal interface{}) (*ast.InstAdd, error) {
-	x, err := NewValue(typ, xVal)
+// NewSExtInst returns a new sext instruction based on the given source value,
+// target type and metadata attachments.
+func NewSExtInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstSExt, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstAdd{X: x, Y: y}, nil
+	return &ast.InstSExt{From: from, To: t, Metadata: md}, nil
 }
 
-// NewFAddInst returns a new fadd instruction based on the given type and
-// operands.
-func NewFAddInst(typ, xVal, yVal interface{}) (*ast.InstFAdd, error) {
-	x, err := NewValue(typ, xVal)
+// NewFPTruncInst returns a new fptrunc instruction based on the given source
+// value, target type and metadata attachments.
+func NewFPTruncInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstFPTrunc, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFAdd{X: x, Y: y}, nil
+	return &ast.InstFPTrunc{From: from, To: t, Metadata: md}, nil
 }
 
-// NewSubInst returns a new sub instruction based on the given type and
-// operands.
-func NewSubInst(typ, xVal, yVal interface{}) (*ast.InstSub, error) {
-	x, err := NewValue(typ, xVal)
+// NewFPExtInst returns a new fpext instruction based on the given source value,
+// target type and metadata attachments.
+func NewFPExtInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstFPExt, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstSub{X: x, Y: y}, nil
+	return &ast.InstFPExt{From: from, To: t, Metadata: md}, nil
 }
 
-// NewFSubInst returns a new fsub instruction based on the given type and
-// operands.
-func NewFSubInst(typ, xVal, yVal interface{}) (*ast.InstFSub, error) {
-	x, err := NewValue(typ, xVal)
+// NewFPToUIInst returns a new fptoui instruction based on the given source
+// value, target type and metadata attachments.
+func NewFPToUIInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstFPToUI, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFSub{X: x, Y: y}, nil
+	return &ast.InstFPToUI{From: from, To: t, Metadata: md}, nil
 }
 
-// NewMulInst returns a new mul instruction based on the given type and
-// operands.
-func NewMulInst(typ, xVal, yVal interface{}) (*ast.InstMul, error) {
-	x, err := NewValue(typ, xVal)
+// NewFPToSIInst returns a new fptosi instruction based on the given source
+// value, target type and metadata attachments.
+func NewFPToSIInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstFPToSI, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstMul{X: x, Y: y}, nil
+	return &ast.InstFPToSI{From: from, To: t, Metadata: md}, nil
 }
 
-// NewFMulInst returns a new fmul instruction based on the given type and
-// operands.
-func NewFMulInst(typ, xVal, yVal interface{}) (*ast.InstFMul, error) {
-	x, err := NewValue(typ, xVal)
+// NewUIToFPInst returns a new uitofp instruction based on the given source
+// value, target type and metadata attachments.
+func NewUIToFPInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstUIToFP, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFMul{X: x, Y: y}, nil
+	return &ast.InstUIToFP{From: from, To: t, Metadata: md}, nil
 }
 
-// NewUDivInst returns a new udiv instruction based on the given type and
-// operands.
-func NewUDivInst(typ, xVal, yVal interface{}) (*ast.InstUDiv, error) {
-	x, err := NewValue(typ, xVal)
+// NewSIToFPInst returns a new sitofp instruction based on the given source
+// value, target type and metadata attachments.
+func NewSIToFPInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstSIToFP, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstUDiv{X: x, Y: y}, nil
+	return &ast.InstSIToFP{From: from, To: t, Metadata: md}, nil
 }
 
-// NewSDivInst returns a new sdiv instruction based on the given type and
-// operands.
-func NewSDivInst(typ, xVal, yVal interface{}) (*ast.InstSDiv, error) {
-	x, err := NewValue(typ, xVal)
+// NewPtrToIntInst returns a new ptrtoint instruction based on the given source
+// value, target type and metadata attachments.
+func NewPtrToIntInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstPtrToInt, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstSDiv{X: x, Y: y}, nil
+	return &ast.InstPtrToInt{From: from, To: t, Metadata: md}, nil
 }
 
-// NewFDivInst returns a new fdiv instruction based on the given type and
-// operands.
-func NewFDivInst(typ, xVal, yVal interface{}) (*ast.InstFDiv, error) {
-	x, err := NewValue(typ, xVal)
+// NewIntToPtrInst returns a new inttoptr instruction based on the given source
+// value, target type and metadata attachments.
+func NewIntToPtrInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstIntToPtr, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFDiv{X: x, Y: y}, nil
+	return &ast.InstIntToPtr{From: from, To: t, Metadata: md}, nil
 }
 
-// NewURemInst returns a new urem instruction based on the given type and
-// operands.
-func NewURemInst(typ, xVal, yVal interface{}) (*ast.InstURem, error) {
-	x, err := NewValue(typ, xVal)
+// NewBitCastInst returns a new bitcast instruction based on the given source
+// value, target type and metadata attachments.
+func NewBitCastInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstBitCast, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstURem{X: x, Y: y}, nil
+	return &ast.InstBitCast{From: from, To: t, Metadata: md}, nil
 }
 
-// NewSRemInst returns a new srem instruction based on the given type and
-// operands.
-func NewSRemInst(typ, xVal, yVal interface{}) (*ast.InstSRem, error) {
-	x, err := NewValue(typ, xVal)
+// NewAddrSpaceCastInst returns a new addrspacecast instruction based on the
+// given source value, target type and metadata attachments.
+func NewAddrSpaceCastInst(fromTyp, fromVal, to, mds interface{}) (*ast.InstAddrSpaceCast, error) {
+	from, err := NewValue(fromTyp, fromVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	t, ok := to.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstSRem{X: x, Y: y}, nil
+	return &ast.InstAddrSpaceCast{From: from, To: t, Metadata: md}, nil
 }
 
-// NewFRemInst returns a new frem instruction based on the given type and
-// operands.
-func NewFRemInst(typ, xVal, yVal interface{}) (*ast.InstFRem, error) {
+// --- [ Other instructions ] --------------------------------------------------
+
+// NewICmpInst returns a new icmp instruction based on the given integer
+// condition code, type, operands and metadata attachments.
+func NewICmpInst(cond, typ, xVal, yVal, mds interface{}) (*ast.InstICmp, error) {
+	c, ok := cond.(ast.IntPred)
+	if !ok {
+		return nil, errors.Errorf("invalid integer predicate type; expected ast.IntPred, got %T", cond)
+	}
 	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -1757,14 +4461,21 @@ func NewFRemInst(typ, xVal, yVal interface{}) (*ast.InstFRem, error) {
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFRem{X: x, Y: y}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.InstICmp{Cond: c, X: x, Y: y, Metadata: md}, nil
 }
 
-// --- [ Bitwise instructions ] ------------------------------------------------
-
-// NewShlInst returns a new shl instruction based on the given type and
-// operands.
-func NewShlInst(typ, xVal, yVal interface{}) (*ast.InstShl, error) {
+// NewFCmpInst returns a new fcmp instruction based on the given fast-math
+// flags, floating-point condition code, type, operands and metadata
+// attachments.
+func NewFCmpInst(fastmath, cond, typ, xVal, yVal, mds interface{}) (*ast.InstFCmp, error) {
+	c, ok := cond.(ast.FloatPred)
+	if !ok {
+		return nil, errors.Errorf("invalid floating-point predicate type; expected ast.FloatPred, got %T", cond)
+	}
 	x, err := NewValue(typ, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -1773,459 +4484,484 @@ func NewShlInst(typ, xVal, yVal interface{}) (*ast.InstShl, error) {
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstShl{X: x, Y: y}, nil
-}
-
-// NewLShrInst returns a new lshr instruction based on the given type and
-// operands.
-func NewLShrInst(typ, xVal, yVal interface{}) (*ast.InstLShr, error) {
-	x, err := NewValue(typ, xVal)
+	flags, err := getFastMathFlags(fastmath)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstLShr{X: x, Y: y}, nil
+	return &ast.InstFCmp{Cond: c, X: x, Y: y, FastMathFlags: flags, Metadata: md}, nil
 }
 
-// NewAShrInst returns a new ashr instruction based on the given type and
-// operands.
-func NewAShrInst(typ, xVal, yVal interface{}) (*ast.InstAShr, error) {
-	x, err := NewValue(typ, xVal)
-	if err != nil {
-		return nil, errors.WithStack(err)
+// NewPhiInst returns a new phi instruction based on the given incoming value,
+// hiInst returns a new phi instruction based on the given incoming values and
+// metadata attachments.
+func NewPhiInst(typ, incs, mds interface{}) (*ast.InstPhi, error) {
+	t, ok := typ.(ast.Type)
+	if !ok {
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
 	}
-	y, err := NewValue(typ, yVal)
+	is, ok := incs.([]*ast.Incoming)
+	if !ok {
+		return nil, errors.Errorf("invalid incoming value list type; expected []*ast.Incoming, got %T", incs)
+	}
+	for _, inc := range is {
+		x, err := NewValue(t, inc.X)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		inc.X = x
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstAShr{X: x, Y: y}, nil
+	return &ast.InstPhi{Type: t, Incs: is, Metadata: md}, nil
 }
 
-// NewAndInst returns a new and instruction based on the given type and
-// operands.
-func NewAndInst(typ, xVal, yVal interface{}) (*ast.InstAnd, error) {
-	x, err := NewValue(typ, xVal)
+// NewIncomingList returns a new incoming value list based on the given incoming
+// value.
+func NewIncomingList(inc interface{}) ([]*ast.Incoming, error) {
+	i, ok := inc.(*ast.Incoming)
+	if !ok {
+		return nil, errors.Errorf("invalid incoming value type; expected *ast.Incoming, got %T", inc)
+	}
+	return []*ast.Incoming{i}, nil
+}
+
+// AppendIncoming appends the given incoming value to the incoming value list.
+func AppendIncoming(incs, inc interface{}) ([]*ast.Incoming, error) {
+	is, ok := incs.([]*ast.Incoming)
+	if !ok {
+		return nil, errors.Errorf("invalid incoming value list type; expected []*ast.Incoming, got %T", incs)
+	}
+	i, ok := inc.(*ast.Incoming)
+	if !ok {
+		return nil, errors.Errorf("invalid incoming value type; expected *ast.Incoming, got %T", inc)
+	}
+	return append(is, i), nil
+}
+
+// NewIncoming returns a new incoming value based on the given value and
+// predecessor basic block.
+func NewIncoming(x, pred interface{}) (*ast.Incoming, error) {
+	xx, err := NewValue(&ast.TypeDummy{}, x)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	pp, err := NewValue(&ast.TypeDummy{}, pred)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstAnd{X: x, Y: y}, nil
+	p, ok := pp.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid predecessor type; expected ast.NamedValue, got %T", pp)
+	}
+	return &ast.Incoming{X: xx, Pred: p}, nil
 }
 
-// NewOrInst returns a new or instruction based on the given type and
-// operands.
-func NewOrInst(typ, xVal, yVal interface{}) (*ast.InstOr, error) {
-	x, err := NewValue(typ, xVal)
+// NewSelect returns a new select instruction based on the given selection
+// condition type and value, operands and metadata attachments.
+func NewSelectInst(condTyp, condVal, xTyp, xVal, yTyp, yVal, mds interface{}) (*ast.InstSelect, error) {
+	cond, err := NewValue(condTyp, condVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstOr{X: x, Y: y}, nil
-}
-
-// NewXorInst returns a new xor instruction based on the given type and
-// operands.
-func NewXorInst(typ, xVal, yVal interface{}) (*ast.InstXor, error) {
-	x, err := NewValue(typ, xVal)
+	y, err := NewValue(yTyp, yVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstXor{X: x, Y: y}, nil
+	return &ast.InstSelect{Cond: cond, X: x, Y: y, Metadata: md}, nil
 }
 
-// --- [ Memory instructions ] -------------------------------------------------
-
-// NewAllocaInst returns a new alloca instruction based on the given element
-// type and number of elements.
-func NewAllocaInst(elem, nelems interface{}) (*ast.InstAlloca, error) {
-	e, ok := elem.(ast.Type)
+// NewCallInst returns a new call instruction based on the given fast-math
+// flags, return value attributes, return type, callee name, function arguments,
+// function attributes and metadata attachments.
+func NewCallInst(fastmath, retAttrs, retTyp, callee, args, funcAttrs, mds interface{}) (*ast.InstCall, error) {
+	r, ok := retTyp.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+		return nil, errors.Errorf("invalid return type; expected ast.Type, got %T", retTyp)
+	}
+	cc, err := NewValue(&ast.TypeDummy{}, callee)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c, ok := cc.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid callee type; expected ast.NamedValue, got %T", cc)
 	}
-	inst := &ast.InstAlloca{Elem: e}
-	switch nelems := nelems.(type) {
-	case ast.Value:
-		inst.NElems = nelems
+	var as []ast.Value
+	switch args := args.(type) {
+	case []ast.Value:
+		as = args
 	case nil:
-		// no nelems.
+		// no arguments.
 	default:
-		return nil, errors.Errorf("invalid number of elements type; expected ast.Value or nil, got %T", nelems)
+		return nil, errors.Errorf("invalid function arguments type; expected []ast.Value or nil, got %T", args)
 	}
-	return inst, nil
-}
-
-// NewLoadInst returns a new load instruction based on the given element type,
-// source address type and value.
-func NewLoadInst(elem, srcTyp, srcVal interface{}) (*ast.InstLoad, error) {
-	e, ok := elem.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+	flags, err := getFastMathFlags(fastmath)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	src, err := NewValue(srcTyp, srcVal)
+	ras, err := getParamAttrs(retAttrs)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	// Store e in InstLoad to evaluate against src.Type().Elem() after type
-	// resolution.
-	return &ast.InstLoad{Elem: e, Src: src}, nil
-}
-
-// NewStoreInst returns a new store instruction based on the given element type,
-// source address type and value.
-func NewStoreInst(srcTyp, srcVal, dstTyp, dstVal interface{}) (*ast.InstStore, error) {
-	src, err := NewValue(srcTyp, srcVal)
+	fas, err := getFuncAttrs(funcAttrs)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	dst, err := NewValue(dstTyp, dstVal)
+	inst := &ast.InstCall{Type: r, Callee: c, Args: as, FastMathFlags: flags}
+	inst.ReturnAttrs = ras
+	inst.FuncAttrs = fas
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstStore{Src: src, Dst: dst}, nil
+	inst.Metadata = md
+	return inst, nil
 }
 
-// NewGetElementPtrInst returns a new getelementptr instruction based on the
-// given element type, source address type and value, and element indices.
-func NewGetElementPtrInst(elem, srcTyp, srcVal, indices interface{}) (*ast.InstGetElementPtr, error) {
-	e, ok := elem.(ast.Type)
+// NewLandingPadInst returns a new landingpad instruction based on the given
+// result type, cleanup flag, landing pad clauses and metadata attachments.
+func NewLandingPadInst(typ, cleanup, clauses, mds interface{}) (*ast.InstLandingPad, error) {
+	t, ok := typ.(ast.Type)
 	if !ok {
-		return nil, errors.Errorf("invalid element type; expected ast.Type, got %T", elem)
+		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
 	}
-	src, err := NewValue(srcTyp, srcVal)
+	c, err := getFlag(cleanup)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var is []ast.Value
-	switch indices := indices.(type) {
-	case []ast.Value:
-		is = indices
+	var cs []*ast.Clause
+	switch clauses := clauses.(type) {
+	case []*ast.Clause:
+		cs = clauses
 	case nil:
-		// no indices.
+		// no clauses.
 	default:
-		return nil, errors.Errorf("invalid indices type; expected []ast.Value or nil, got %T", indices)
+		return nil, errors.Errorf("invalid landing pad clauses type; expected []*ast.Clause or nil, got %T", clauses)
 	}
-	// Store e in InstGetElementPtr to evaluate against src.Type().Elem() after
-	// type resolution.
-	return &ast.InstGetElementPtr{Elem: e, Src: src, Indices: is}, nil
-}
-
-// --- [ Conversion instructions ] ---------------------------------------------
-
-// NewTruncInst returns a new trunc instruction based on the given source value
-// and target type.
-func NewTruncInst(fromTyp, fromVal, to interface{}) (*ast.InstTrunc, error) {
-	from, err := NewValue(fromTyp, fromVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	return &ast.InstLandingPad{Type: t, Cleanup: c, Clauses: cs, Metadata: md}, nil
+}
+
+// NewClauseList returns a new landing pad clause list based on the given
+// clause.
+func NewClauseList(clause interface{}) ([]*ast.Clause, error) {
+	c, ok := clause.(*ast.Clause)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid landing pad clause type; expected *ast.Clause, got %T", clause)
 	}
-	return &ast.InstTrunc{From: from, To: t}, nil
+	return []*ast.Clause{c}, nil
 }
 
-// NewZExtInst returns a new zext instruction based on the given source value
-// and target type.
-func NewZExtInst(fromTyp, fromVal, to interface{}) (*ast.InstZExt, error) {
-	from, err := NewValue(fromTyp, fromVal)
-	if err != nil {
-		return nil, errors.WithStack(err)
+// AppendClause appends the given clause to the landing pad clause list.
+func AppendClause(clauses, clause interface{}) ([]*ast.Clause, error) {
+	cs, ok := clauses.([]*ast.Clause)
+	if !ok {
+		return nil, errors.Errorf("invalid landing pad clause list type; expected []*ast.Clause, got %T", clauses)
 	}
-	t, ok := to.(ast.Type)
+	c, ok := clause.(*ast.Clause)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid landing pad clause type; expected *ast.Clause, got %T", clause)
 	}
-	return &ast.InstZExt{From: from, To: t}, nil
+	return append(cs, c), nil
 }
 
-// NewSExtInst returns a new sext instruction based on the given source value
-// and target type.
-func NewSExtInst(fromTyp, fromVal, to interface{}) (*ast.InstSExt, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// NewClause returns a new landing pad clause based on the given clause kind and
+// operand.
+func NewClause(kind ast.ClauseKind, xTyp, xVal interface{}) (*ast.Clause, error) {
+	x, err := NewConstant(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
-	}
-	return &ast.InstSExt{From: from, To: t}, nil
+	return &ast.Clause{Kind: kind, X: x}, nil
 }
 
-// NewFPTruncInst returns a new fptrunc instruction based on the given source value
-// and target type.
-func NewFPTruncInst(fromTyp, fromVal, to interface{}) (*ast.InstFPTrunc, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// NewCatchPadInst returns a new catchpad instruction based on the given parent
+// catchswitch, exception arguments and metadata attachments.
+func NewCatchPadInst(catchSwitch, args, mds interface{}) (*ast.InstCatchPad, error) {
+	cs, err := newTokenValue(catchSwitch)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
-	}
-	return &ast.InstFPTrunc{From: from, To: t}, nil
-}
-
-// NewFPExtInst returns a new fpext instruction based on the given source value
-// and target type.
-func NewFPExtInst(fromTyp, fromVal, to interface{}) (*ast.InstFPExt, error) {
-	from, err := NewValue(fromTyp, fromVal)
+	as, err := getArgs(args)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFPExt{From: from, To: t}, nil
+	return &ast.InstCatchPad{CatchSwitch: cs, Args: as, Metadata: md}, nil
 }
 
-// NewFPToUIInst returns a new fptoui instruction based on the given source value
-// and target type.
-func NewFPToUIInst(fromTyp, fromVal, to interface{}) (*ast.InstFPToUI, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// NewCleanupPadInst returns a new cleanuppad instruction based on the given
+// parent exception pad, exception arguments and metadata attachments.
+func NewCleanupPadInst(parentPad, args, mds interface{}) (*ast.InstCleanupPad, error) {
+	p, err := NewValue(&ast.TokenType{}, parentPad)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
-	}
-	return &ast.InstFPToUI{From: from, To: t}, nil
-}
-
-// NewFPToSIInst returns a new fptosi instruction based on the given source value
-// and target type.
-func NewFPToSIInst(fromTyp, fromVal, to interface{}) (*ast.InstFPToSI, error) {
-	from, err := NewValue(fromTyp, fromVal)
+	as, err := getArgs(args)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFPToSI{From: from, To: t}, nil
+	return &ast.InstCleanupPad{ParentPad: p, Args: as, Metadata: md}, nil
 }
 
-// NewUIToFPInst returns a new uitofp instruction based on the given source value
-// and target type.
-func NewUIToFPInst(fromTyp, fromVal, to interface{}) (*ast.InstUIToFP, error) {
-	from, err := NewValue(fromTyp, fromVal)
-	if err != nil {
-		return nil, errors.WithStack(err)
+// === [ Terminators ] =========================================================
+
+// NewNamedTerminator returns a named terminator based on the given local
+// variable name and terminator.
+func NewNamedTerminator(name, term interface{}) (ast.Terminator, error) {
+	// namedTerminator represents a named terminator.
+	type namedTerminator interface {
+		ast.Terminator
+		ast.NamedValue
 	}
-	t, ok := to.(ast.Type)
+	n, ok := name.(*LocalIdent)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid local variable name type; expected *astx.LocalIdent, got %T", name)
+	}
+	t, ok := term.(namedTerminator)
+	if !ok {
+		return nil, errors.Errorf("invalid terminator type; expected namedTerminator, got %T", term)
 	}
-	return &ast.InstUIToFP{From: from, To: t}, nil
+	t.SetName(n.name)
+	return t, nil
 }
 
-// NewSIToFPInst returns a new sitofp instruction based on the given source value
-// and target type.
-func NewSIToFPInst(fromTyp, fromVal, to interface{}) (*ast.InstSIToFP, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// --- [ ret ] -----------------------------------------------------------------
+
+// NewRetTerm returns a new ret terminator based on the given return type, value
+// and metadata attachments.
+func NewRetTerm(xTyp, xVal, mds interface{}) (*ast.TermRet, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.InstSIToFP{From: from, To: t}, nil
+	return &ast.TermRet{X: x, Metadata: md}, nil
 }
 
-// NewPtrToIntInst returns a new ptrtoint instruction based on the given source value
-// and target type.
-func NewPtrToIntInst(fromTyp, fromVal, to interface{}) (*ast.InstPtrToInt, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// NewRetVoidTerm returns a new void ret terminator based on the given metadata
+// attachments.
+func NewRetVoidTerm(mds interface{}) (*ast.TermRet, error) {
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
-	}
-	return &ast.InstPtrToInt{From: from, To: t}, nil
+	return &ast.TermRet{Metadata: md}, nil
 }
 
-// NewIntToPtrInst returns a new inttoptr instruction based on the given source value
-// and target type.
-func NewIntToPtrInst(fromTyp, fromVal, to interface{}) (*ast.InstIntToPtr, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// --- [ br ] ------------------------------------------------------------------
+
+// NewBrTerm returns a new unconditional br terminator based on the given target
+// branc, rTerm returns a new unconditional br terminator based on the given
+// target branch and metadata attachments.
+func NewBrTerm(targetTyp, targetVal, mds interface{}) (*ast.TermBr, error) {
+	target, err := NewValue(targetTyp, targetVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
+	t, ok := target.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+		return nil, errors.Errorf("invalid target branch type; expected ast.NamedValue, got %T", target)
 	}
-	return &ast.InstIntToPtr{From: from, To: t}, nil
-}
-
-// NewBitCastInst returns a new bitcast instruction based on the given source value
-// and target type.
-func NewBitCastInst(fromTyp, fromVal, to interface{}) (*ast.InstBitCast, error) {
-	from, err := NewValue(fromTyp, fromVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
-	}
-	return &ast.InstBitCast{From: from, To: t}, nil
+	return &ast.TermBr{Target: t, Metadata: md}, nil
 }
 
-// NewAddrSpaceCastInst returns a new addrspacecast instruction based on the given source value
-// and target type.
-func NewAddrSpaceCastInst(fromTyp, fromVal, to interface{}) (*ast.InstAddrSpaceCast, error) {
-	from, err := NewValue(fromTyp, fromVal)
+// --- [ conditional br ] ------------------------------------------------------
+
+// NewCondBrTerm returns a new conditional br terminator based on the given
+// branching condition type and value, and conditional target branches, and
+// metadata attachments.
+func NewCondBrTerm(condTyp, condVal, targetTrueTyp, targetTrueVal, targetFalseTyp, targetFalseVal, mds interface{}) (*ast.TermCondBr, error) {
+	cond, err := NewValue(condTyp, condVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := to.(ast.Type)
-	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", to)
+	targetTrue, err := NewValue(targetTrueTyp, targetTrueVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.InstAddrSpaceCast{From: from, To: t}, nil
-}
-
-// --- [ Other instructions ] --------------------------------------------------
-
-// NewICmpInst returns a new icmp instruction based on the given integer
-// condition code, type and operands.
-func NewICmpInst(cond, typ, xVal, yVal interface{}) (*ast.InstICmp, error) {
-	c, ok := cond.(ast.IntPred)
+	tTrue, ok := targetTrue.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid integer predicate type; expected ast.IntPred, got %T", cond)
+		return nil, errors.Errorf("invalid true target branch type; expected ast.NamedValue, got %T", targetTrue)
 	}
-	x, err := NewValue(typ, xVal)
+	targetFalse, err := NewValue(targetFalseTyp, targetFalseVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	tFalse, ok := targetFalse.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid false target branch type; expected ast.NamedValue, got %T", targetFalse)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstICmp{Cond: c, X: x, Y: y}, nil
+	return &ast.TermCondBr{Cond: cond, TargetTrue: tTrue, TargetFalse: tFalse, Metadata: md}, nil
 }
 
-// NewFCmpInst returns a new fcmp instruction based on the given floating-point
-// condition code, type and operands.
-func NewFCmpInst(cond, typ, xVal, yVal interface{}) (*ast.InstFCmp, error) {
-	c, ok := cond.(ast.FloatPred)
-	if !ok {
-		return nil, errors.Errorf("invalid floating-point predicate type; expected ast.FloatPred, got %T", cond)
-	}
-	x, err := NewValue(typ, xVal)
+// --- [ switch ] --------------------------------------------------------------
+
+// NewSwitchTerm returns a new switch terminator based on the given control
+// variable type and value, default target branch, switch cases and metadata
+// attachments.
+func NewSwitchTerm(xTyp, xVal, targetDefaultTyp, targetDefaultVal, cases, mds interface{}) (*ast.TermSwitch, error) {
+	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(typ, yVal)
+	targetDefault, err := NewValue(targetDefaultTyp, targetDefaultVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstFCmp{Cond: c, X: x, Y: y}, nil
-}
-
-// NewPhiInst returns a new phi instruction based on the given incoming values.
-func NewPhiInst(typ, incs interface{}) (*ast.InstPhi, error) {
-	t, ok := typ.(ast.Type)
+	tDefault, ok := targetDefault.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid type; expected ast.Type, got %T", typ)
+		return nil, errors.Errorf("invalid default target branch type; expected ast.NamedValue, got %T", targetDefault)
 	}
-	is, ok := incs.([]*ast.Incoming)
-	if !ok {
-		return nil, errors.Errorf("invalid incoming value list type; expected []*ast.Incoming, got %T", incs)
+	var cs []*ast.Case
+	switch cases := cases.(type) {
+	case []*ast.Case:
+		cs = cases
+	case nil:
+		// no cases.
+	default:
+		return nil, errors.Errorf("invalid switch cases type; expected []*ast.Case or nil, got %T", cases)
 	}
-	for _, inc := range is {
-		x, err := NewValue(t, inc.X)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		inc.X = x
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.InstPhi{Type: t, Incs: is}, nil
+	return &ast.TermSwitch{X: x, TargetDefault: tDefault, Cases: cs, Metadata: md}, nil
 }
 
-// NewIncomingList returns a new incoming value list based on the given incoming
-// value.
-func NewIncomingList(inc interface{}) ([]*ast.Incoming, error) {
-	i, ok := inc.(*ast.Incoming)
+// NewCaseList returns a new switch case list based on the given case.
+func NewCaseList(switchCase interface{}) ([]*ast.Case, error) {
+	c, ok := switchCase.(*ast.Case)
 	if !ok {
-		return nil, errors.Errorf("invalid incoming value type; expected *ast.Incoming, got %T", inc)
+		return nil, errors.Errorf("invalid switch case type; expected *ast.Case, got %T", switchCase)
 	}
-	return []*ast.Incoming{i}, nil
+	return []*ast.Case{c}, nil
 }
 
-// AppendIncoming appends the given incoming value to the incoming value list.
-func AppendIncoming(incs, inc interface{}) ([]*ast.Incoming, error) {
-	is, ok := incs.([]*ast.Incoming)
+// AppendCase appends the given case to the switch case list.
+func AppendCase(cases, switchCase interface{}) ([]*ast.Case, error) {
+	cs, ok := cases.([]*ast.Case)
 	if !ok {
-		return nil, errors.Errorf("invalid incoming value list type; expected []*ast.Incoming, got %T", incs)
+		return nil, errors.Errorf("invalid switch case list type; expected []*ast.Case, got %T", cases)
 	}
-	i, ok := inc.(*ast.Incoming)
+	c, ok := switchCase.(*ast.Case)
 	if !ok {
-		return nil, errors.Errorf("invalid incoming value type; expected *ast.Incoming, got %T", inc)
+		return nil, errors.Errorf("invalid switch case type; expected *ast.Case, got %T", switchCase)
 	}
-	return append(is, i), nil
+	return append(cs, c), nil
 }
 
-// NewIncoming returns a new incoming value based on the given value and
-// predecessor basic block.
-func NewIncoming(x, pred interface{}) (*ast.Incoming, error) {
-	xx, err := NewValue(&ast.TypeDummy{}, x)
+// NewCase returns a new switch case based on the given case comparand and
+// target branch.
+func NewCase(xTyp, xVal, targetTyp, targetVal interface{}) (*ast.Case, error) {
+	xValue, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	pp, err := NewValue(&ast.TypeDummy{}, pred)
+	x, ok := xValue.(*ast.IntConst)
+	if !ok {
+		return nil, errors.Errorf("invalid case comparand type; expected *ast.IntConst, got %T", xValue)
+	}
+	target, err := NewValue(targetTyp, targetVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	p, ok := pp.(ast.NamedValue)
+	t, ok := target.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid predecessor type; expected ast.NamedValue, got %T", pp)
+		return nil, errors.Errorf("invalid target branch type; expected ast.NamedValue, got %T", target)
 	}
-	return &ast.Incoming{X: xx, Pred: p}, nil
+	return &ast.Case{X: x, Target: t}, nil
 }
 
-// NewSelect returns a new select instruction based on the given selection
-// condition type and value, and operands.
-func NewSelectInst(condTyp, condVal, xTyp, xVal, yTyp, yVal interface{}) (*ast.InstSelect, error) {
-	cond, err := NewValue(condTyp, condVal)
+// --- [ indirectbr ] ----------------------------------------------------------
+
+// NewIndirectBrTerm returns a new indirectbr terminator based on the given
+// target address type and value, and list of possible destinations, and
+// metadata attachments.
+func NewIndirectBrTerm(addrTyp, addrVal, validTargets, mds interface{}) (*ast.TermIndirectBr, error) {
+	addr, err := NewValue(addrTyp, addrVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	x, err := NewValue(xTyp, xVal)
+	var ts []ast.NamedValue
+	switch validTargets := validTargets.(type) {
+	case []ast.NamedValue:
+		ts = validTargets
+	case nil:
+		// no valid targets.
+	default:
+		return nil, errors.Errorf("invalid valid targets type; expected []ast.NamedValue or nil, got %T", validTargets)
+	}
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	y, err := NewValue(yTyp, yVal)
+	return &ast.TermIndirectBr{Addr: addr, ValidTargets: ts, Metadata: md}, nil
+}
+
+// NewLabelList returns a new label list based on the given label type and
+// value.
+func NewLabelList(labelTyp, labelVal interface{}) ([]ast.NamedValue, error) {
+	return AppendLabel([]ast.NamedValue(nil), labelTyp, labelVal)
+}
+
+// AppendLabel appends the given label to the label list.
+func AppendLabel(labels, labelTyp, labelVal interface{}) ([]ast.NamedValue, error) {
+	ls, ok := labels.([]ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid label list type; expected []ast.NamedValue, got %T", labels)
+	}
+	label, err := NewValue(labelTyp, labelVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.InstSelect{Cond: cond, X: x, Y: y}, nil
+	l, ok := label.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid label type; expected ast.NamedValue, got %T", label)
+	}
+	return append(ls, l), nil
 }
 
-// NewCallInst returns a new call instruction based on the given return type,
-// callee name, and function arguments.
-func NewCallInst(retTyp, callee, args interface{}) (*ast.InstCall, error) {
+// --- [ invoke ] --------------------------------------------------------------
+
+// NewInvokeTerm returns a new invoke terminator based on the given return type,
+// callee name, function arguments, target branches and metadata attachments.
+func NewInvokeTerm(retTyp, callee, args, targetNormalTyp, targetNormalVal, targetUnwindTyp, targetUnwindVal, mds interface{}) (*ast.TermInvoke, error) {
 	r, ok := retTyp.(ast.Type)
 	if !ok {
 		return nil, errors.Errorf("invalid return type; expected ast.Type, got %T", retTyp)
@@ -2247,141 +4983,169 @@ func NewCallInst(retTyp, callee, args interface{}) (*ast.InstCall, error) {
 	default:
 		return nil, errors.Errorf("invalid function arguments type; expected []ast.Value or nil, got %T", args)
 	}
-	return &ast.InstCall{Type: r, Callee: c, Args: as}, nil
+	targetNormal, err := NewValue(targetNormalTyp, targetNormalVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tNormal, ok := targetNormal.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid normal target branch type; expected ast.NamedValue, got %T", targetNormal)
+	}
+	targetUnwind, err := NewValue(targetUnwindTyp, targetUnwindVal)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tUnwind, ok := targetUnwind.(ast.NamedValue)
+	if !ok {
+		return nil, errors.Errorf("invalid unwind target branch type; expected ast.NamedValue, got %T", targetUnwind)
+	}
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.TermInvoke{Type: r, Callee: c, Args: as, TargetNormal: tNormal, TargetUnwind: tUnwind, Metadata: md}, nil
 }
 
-// === [ Terminators ] =========================================================
-
-// --- [ ret ] -----------------------------------------------------------------
+// --- [ resume ] --------------------------------------------------------------
 
-// NewRetTerm returns a new ret terminator based on the given return type and
-// value.
-func NewRetTerm(xTyp, xVal interface{}) (*ast.TermRet, error) {
+// NewResumeTerm returns a new resume terminator based on the given exception
+// type, value and metadata attachments.
+func NewResumeTerm(xTyp, xVal, mds interface{}) (*ast.TermResume, error) {
 	x, err := NewValue(xTyp, xVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &ast.TermRet{X: x}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.TermResume{X: x, Metadata: md}, nil
 }
 
-// --- [ br ] ------------------------------------------------------------------
+// --- [ catchswitch ] ---------------------------------------------------------
 
-// NewBrTerm returns a new unconditional br terminator based on the given target
-// branch.
-func NewBrTerm(targetTyp, targetVal interface{}) (*ast.TermBr, error) {
-	target, err := NewValue(targetTyp, targetVal)
+// NewCatchSwitchTerm returns a new catchswitch terminator based on the given
+// parent exception pad, exception handlers, unwind target and metadata
+// attachments.
+func NewCatchSwitchTerm(parentPad, handlers, unwindTarget, mds interface{}) (*ast.TermCatchSwitch, error) {
+	p, err := NewValue(&ast.TokenType{}, parentPad)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := target.(ast.NamedValue)
+	hs, ok := handlers.([]ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid target branch type; expected ast.NamedValue, got %T", target)
+		return nil, errors.Errorf("invalid exception handlers type; expected []ast.NamedValue, got %T", handlers)
+	}
+	u, err := getUnwindTarget(unwindTarget)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.TermBr{Target: t}, nil
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ast.TermCatchSwitch{ParentPad: p, Handlers: hs, UnwindTarget: u, Metadata: md}, nil
 }
 
-// --- [ conditional br ] ------------------------------------------------------
+// --- [ catchret ] ------------------------------------------------------------
 
-// NewCondBrTerm returns a new conditional br terminator based on the given
-// branching condition type and value, and conditional target branches.
-func NewCondBrTerm(condTyp, condVal, targetTrueTyp, targetTrueVal, targetFalseTyp, targetFalseVal interface{}) (*ast.TermCondBr, error) {
-	cond, err := NewValue(condTyp, condVal)
+// NewCatchRetTerm returns a new catchret terminator based on the given exited
+// catchpad, target branch and metadata attachments.
+func NewCatchRetTerm(catchPad, targetTyp, targetVal, mds interface{}) (*ast.TermCatchRet, error) {
+	cp, err := newTokenValue(catchPad)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	targetTrue, err := NewValue(targetTrueTyp, targetTrueVal)
+	target, err := NewValue(targetTyp, targetVal)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	tTrue, ok := targetTrue.(ast.NamedValue)
+	t, ok := target.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid true target branch type; expected ast.NamedValue, got %T", targetTrue)
+		return nil, errors.Errorf("invalid target branch type; expected ast.NamedValue, got %T", target)
 	}
-	targetFalse, err := NewValue(targetFalseTyp, targetFalseVal)
+	md, err := getMetadataAttachments(mds)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	tFalse, ok := targetFalse.(ast.NamedValue)
-	if !ok {
-		return nil, errors.Errorf("invalid false target branch type; expected ast.NamedValue, got %T", targetFalse)
-	}
-	return &ast.TermCondBr{Cond: cond, TargetTrue: tTrue, TargetFalse: tFalse}, nil
+	return &ast.TermCatchRet{CatchPad: cp, Target: t, Metadata: md}, nil
 }
 
-// --- [ switch ] --------------------------------------------------------------
+// --- [ cleanupret ] ----------------------------------------------------------
 
-// NewSwitchTerm returns a new switch terminator based on the given control
-// variable type and value, default target branch and switch cases.
-func NewSwitchTerm(xTyp, xVal, targetDefaultTyp, targetDefaultVal, cases interface{}) (*ast.TermSwitch, error) {
-	x, err := NewValue(xTyp, xVal)
+// NewCleanupRetTerm returns a new cleanupret terminator based on the given
+// exited cleanuppad, unwind target and metadata attachments.
+func NewCleanupRetTerm(cleanupPad, unwindTarget, mds interface{}) (*ast.TermCleanupRet, error) {
+	cp, err := newTokenValue(cleanupPad)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	targetDefault, err := NewValue(targetDefaultTyp, targetDefaultVal)
+	u, err := getUnwindTarget(unwindTarget)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	tDefault, ok := targetDefault.(ast.NamedValue)
-	if !ok {
-		return nil, errors.Errorf("invalid default target branch type; expected ast.NamedValue, got %T", targetDefault)
-	}
-	var cs []*ast.Case
-	switch cases := cases.(type) {
-	case []*ast.Case:
-		cs = cases
-	case nil:
-		// no cases.
-	default:
-		return nil, errors.Errorf("invalid switch cases type; expected []*ast.Case or nil, got %T", cases)
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return &ast.TermSwitch{X: x, TargetDefault: tDefault, Cases: cs}, nil
+	return &ast.TermCleanupRet{CleanupPad: cp, UnwindTarget: u, Metadata: md}, nil
 }
 
-// NewCaseList returns a new switch case list based on the given case.
-func NewCaseList(switchCase interface{}) ([]*ast.Case, error) {
-	c, ok := switchCase.(*ast.Case)
-	if !ok {
-		return nil, errors.Errorf("invalid switch case type; expected *ast.Case, got %T", switchCase)
+// --- [ unreachable ] ---------------------------------------------------------
+
+// NewUnreachableTerm returns a new unreachable terminator based on the given
+// metadata attachments.
+func NewUnreachableTerm(mds interface{}) (*ast.TermUnreachable, error) {
+	md, err := getMetadataAttachments(mds)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
-	return []*ast.Case{c}, nil
+	return &ast.TermUnreachable{Metadata: md}, nil
 }
 
-// AppendCase appends the given case to the switch case list.
-func AppendCase(cases, switchCase interface{}) ([]*ast.Case, error) {
-	cs, ok := cases.([]*ast.Case)
-	if !ok {
-		return nil, errors.Errorf("invalid switch case list type; expected []*ast.Case, got %T", cases)
-	}
-	c, ok := switchCase.(*ast.Case)
-	if !ok {
-		return nil, errors.Errorf("invalid switch case type; expected *ast.Case, got %T", switchCase)
+// ### [ Helper functions ] ####################################################
+
+// getArgs returns the function or exception arguments of the given argument
+// list.
+func getArgs(args interface{}) ([]ast.Value, error) {
+	switch args := args.(type) {
+	case []ast.Value:
+		return args, nil
+	case nil:
+		// no arguments.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid arguments type; expected []ast.Value or nil, got %T", args)
 	}
-	return append(cs, c), nil
 }
 
-// NewCase returns a new switch case based on the given case comparand and
-// target branch.
-func NewCase(xTyp, xVal, targetTyp, targetVal interface{}) (*ast.Case, error) {
-	xValue, err := NewValue(xTyp, xVal)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	x, ok := xValue.(*ast.IntConst)
-	if !ok {
-		return nil, errors.Errorf("invalid case comparand type; expected *ast.IntConst, got %T", xValue)
-	}
-	target, err := NewValue(targetTyp, targetVal)
+// newTokenValue returns a named value of token type based on the given local
+// identifier.
+func newTokenValue(ident interface{}) (ast.NamedValue, error) {
+	val, err := NewValue(&ast.TokenType{}, ident)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	t, ok := target.(ast.NamedValue)
+	v, ok := val.(ast.NamedValue)
 	if !ok {
-		return nil, errors.Errorf("invalid target branch type; expected ast.NamedValue, got %T", target)
+		return nil, errors.Errorf("invalid token value type; expected ast.NamedValue, got %T", val)
 	}
-	return &ast.Case{X: x, Target: t}, nil
+	return v, nil
 }
 
-// ### [ Helper functions ] ####################################################
+// getUnwindTarget returns the unwind target of the given value; or nil if
+// unwinding to the caller.
+func getUnwindTarget(unwindTarget interface{}) (ast.NamedValue, error) {
+	switch unwindTarget := unwindTarget.(type) {
+	case ast.NamedValue:
+		return unwindTarget, nil
+	case nil:
+		// unwind to caller.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid unwind target type; expected ast.NamedValue or nil, got %T", unwindTarget)
+	}
+}
 
 // getTokenString returns the string literal of the given token.
 func getTokenString(tok interface{}) (string, error) {
@@ -2410,3 +5174,316 @@ func getInt64(lit interface{}) (int64, error) {
 	}
 	return n, nil
 }
+
+// getIndex returns the int64 representation of the given aggregate index
+// token.
+func getIndex(tok interface{}) (int64, error) {
+	s, err := getTokenString(tok)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return n, nil
+}
+
+// getFlag returns the boolean value of the given optional flag.
+func getFlag(flag interface{}) (bool, error) {
+	switch flag := flag.(type) {
+	case bool:
+		return flag, nil
+	case nil:
+		// flag not present.
+		return false, nil
+	default:
+		return false, errors.Errorf("invalid flag type; expected bool or nil, got %T", flag)
+	}
+}
+
+// getOverflowFlags returns the overflow flags of the given optional overflow
+// flag list.
+func getOverflowFlags(flags interface{}) ([]ast.OverflowFlag, error) {
+	switch flags := flags.(type) {
+	case []ast.OverflowFlag:
+		return flags, nil
+	case nil:
+		// no overflow flags.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid overflow flag list type; expected []ast.OverflowFlag or nil, got %T", flags)
+	}
+}
+
+// getFastMathFlags returns the fast-math flags of the given optional fast-math
+// flag list.
+func getFastMathFlags(flags interface{}) ([]ast.FastMathFlag, error) {
+	switch flags := flags.(type) {
+	case []ast.FastMathFlag:
+		return flags, nil
+	case nil:
+		// no fast-math flags.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid fast-math flag list type; expected []ast.FastMathFlag or nil, got %T", flags)
+	}
+}
+
+// getLinkage returns the linkage type of the given optional linkage type; or
+// LinkageExternal if not present.
+func getLinkage(linkage interface{}) (ast.Linkage, error) {
+	switch linkage := linkage.(type) {
+	case ast.Linkage:
+		return linkage, nil
+	case nil:
+		// no linkage type; defaults to external linkage.
+		return ast.LinkageExternal, nil
+	default:
+		return 0, errors.Errorf("invalid linkage type; expected ast.Linkage or nil, got %T", linkage)
+	}
+}
+
+// getPreemption returns the runtime preemption specifier of the given optional
+// runtime preemption specifier; or PreemptionDSOPreemptable if not present.
+func getPreemption(preemption interface{}) (ast.Preemption, error) {
+	switch preemption := preemption.(type) {
+	case ast.Preemption:
+		return preemption, nil
+	case nil:
+		// no runtime preemption specifier; defaults to dso_preemptable.
+		return ast.PreemptionDSOPreemptable, nil
+	default:
+		return 0, errors.Errorf("invalid runtime preemption specifier type; expected ast.Preemption or nil, got %T", preemption)
+	}
+}
+
+// getVisibility returns the visibility style of the given optional visibility
+// style; or VisibilityDefault if not present.
+func getVisibility(visibility interface{}) (ast.Visibility, error) {
+	switch visibility := visibility.(type) {
+	case ast.Visibility:
+		return visibility, nil
+	case nil:
+		// no visibility style; defaults to default visibility.
+		return ast.VisibilityDefault, nil
+	default:
+		return 0, errors.Errorf("invalid visibility style type; expected ast.Visibility or nil, got %T", visibility)
+	}
+}
+
+// getDLLStorageClass returns the DLL storage class of the given optional DLL
+// storage class; or DLLStorageNone if not present.
+func getDLLStorageClass(class interface{}) (ast.DLLStorageClass, error) {
+	switch class := class.(type) {
+	case ast.DLLStorageClass:
+		return class, nil
+	case nil:
+		// no DLL storage class.
+		return ast.DLLStorageNone, nil
+	default:
+		return 0, errors.Errorf("invalid DLL storage class type; expected ast.DLLStorageClass or nil, got %T", class)
+	}
+}
+
+// getThreadLocal returns the thread-local storage model of the given optional
+// thread-local storage model; or TLSNone if not present.
+func getThreadLocal(mode interface{}) (ast.ThreadLocalMode, error) {
+	switch mode := mode.(type) {
+	case ast.ThreadLocalMode:
+		return mode, nil
+	case nil:
+		// not thread-local.
+		return ast.TLSNone, nil
+	default:
+		return 0, errors.Errorf("invalid thread-local storage model type; expected ast.ThreadLocalMode or nil, got %T", mode)
+	}
+}
+
+// getUnnamedAddr returns the unnamed address specifier of the given optional
+// unnamed address specifier; or UnnamedAddrNone if not present.
+func getUnnamedAddr(addr interface{}) (ast.UnnamedAddr, error) {
+	switch addr := addr.(type) {
+	case ast.UnnamedAddr:
+		return addr, nil
+	case nil:
+		// no unnamed address specifier.
+		return ast.UnnamedAddrNone, nil
+	default:
+		return 0, errors.Errorf("invalid unnamed address specifier type; expected ast.UnnamedAddr or nil, got %T", addr)
+	}
+}
+
+// getMetadata returns the metadata of the given optional metadata operand; or
+// nil if null.
+func getMetadata(node interface{}) (ast.Metadata, error) {
+	switch node := node.(type) {
+	case ast.Metadata:
+		return node, nil
+	case nil:
+		// null metadata operand.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid metadata type; expected ast.Metadata or nil, got %T", node)
+	}
+}
+
+// getMetadataAttachments returns the metadata attachments of the given optional
+// metadata attachment list.
+func getMetadataAttachments(mds interface{}) ([]*ast.MetadataAttachment, error) {
+	switch mds := mds.(type) {
+	case []*ast.MetadataAttachment:
+		return mds, nil
+	case nil:
+		// no metadata attachments.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid metadata attachment list type; expected []*ast.MetadataAttachment or nil, got %T", mds)
+	}
+}
+
+// getDIFields returns the fields of the given optional specialized metadata
+// node field list, separating the fields not modelled by the node types.
+func getDIFields(fields interface{}) ([]*DIField, []*ast.DIField, error) {
+	var fs []*DIField
+	switch fields := fields.(type) {
+	case []*DIField:
+		fs = fields
+	case nil:
+		// no fields.
+		return nil, nil, nil
+	default:
+		return nil, nil, errors.Errorf("invalid specialized metadata node field list type; expected []*astx.DIField or nil, got %T", fields)
+	}
+	var known []*DIField
+	var extra []*ast.DIField
+	for _, f := range fs {
+		if e, ok := f.val.(*ast.DIField); ok {
+			extra = append(extra, e)
+			continue
+		}
+		known = append(known, f)
+	}
+	return known, extra, nil
+}
+
+// int returns the integer value of the specialized metadata node field.
+func (f *DIField) int() (int64, error) {
+	v, ok := f.val.(int64)
+	if !ok {
+		return 0, errors.Errorf("invalid %q field value type; expected int64, got %T", f.name, f.val)
+	}
+	return v, nil
+}
+
+// str returns the string value of the specialized metadata node field.
+func (f *DIField) str() (string, error) {
+	v, ok := f.val.(string)
+	if !ok {
+		return "", errors.Errorf("invalid %q field value type; expected string, got %T", f.name, f.val)
+	}
+	return v, nil
+}
+
+// bool returns the boolean value of the specialized metadata node field.
+func (f *DIField) bool() (bool, error) {
+	v, ok := f.val.(bool)
+	if !ok {
+		return false, errors.Errorf("invalid %q field value type; expected bool, got %T", f.name, f.val)
+	}
+	return v, nil
+}
+
+// flags returns the debug information flags of the specialized metadata node
+// field.
+func (f *DIField) flags() ([]string, error) {
+	v, ok := f.val.([]string)
+	if !ok {
+		return nil, errors.Errorf("invalid %q field value type; expected []string, got %T", f.name, f.val)
+	}
+	return v, nil
+}
+
+// metadata returns the metadata value of the specialized metadata node field;
+// or nil if null.
+func (f *DIField) metadata() (ast.Metadata, error) {
+	v, err := getMetadata(f.val)
+	if err != nil {
+		return nil, errors.Errorf("invalid %q field value type; expected ast.Metadata or nil, got %T", f.name, f.val)
+	}
+	return v, nil
+}
+
+// getFuncAttrs returns the function attributes of the given optional function
+// attribute list.
+func getFuncAttrs(attrs interface{}) ([]ast.FuncAttribute, error) {
+	switch attrs := attrs.(type) {
+	case []ast.FuncAttribute:
+		return attrs, nil
+	case nil:
+		// no function attributes.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid function attribute list type; expected []ast.FuncAttribute or nil, got %T", attrs)
+	}
+}
+
+// getParamAttrs returns the parameter attributes of the given optional
+// parameter attribute list.
+func getParamAttrs(attrs interface{}) ([]ast.ParamAttribute, error) {
+	switch attrs := attrs.(type) {
+	case []ast.ParamAttribute:
+		return attrs, nil
+	case nil:
+		// no parameter attributes.
+		return nil, nil
+	default:
+		return nil, errors.Errorf("invalid parameter attribute list type; expected []ast.ParamAttribute or nil, got %T", attrs)
+	}
+}
+
+// getAlign returns the alignment in bytes of the given optional alignment
+// integer literal token; or 0 if not present.
+func getAlign(align interface{}) (int, error) {
+	if align == nil {
+		// alignment not present.
+		return 0, nil
+	}
+	s, err := getTokenString(align)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return int(n), nil
+}
+
+// getSyncScope returns the synchronization scope of the given optional string
+// literal token; or the empty string if not present.
+func getSyncScope(syncScope interface{}) (string, error) {
+	if syncScope == nil {
+		// system synchronization scope.
+		return "", nil
+	}
+	s, err := getTokenString(syncScope)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	// Skip double-quotes.
+	s = s[1 : len(s)-1]
+	return enc.Unescape(s), nil
+}
+
+// getStringLit returns the unquoted and unescaped contents of the given string
+// literal token.
+func getStringLit(lit interface{}) (string, error) {
+	s, err := getTokenString(lit)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	// Skip double-quotes.
+	s = s[1 : len(s)-1]
+	return enc.Unescape(s), nil
+}
@@ -4,10 +4,11 @@
 //
 //    1. Index type definitions.
 //    2. Index global variables.
-//    3. Index functions.
-//    4. Fix type definitions.
-//    5. Resolve named types.
-//    6. Resolve global identifiers.
+//    3. Index aliases and IFuncs.
+//    4. Index functions.
+//    5. Fix type definitions.
+//    6. Resolve named types.
+//    7. Resolve global identifiers.
 //
 // Per function.
 //
@@ -55,6 +56,24 @@ func fixModule(m *ast.Module) *ast.Module {
 		fix.globals[name] = global
 	}
 
+	// Index aliases.
+	for _, alias := range m.Aliases {
+		name := alias.Name
+		if _, ok := fix.globals[name]; ok {
+			panic(fmt.Errorf("global identifier %q already present; old `%v`, new `%v`", name, fix.globals[name], alias))
+		}
+		fix.globals[name] = alias
+	}
+
+	// Index IFuncs.
+	for _, ifunc := range m.IFuncs {
+		name := ifunc.Name
+		if _, ok := fix.globals[name]; ok {
+			panic(fmt.Errorf("global identifier %q already present; old `%v`, new `%v`", name, fix.globals[name], ifunc))
+		}
+		fix.globals[name] = ifunc
+	}
+
 	// Index functions.
 	for _, f := range m.Funcs {
 		name := f.Name
@@ -167,10 +186,14 @@ func (fix *fixer) fixType(old ast.Type) ast.Type {
 		old.Elem = fix.fixType(old.Elem)
 	case *ast.VectorType:
 		old.Elem = fix.fixType(old.Elem)
+	case *ast.MMXType:
+		// nothing to do.
 	case *ast.LabelType:
 		// nothing to do.
 	case *ast.MetadataType:
 		// nothing to do.
+	case *ast.TokenType:
+		// nothing to do.
 	case *ast.ArrayType:
 		old.Elem = fix.fixType(old.Elem)
 	case *ast.StructType:
@@ -228,15 +251,8 @@ func (fix *fixer) fixFunction(f *ast.Function) {
 		for _, inst := range block.Insts {
 			if inst, ok := inst.(ast.NamedValue); ok {
 				// Ignore local value if of type void.
-				if inst, ok := inst.(*ast.InstCall); ok {
-					if _, ok := inst.Type.(*ast.VoidType); ok {
-						continue
-					}
-					if sig, ok := inst.Type.(*ast.FuncType); ok {
-						if _, ok := sig.Ret.(*ast.VoidType); ok {
-							continue
-						}
-					}
+				if inst, ok := inst.(*ast.InstCall); ok && isVoidCallType(inst.Type) {
+					continue
 				}
 				name := inst.GetName()
 				if _, ok := fix.locals[name]; ok {
@@ -245,6 +261,18 @@ func (fix *fixer) fixFunction(f *ast.Function) {
 				fix.locals[name] = inst
 			}
 		}
+		// Index local variables produced by terminators.
+		if term, ok := block.Term.(ast.NamedValue); ok {
+			// Ignore local value if of type void.
+			if term, ok := term.(*ast.TermInvoke); ok && isVoidCallType(term.Type) {
+				continue
+			}
+			name := term.GetName()
+			if _, ok := fix.locals[name]; ok {
+				panic(fmt.Errorf("terminator name %q already present for function %s; old `%v`, new `%v`", name, enc.Global(f.Name), fix.locals[name], term))
+			}
+			fix.locals[name] = term
+		}
 	}
 
 	// Resolve values of local identifiers.
@@ -311,6 +339,20 @@ func (fix *fixer) getGlobal(name string) ast.NamedValue {
 	return global
 }
 
+// isVoidCallType reports whether the given type of a call or invoke
+// instruction denotes a void return.
+func isVoidCallType(typ ast.Type) bool {
+	if _, ok := typ.(*ast.VoidType); ok {
+		return true
+	}
+	if sig, ok := typ.(*ast.FuncType); ok {
+		if _, ok := sig.Ret.(*ast.VoidType); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // getLocal returns the local value of the given local identifier.
 func (fix *fixer) getLocal(name string) ast.NamedValue {
 	local, ok := fix.locals[name]
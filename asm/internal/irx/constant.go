@@ -20,6 +20,13 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 		return constant.NewFloatFromString(old.Lit, m.irType(old.Type))
 	case *ast.NullConst:
 		return constant.NewNull(m.irType(old.Type))
+	case *ast.NoneConst:
+		typ := m.irType(old.Type)
+		t, ok := typ.(*types.TokenType)
+		if !ok {
+			panic(fmt.Errorf("invalid none token constant type; expected *types.TokenType, got %T", typ))
+		}
+		return constant.NewNoneToken(t)
 
 	// Complex constants
 	case *ast.VectorConst:
@@ -27,6 +34,11 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 		for _, oldElem := range old.Elems {
 			elems = append(elems, m.irConstant(oldElem))
 		}
+		if t, ok := m.irType(old.Type).(*types.VectorType); ok && t.Len != int64(len(elems)) {
+			// The number of vector elements is validated by the semantic
+			// checker.
+			return &constant.Vector{Typ: t, Elems: elems}
+		}
 		c := constant.NewVector(elems...)
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("vector type mismatch; expected `%v`, got `%v`", want, got))
@@ -62,22 +74,27 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 		}
 		c := constant.NewStruct(fields...)
 		got := c.Typ
+		got.Packed = old.Packed
 		oldType := m.irType(old.Type)
 		want, ok := oldType.(*types.StructType)
 		if !ok {
 			panic(fmt.Errorf("invalid struct type; expected *types.StructType, got %T", oldType))
 		}
-		// TODO: Figure out how to validate the bodies of got and want. After name
-		// is copied from want to got, only name identity is used for type
-		// equality.
-		got.Name = want.Name
-		if !got.Equal(want) {
-			err := errors.Errorf("struct type mismatch; expected `%v`, got `%v`", want, got)
+		// Identified struct types are uniqued by type names, so compare the body
+		// of want against the literal struct type of the fields.
+		body := &types.StructType{Fields: want.Fields, Packed: want.Packed}
+		if !got.Equal(body) {
+			err := errors.Errorf("struct type mismatch; expected `%v`, got `%v`", body, got)
 			m.errs = append(m.errs, err)
 		}
+		c.Typ = want
 		return c
 	case *ast.ZeroInitializerConst:
 		return constant.NewZeroInitializer(m.irType(old.Type))
+	case *ast.UndefConst:
+		return constant.NewUndef(m.irType(old.Type))
+	case *ast.PoisonConst:
+		return constant.NewPoison(m.irType(old.Type))
 
 	// Global variable and function addresses
 	case *ast.Global:
@@ -91,6 +108,20 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 			panic(fmt.Errorf("invalid global type; expected *ir.Global, got %T", v))
 		}
 		return global
+	case *ast.Alias:
+		v := m.getGlobal(old.Name)
+		alias, ok := v.(*ir.Alias)
+		if !ok {
+			panic(fmt.Errorf("invalid alias type; expected *ir.Alias, got %T", v))
+		}
+		return alias
+	case *ast.IFunc:
+		v := m.getGlobal(old.Name)
+		ifunc, ok := v.(*ir.IFunc)
+		if !ok {
+			panic(fmt.Errorf("invalid IFunc type; expected *ir.IFunc, got %T", v))
+		}
+		return ifunc
 	case *ast.Function:
 		// TODO: Validate old.Type against type of resolved function?
 		// Not possible currently, as globals have already been resolved by astx.
@@ -103,10 +134,26 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 		}
 		return f
 
+	// Addresses of basic blocks
+	case *ast.BlockAddressConst:
+		v := m.irValue(old.Func)
+		f, ok := v.(*ir.Function)
+		if !ok {
+			panic(fmt.Errorf("invalid blockaddress function type; expected *ir.Function, got %T", v))
+		}
+		// The basic block is resolved once all functions have been translated.
+		c := constant.NewBlockAddress(f, nil)
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("blockaddress type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		m.blockAddrs = append(m.blockAddrs, &blockAddr{c: c, block: old.Block.Name})
+		return c
+
 	// Binary expressions
 	case *ast.ExprAdd:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewAdd(x, y)
+		c.OverflowFlags = irConstOverflowFlags(old.OverflowFlags)
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("add expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -121,6 +168,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprSub:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewSub(x, y)
+		c.OverflowFlags = irConstOverflowFlags(old.OverflowFlags)
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("sub expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -135,6 +183,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprMul:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewMul(x, y)
+		c.OverflowFlags = irConstOverflowFlags(old.OverflowFlags)
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("mul expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -149,6 +198,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprUDiv:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewUDiv(x, y)
+		c.Exact = old.Exact
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("udiv expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -156,6 +206,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprSDiv:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewSDiv(x, y)
+		c.Exact = old.Exact
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("sdiv expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -193,6 +244,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprShl:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewShl(x, y)
+		c.OverflowFlags = irConstOverflowFlags(old.OverflowFlags)
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("shl expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -200,6 +252,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprLShr:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewLShr(x, y)
+		c.Exact = old.Exact
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("lshr expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -207,6 +260,7 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 	case *ast.ExprAShr:
 		x, y := m.irConstant(old.X), m.irConstant(old.Y)
 		c := constant.NewAShr(x, y)
+		c.Exact = old.Exact
 		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
 			m.errs = append(m.errs, errors.Errorf("ashr expression type mismatch; expected `%v`, got `%v`", want, got))
 		}
@@ -233,6 +287,55 @@ func (m *Module) irConstant(old ast.Constant) constant.Constant {
 		}
 		return c
 
+	// Vector expressions
+	case *ast.ExprExtractElement:
+		x, index := m.irConstant(old.X), m.irConstant(old.Index)
+		c := constant.NewExtractElement(x, index)
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("extractelement expression type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		return c
+	case *ast.ExprInsertElement:
+		x, elem, index := m.irConstant(old.X), m.irConstant(old.Elem), m.irConstant(old.Index)
+		c := constant.NewInsertElement(x, elem, index)
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("insertelement expression type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		return c
+	case *ast.ExprShuffleVector:
+		x, y, mask := m.irConstant(old.X), m.irConstant(old.Y), m.irConstant(old.Mask)
+		c := constant.NewShuffleVector(x, y, mask)
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("shufflevector expression type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		return c
+
+	// Aggregate expressions
+	case *ast.ExprExtractValue:
+		x := m.irConstant(old.X)
+		typ, err := types.AggregateElemType(x.Type(), old.Indices)
+		if err != nil {
+			// Invalid indices are reported by the semantic checker; fall back to
+			// the type of the expression.
+			typ = m.irType(old.Type)
+		}
+		c := &constant.ExprExtractValue{
+			Typ:     typ,
+			X:       x,
+			Indices: old.Indices,
+		}
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("extractvalue expression type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		return c
+	case *ast.ExprInsertValue:
+		x, elem := m.irConstant(old.X), m.irConstant(old.Elem)
+		c := constant.NewInsertValue(x, elem, old.Indices...)
+		if got, want := c.Type(), m.irType(old.Type); !got.Equal(want) {
+			m.errs = append(m.errs, errors.Errorf("insertvalue expression type mismatch; expected `%v`, got `%v`", want, got))
+		}
+		return c
+
 	// Memory expressions
 	case *ast.ExprGetElementPtr:
 		src := m.irConstant(old.Src)
@@ -5,9 +5,12 @@ package irx
 import (
 	"fmt"
 
+	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
+	"github.com/pkg/errors"
 )
 
 // A Module represents an LLVM IR module generator.
@@ -16,11 +19,24 @@ type Module struct {
 	*ir.Module
 	// types maps from type identifiers to their corresponding LLVM IR types.
 	types map[string]types.Type
+	// comdats maps from comdat names to their corresponding LLVM IR comdat
+	// definitions.
+	comdats map[string]*ir.Comdat
+	// attrGroups maps from attribute group IDs to their corresponding LLVM IR
+	// attribute group definitions.
+	attrGroups map[int64]*ir.AttrGroupDef
+	// metadata maps from metadata IDs to their corresponding LLVM IR metadata
+	// nodes.
+	metadata map[int64]metadata.Node
 	// globals maps global identifiers to their corresponding LLVM IR values.
 	globals map[string]value.Named
 	// locals maps local identifiers to their corresponding LLVM IR values; reset
 	// once per function definition.
 	locals map[string]value.Named
+	// blockAddrs tracks blockaddress constants whose basic blocks are resolved
+	// once all functions have been translated, as they may refer to basic
+	// blocks of other functions.
+	blockAddrs []*blockAddr
 	// List of errors encountered during translation.
 	errs []error
 }
@@ -29,9 +45,12 @@ type Module struct {
 func NewModule() *Module {
 	m := ir.NewModule()
 	return &Module{
-		Module:  m,
-		types:   make(map[string]types.Type),
-		globals: make(map[string]value.Named),
+		Module:     m,
+		types:      make(map[string]types.Type),
+		comdats:    make(map[string]*ir.Comdat),
+		attrGroups: make(map[int64]*ir.AttrGroupDef),
+		metadata:   make(map[int64]metadata.Node),
+		globals:    make(map[string]value.Named),
 	}
 }
 
@@ -44,6 +63,42 @@ func (m *Module) getType(name string) types.Type {
 	return typ
 }
 
+// getComdat returns the comdat definition of the given optional comdat name;
+// or nil if not present.
+func (m *Module) getComdat(name string) *ir.Comdat {
+	if len(name) == 0 {
+		// no comdat.
+		return nil
+	}
+	comdat, ok := m.comdats[name]
+	if !ok {
+		m.errs = append(m.errs, errors.Errorf("use of undefined comdat %s", enc.Comdat(name)))
+		return nil
+	}
+	return comdat
+}
+
+// getAttrGroup returns the attribute group definition of the given attribute
+// group ID.
+func (m *Module) getAttrGroup(id int64) *ir.AttrGroupDef {
+	group, ok := m.attrGroups[id]
+	if !ok {
+		m.errs = append(m.errs, errors.Errorf("use of undefined attribute group %s", enc.AttrGroupID(id)))
+		return nil
+	}
+	return group
+}
+
+// getMetadata returns the metadata node of the given metadata ID.
+func (m *Module) getMetadata(id int64) metadata.Node {
+	node, ok := m.metadata[id]
+	if !ok {
+		m.errs = append(m.errs, errors.Errorf("use of undefined metadata %s", enc.MetadataID(id)))
+		return nil
+	}
+	return node
+}
+
 // getGlobal returns the global value of the given global identifier.
 func (m *Module) getGlobal(name string) value.Named {
 	global, ok := m.globals[name]
@@ -0,0 +1,339 @@
+package irx
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/asm/internal/ast"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/pkg/errors"
+)
+
+// irMetadata returns the corresponding LLVM IR metadata of the given metadata;
+// or nil if null.
+func (m *Module) irMetadata(old ast.Metadata) metadata.Metadata {
+	switch old := old.(type) {
+	case nil:
+		// null metadata operand.
+		return nil
+	case ast.MetadataID:
+		if node := m.getMetadata(int64(old)); node != nil {
+			return node
+		}
+		// Return an empty node on undefined metadata, to allow translation to
+		// continue and report all errors.
+		return metadata.NewMDNode()
+	case *ast.MDString:
+		return metadata.NewMDString(old.Val)
+	case *ast.ValueAsMetadata:
+		return metadata.NewValueAsMetadata(m.irValue(old.Value))
+	case ast.MetadataNode:
+		// Inline metadata node.
+		node := newMetadataNode(old, false)
+		m.fixMetadataNode(node, old)
+		return node
+	default:
+		panic(fmt.Errorf("support for metadata %T not yet implemented", old))
+	}
+}
+
+// irMetadataNodes returns the corresponding LLVM IR metadata operands of the
+// given metadata operands.
+func (m *Module) irMetadataNodes(old []ast.Metadata) []metadata.Metadata {
+	if len(old) == 0 {
+		return nil
+	}
+	nodes := make([]metadata.Metadata, len(old))
+	for i, oldNode := range old {
+		nodes[i] = m.irMetadata(oldNode)
+	}
+	return nodes
+}
+
+// irMetadataAttachments returns the corresponding LLVM IR metadata attachments
+// of the given metadata attachments.
+func (m *Module) irMetadataAttachments(old []*ast.MetadataAttachment) []*metadata.Attachment {
+	var mds []*metadata.Attachment
+	for _, oldMD := range old {
+		node := m.getMetadata(int64(oldMD.ID))
+		if node == nil {
+			continue
+		}
+		mds = append(mds, metadata.NewAttachment(oldMD.Name, node))
+	}
+	return mds
+}
+
+// newMetadataNode returns a new empty LLVM IR metadata node corresponding to
+// the given metadata node, to be filled in by fixMetadataNode. The returned
+// node is inline (ID -1).
+func newMetadataNode(old ast.MetadataNode, distinct bool) metadata.Node {
+	switch old.(type) {
+	case *ast.MDNode:
+		return &metadata.MDNode{ID: -1, Distinct: distinct}
+	case *ast.DICompileUnit:
+		return &metadata.DICompileUnit{ID: -1, Distinct: distinct}
+	case *ast.DIFile:
+		return &metadata.DIFile{ID: -1, Distinct: distinct}
+	case *ast.DISubprogram:
+		return &metadata.DISubprogram{ID: -1, Distinct: distinct}
+	case *ast.DILocation:
+		return &metadata.DILocation{ID: -1, Distinct: distinct}
+	case *ast.DILocalVariable:
+		return &metadata.DILocalVariable{ID: -1, Distinct: distinct}
+	case *ast.DIBasicType:
+		return &metadata.DIBasicType{ID: -1, Distinct: distinct}
+	case *ast.DICompositeType:
+		return &metadata.DICompositeType{ID: -1, Distinct: distinct}
+	case *ast.DIDerivedType:
+		return &metadata.DIDerivedType{ID: -1, Distinct: distinct}
+	case *ast.DISubroutineType:
+		return &metadata.DISubroutineType{ID: -1, Distinct: distinct}
+	case *ast.DILexicalBlock:
+		return &metadata.DILexicalBlock{ID: -1, Distinct: distinct}
+	case *ast.DISubrange:
+		return &metadata.DISubrange{ID: -1, Distinct: distinct}
+	case *ast.DIGlobalVariable:
+		return &metadata.DIGlobalVariable{ID: -1, Distinct: distinct}
+	case *ast.DIGlobalVariableExpression:
+		return &metadata.DIGlobalVariableExpression{ID: -1, Distinct: distinct}
+	case *ast.DIExpression:
+		return &metadata.DIExpression{ID: -1, Distinct: distinct}
+	default:
+		panic(fmt.Errorf("support for metadata node %T not yet implemented", old))
+	}
+}
+
+// fixMetadataNode fills in the operands and fields of the given LLVM IR
+// metadata node, as created by newMetadataNode, based on the given metadata
+// node.
+func (m *Module) fixMetadataNode(node metadata.Node, old ast.MetadataNode) {
+	switch old := old.(type) {
+	case *ast.MDNode:
+		n := node.(*metadata.MDNode)
+		n.Nodes = m.irMetadataNodes(old.Nodes)
+	case *ast.DICompileUnit:
+		n := node.(*metadata.DICompileUnit)
+		n.Language = old.Language
+		n.File = m.irDIFile(old.File)
+		n.Producer = old.Producer
+		n.IsOptimized = old.IsOptimized
+		n.Flags = old.Flags
+		n.RuntimeVersion = old.RuntimeVersion
+		n.SplitDebugFilename = old.SplitDebugFilename
+		n.EmissionKind = old.EmissionKind
+		n.Enums = m.irMetadata(old.Enums)
+		n.RetainedTypes = m.irMetadata(old.RetainedTypes)
+		n.Globals = m.irMetadata(old.Globals)
+		n.Imports = m.irMetadata(old.Imports)
+		n.DwoID = old.DwoID
+		n.NameTableKind = old.NameTableKind
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIFile:
+		n := node.(*metadata.DIFile)
+		n.Filename = old.Filename
+		n.Directory = old.Directory
+		n.ChecksumKind = old.ChecksumKind
+		n.Checksum = old.Checksum
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DISubprogram:
+		n := node.(*metadata.DISubprogram)
+		n.Name = old.Name
+		n.LinkageName = old.LinkageName
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.Sig = m.irMetadata(old.Sig)
+		n.IsLocal = old.IsLocal
+		n.IsDefinition = old.IsDefinition
+		n.ScopeLine = old.ScopeLine
+		n.Flags = old.Flags
+		n.SPFlags = old.SPFlags
+		n.IsOptimized = old.IsOptimized
+		n.Unit = m.irDICompileUnit(old.Unit)
+		n.Declaration = m.irMetadata(old.Declaration)
+		n.RetainedNodes = m.irMetadata(old.RetainedNodes)
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DILocation:
+		n := node.(*metadata.DILocation)
+		n.Line = old.Line
+		n.Column = old.Column
+		n.Scope = m.irMetadata(old.Scope)
+		n.InlinedAt = m.irDILocation(old.InlinedAt)
+		n.IsImplicitCode = old.IsImplicitCode
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DILocalVariable:
+		n := node.(*metadata.DILocalVariable)
+		n.Name = old.Name
+		n.Arg = old.Arg
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.VarType = m.irMetadata(old.VarType)
+		n.Flags = old.Flags
+		n.Align = old.Align
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIBasicType:
+		n := node.(*metadata.DIBasicType)
+		n.Tag = old.Tag
+		n.Name = old.Name
+		n.Size = old.Size
+		n.Align = old.Align
+		n.Encoding = old.Encoding
+		n.Flags = old.Flags
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DICompositeType:
+		n := node.(*metadata.DICompositeType)
+		n.Tag = old.Tag
+		n.Name = old.Name
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.BaseType = m.irMetadata(old.BaseType)
+		n.Size = old.Size
+		n.Align = old.Align
+		n.Offset = old.Offset
+		n.Flags = old.Flags
+		n.Elements = m.irMetadata(old.Elements)
+		n.VtableHolder = m.irMetadata(old.VtableHolder)
+		n.TemplateParams = m.irMetadata(old.TemplateParams)
+		n.Identifier = old.Identifier
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIDerivedType:
+		n := node.(*metadata.DIDerivedType)
+		n.Tag = old.Tag
+		n.Name = old.Name
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.BaseType = m.irMetadata(old.BaseType)
+		n.Size = old.Size
+		n.Align = old.Align
+		n.Offset = old.Offset
+		n.Flags = old.Flags
+		n.ExtraData = m.irMetadata(old.ExtraData)
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DISubroutineType:
+		n := node.(*metadata.DISubroutineType)
+		n.Flags = old.Flags
+		n.Types = m.irMetadata(old.Types)
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DILexicalBlock:
+		n := node.(*metadata.DILexicalBlock)
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.Column = old.Column
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DISubrange:
+		n := node.(*metadata.DISubrange)
+		n.Count = old.Count
+		n.CountVar = m.irMetadata(old.CountVar)
+		n.LowerBound = old.LowerBound
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIGlobalVariable:
+		n := node.(*metadata.DIGlobalVariable)
+		n.Name = old.Name
+		n.LinkageName = old.LinkageName
+		n.Scope = m.irMetadata(old.Scope)
+		n.File = m.irDIFile(old.File)
+		n.Line = old.Line
+		n.VarType = m.irMetadata(old.VarType)
+		n.IsLocal = old.IsLocal
+		n.IsDefinition = old.IsDefinition
+		n.Declaration = m.irMetadata(old.Declaration)
+		n.Align = old.Align
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIGlobalVariableExpression:
+		n := node.(*metadata.DIGlobalVariableExpression)
+		n.Var = m.irDIGlobalVariable(old.Var)
+		n.Expr = m.irMetadata(old.Expr)
+		n.Extra = m.irDIFields(old.Extra)
+	case *ast.DIExpression:
+		n := node.(*metadata.DIExpression)
+		for _, oldElem := range old.Elems {
+			switch oldElem := oldElem.(type) {
+			case ast.DwarfOp:
+				n.Elems = append(n.Elems, metadata.DwarfOp(oldElem))
+			case ast.Uint:
+				n.Elems = append(n.Elems, metadata.Uint(oldElem))
+			default:
+				panic(fmt.Errorf("support for DWARF expression element %T not yet implemented", oldElem))
+			}
+		}
+	default:
+		panic(fmt.Errorf("support for metadata node %T not yet implemented", old))
+	}
+}
+
+// irDIFile returns the corresponding LLVM IR source file debug information of
+// the given metadata; or nil if null.
+func (m *Module) irDIFile(old ast.Metadata) *metadata.DIFile {
+	switch md := m.irMetadata(old).(type) {
+	case nil:
+		return nil
+	case *metadata.DIFile:
+		return md
+	default:
+		m.errs = append(m.errs, errors.Errorf("invalid source file metadata type; expected *metadata.DIFile, got %T", md))
+		return nil
+	}
+}
+
+// irDICompileUnit returns the corresponding LLVM IR compile unit debug
+// information of the given metadata; or nil if null.
+func (m *Module) irDICompileUnit(old ast.Metadata) *metadata.DICompileUnit {
+	switch md := m.irMetadata(old).(type) {
+	case nil:
+		return nil
+	case *metadata.DICompileUnit:
+		return md
+	default:
+		m.errs = append(m.errs, errors.Errorf("invalid compile unit metadata type; expected *metadata.DICompileUnit, got %T", md))
+		return nil
+	}
+}
+
+// irDILocation returns the corresponding LLVM IR source location debug
+// information of the given metadata; or nil if null.
+func (m *Module) irDILocation(old ast.Metadata) *metadata.DILocation {
+	switch md := m.irMetadata(old).(type) {
+	case nil:
+		return nil
+	case *metadata.DILocation:
+		return md
+	default:
+		m.errs = append(m.errs, errors.Errorf("invalid source location metadata type; expected *metadata.DILocation, got %T", md))
+		return nil
+	}
+}
+
+// irDIGlobalVariable returns the corresponding LLVM IR global variable debug
+// information of the given metadata; or nil if null.
+func (m *Module) irDIGlobalVariable(old ast.Metadata) *metadata.DIGlobalVariable {
+	switch md := m.irMetadata(old).(type) {
+	case nil:
+		return nil
+	case *metadata.DIGlobalVariable:
+		return md
+	default:
+		m.errs = append(m.errs, errors.Errorf("invalid global variable metadata type; expected *metadata.DIGlobalVariable, got %T", md))
+		return nil
+	}
+}
+
+// irDIFields returns the corresponding LLVM IR fields of the given fields not
+// modelled by the specialized metadata node types.
+func (m *Module) irDIFields(old []*ast.DIField) []*metadata.DIField {
+	if len(old) == 0 {
+		return nil
+	}
+	fs := make([]*metadata.DIField, len(old))
+	for i, oldField := range old {
+		f := &metadata.DIField{Name: oldField.Name, Lit: oldField.Lit}
+		if oldField.MD != nil {
+			f.MD = m.irMetadata(oldField.MD)
+		}
+		fs[i] = f
+	}
+	return fs
+}
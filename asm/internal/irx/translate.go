@@ -3,19 +3,29 @@
 // Per module.
 //
 //    1. Index type definitions.
-//    2. Index global variables.
+//    2. Index comdat definitions.
+//    3. Index attribute group definitions.
+//    4. Index metadata definitions.
+//    5. Index global variables.
 //       - Store preliminary content type.
-//    3. Index function.
+//    6. Index aliases and IFuncs.
+//       - Store preliminary content type.
+//    7. Index function.
 //       - Store type.
-//    4. Fix type definitions.
-//    5. Fix globals.
-//    6. Fix functions.
+//    8. Fix type definitions.
+//    9. Fix attribute group definitions.
+//   10. Fix metadata definitions.
+//   11. Fix named metadata definitions.
+//   12. Fix globals.
+//   13. Fix aliases and IFuncs.
+//   14. Fix functions.
+//   15. Fix block addresses.
 //
 // Per function.
 //
 //    1. Index function parameters.
 //    2. Index basic blocks.
-//    3. Index local variables produced by instructions.
+//    3. Index local variables produced by instructions and terminators.
 //       - Store preliminary type.
 //    4. Fix basic blocks.
 
@@ -28,6 +38,7 @@ import (
 	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 	"github.com/pkg/errors"
@@ -40,6 +51,13 @@ import (
 func Translate(module *ast.Module) (*ir.Module, error) {
 	m := NewModule()
 
+	// Translate source filename, target specifiers and module-level inline
+	// assembly.
+	m.SourceFilename = module.SourceFilename
+	m.DataLayout = module.DataLayout
+	m.TargetTriple = module.TargetTriple
+	m.ModuleAsm = module.ModuleAsm
+
 	// Index type definitions.
 	for _, old := range module.Types {
 		name := old.Name
@@ -52,6 +70,39 @@ func Translate(module *ast.Module) (*ir.Module, error) {
 		m.types[name] = typ
 	}
 
+	// Index comdat definitions.
+	for _, old := range module.Comdats {
+		name := old.Name
+		if _, ok := m.comdats[name]; ok {
+			panic(fmt.Errorf("comdat name %q already present; old `%v`, new `%v`", name, m.comdats[name], old))
+		}
+		comdat := m.NewComdat(name, irSelectionKind(old.Kind))
+		m.comdats[name] = comdat
+	}
+
+	// Index attribute group definitions.
+	for _, old := range module.AttrGroupDefs {
+		id := old.ID
+		if _, ok := m.attrGroups[id]; ok {
+			panic(fmt.Errorf("attribute group ID %d already present; old `%v`, new `%v`", id, m.attrGroups[id], old))
+		}
+		group := ir.NewAttrGroupDef(id)
+		m.AttrGroupDefs = append(m.AttrGroupDefs, group)
+		m.attrGroups[id] = group
+	}
+
+	// Index metadata definitions.
+	for _, old := range module.MetadataDefs {
+		id := old.ID
+		if _, ok := m.metadata[id]; ok {
+			panic(fmt.Errorf("metadata ID %d already present; old `%v`, new `%v`", id, m.metadata[id], old))
+		}
+		node := newMetadataNode(old.Node, old.Distinct)
+		node.SetID(id)
+		m.MetadataDefs = append(m.MetadataDefs, node)
+		m.metadata[id] = node
+	}
+
 	// Index global variables.
 	for _, old := range module.Globals {
 		name := old.Name
@@ -59,7 +110,18 @@ func Translate(module *ast.Module) (*ir.Module, error) {
 			panic(fmt.Errorf("global identifier %q already present; old `%v`, new `%v`", name, m.globals[name], old))
 		}
 		global := &ir.Global{
-			Name: name,
+			Name:            name,
+			Linkage:         irLinkage(old.Linkage),
+			Preemption:      irPreemption(old.Preemption),
+			Visibility:      irVisibility(old.Visibility),
+			DLLStorageClass: irDLLStorageClass(old.DLLStorageClass),
+			ThreadLocal:     irThreadLocalMode(old.ThreadLocal),
+			UnnamedAddr:     irUnnamedAddr(old.UnnamedAddr),
+			Section:         old.Section,
+			Partition:       old.Partition,
+			Comdat:          m.getComdat(old.Comdat),
+			Align:           old.Align,
+			Metadata:        m.irMetadataAttachments(old.Metadata),
 		}
 		// Store preliminary content type.
 		content := m.irType(old.Content)
@@ -69,6 +131,49 @@ func Translate(module *ast.Module) (*ir.Module, error) {
 		m.globals[name] = global
 	}
 
+	// Index aliases.
+	for _, old := range module.Aliases {
+		name := old.Name
+		if _, ok := m.globals[name]; ok {
+			panic(fmt.Errorf("global identifier %q already present; old `%v`, new `%v`", name, m.globals[name], old))
+		}
+		alias := &ir.Alias{
+			Name:            name,
+			Linkage:         irLinkage(old.Linkage),
+			Preemption:      irPreemption(old.Preemption),
+			Visibility:      irVisibility(old.Visibility),
+			DLLStorageClass: irDLLStorageClass(old.DLLStorageClass),
+			ThreadLocal:     irThreadLocalMode(old.ThreadLocal),
+			UnnamedAddr:     irUnnamedAddr(old.UnnamedAddr),
+		}
+		// Store preliminary content type.
+		content := m.irType(old.Content)
+		alias.Typ = types.NewPointer(content)
+		alias.Content = content
+		m.Aliases = append(m.Aliases, alias)
+		m.globals[name] = alias
+	}
+
+	// Index IFuncs.
+	for _, old := range module.IFuncs {
+		name := old.Name
+		if _, ok := m.globals[name]; ok {
+			panic(fmt.Errorf("global identifier %q already present; old `%v`, new `%v`", name, m.globals[name], old))
+		}
+		ifunc := &ir.IFunc{
+			Name:       name,
+			Linkage:    irLinkage(old.Linkage),
+			Preemption: irPreemption(old.Preemption),
+			Visibility: irVisibility(old.Visibility),
+		}
+		// Store preliminary content type.
+		content := m.irType(old.Content)
+		ifunc.Typ = types.NewPointer(content)
+		ifunc.Content = content
+		m.IFuncs = append(m.IFuncs, ifunc)
+		m.globals[name] = ifunc
+	}
+
 	// Index functions.
 	for _, old := range module.Funcs {
 		name := old.Name
@@ -83,10 +188,22 @@ func Translate(module *ast.Module) (*ir.Module, error) {
 		}
 		typ := types.NewPointer(sig)
 		f := &ir.Function{
-			Parent: m.Module,
-			Name:   name,
-			Typ:    typ,
-			Sig:    sig,
+			Parent:          m.Module,
+			Name:            name,
+			Typ:             typ,
+			Sig:             sig,
+			Linkage:         irLinkage(old.Linkage),
+			Preemption:      irPreemption(old.Preemption),
+			Visibility:      irVisibility(old.Visibility),
+			DLLStorageClass: irDLLStorageClass(old.DLLStorageClass),
+			UnnamedAddr:     irUnnamedAddr(old.UnnamedAddr),
+			ReturnAttrs:     irParamAttrs(old.ReturnAttrs),
+			FuncAttrs:       m.irFuncAttrs(old.FuncAttrs),
+			Section:         old.Section,
+			Partition:       old.Partition,
+			Comdat:          m.getComdat(old.Comdat),
+			Align:           old.Align,
+			Metadata:        m.irMetadataAttachments(old.Metadata),
 		}
 		m.Funcs = append(m.Funcs, f)
 		m.globals[name] = f
@@ -97,16 +214,46 @@ func Translate(module *ast.Module) (*ir.Module, error) {
 		m.typeDef(typ)
 	}
 
+	// Fix attribute group definitions.
+	for _, group := range module.AttrGroupDefs {
+		m.attrGroupDef(group)
+	}
+
+	// Fix metadata definitions.
+	for _, node := range module.MetadataDefs {
+		m.metadataDef(node)
+	}
+
+	// Fix named metadata definitions.
+	for _, md := range module.NamedMetadataDefs {
+		m.namedMetadataDef(md)
+	}
+
 	// Fix globals.
 	for _, global := range module.Globals {
 		m.globalDecl(global)
 	}
 
+	// Fix aliases.
+	for _, alias := range module.Aliases {
+		m.aliasDecl(alias)
+	}
+
+	// Fix IFuncs.
+	for _, ifunc := range module.IFuncs {
+		m.ifuncDecl(ifunc)
+	}
+
 	// Fix functions.
 	for _, f := range module.Funcs {
 		m.funcDecl(f)
 	}
 
+	// Fix block addresses.
+	for _, addr := range m.blockAddrs {
+		m.blockAddress(addr)
+	}
+
 	if len(m.errs) > 0 {
 		// TODO: Return a list of all errors.
 		return nil, m.errs[0]
@@ -129,10 +276,14 @@ func newEmptyNamedType(old ast.Type) types.Type {
 		return &types.PointerType{}
 	case *ast.VectorType:
 		return &types.VectorType{}
+	case *ast.MMXType:
+		return &types.MMXType{}
 	case *ast.LabelType:
 		return &types.LabelType{}
 	case *ast.MetadataType:
 		return &types.MetadataType{}
+	case *ast.TokenType:
+		return &types.TokenType{}
 	case *ast.ArrayType:
 		return &types.ArrayType{}
 	case *ast.StructType:
@@ -191,6 +342,13 @@ func (m *Module) typeDef(old *ast.NamedType) {
 		}
 		typ.Elem = d.Elem
 		typ.Len = d.Len
+		typ.Scalable = d.Scalable
+	case *types.MMXType:
+		_, ok := def.(*types.MMXType)
+		if !ok {
+			panic(fmt.Errorf("invalid type; expected *types.MMXType, got %T", def))
+		}
+		// nothing to do.
 	case *types.LabelType:
 		_, ok := def.(*types.LabelType)
 		if !ok {
@@ -203,6 +361,12 @@ func (m *Module) typeDef(old *ast.NamedType) {
 			panic(fmt.Errorf("invalid type; expected *types.MetadataType, got %T", def))
 		}
 		// nothing to do.
+	case *types.TokenType:
+		_, ok := def.(*types.TokenType)
+		if !ok {
+			panic(fmt.Errorf("invalid type; expected *types.TokenType, got %T", def))
+		}
+		// nothing to do.
 	case *types.ArrayType:
 		d, ok := def.(*types.ArrayType)
 		if !ok {
@@ -216,12 +380,49 @@ func (m *Module) typeDef(old *ast.NamedType) {
 			panic(fmt.Errorf("invalid type; expected *types.StructType, got %T", def))
 		}
 		typ.Fields = d.Fields
+		typ.Packed = d.Packed
 		typ.Opaque = d.Opaque
 	default:
 		panic(fmt.Errorf("support for type %T not yet implemented", typ))
 	}
 }
 
+// === [ Attribute groups ] ====================================================
+
+// attrGroupDef translates the given attribute group definition to LLVM IR,
+// emitting code to m.
+func (m *Module) attrGroupDef(old *ast.AttrGroupDef) {
+	group, ok := m.attrGroups[old.ID]
+	if !ok {
+		panic(fmt.Errorf("unable to locate attribute group ID %d", old.ID))
+	}
+	group.FuncAttrs = m.irFuncAttrs(old.FuncAttrs)
+}
+
+// === [ Metadata ] ============================================================
+
+// metadataDef translates the given metadata node definition to LLVM IR,
+// emitting code to m.
+func (m *Module) metadataDef(old *ast.MetadataDef) {
+	node, ok := m.metadata[old.ID]
+	if !ok {
+		panic(fmt.Errorf("unable to locate metadata ID %d", old.ID))
+	}
+	m.fixMetadataNode(node, old.Node)
+}
+
+// namedMetadataDef translates the given named metadata definition to LLVM IR,
+// emitting code to m.
+func (m *Module) namedMetadataDef(old *ast.NamedMetadataDef) {
+	md := &metadata.Named{Name: old.Name}
+	for _, id := range old.IDs {
+		if node := m.getMetadata(int64(id)); node != nil {
+			md.Nodes = append(md.Nodes, node)
+		}
+	}
+	m.NamedMetadata = append(m.NamedMetadata, md)
+}
+
 // === [ Global variables ] ====================================================
 
 // globalDecl translates the given global variable declaration to LLVM IR,
@@ -246,6 +447,32 @@ func (m *Module) globalDecl(old *ast.Global) {
 	global.IsConst = old.Immutable
 }
 
+// === [ Aliases ] =============================================================
+
+// aliasDecl translates the given alias declaration to LLVM IR, emitting code
+// to m.
+func (m *Module) aliasDecl(old *ast.Alias) {
+	v := m.getGlobal(old.Name)
+	alias, ok := v.(*ir.Alias)
+	if !ok {
+		panic(fmt.Errorf("invalid alias type; expected *ir.Alias, got %T", v))
+	}
+	alias.Aliasee = m.irConstant(old.Aliasee)
+}
+
+// === [ IFuncs ] ==============================================================
+
+// ifuncDecl translates the given IFunc declaration to LLVM IR, emitting code
+// to m.
+func (m *Module) ifuncDecl(old *ast.IFunc) {
+	v := m.getGlobal(old.Name)
+	ifunc, ok := v.(*ir.IFunc)
+	if !ok {
+		panic(fmt.Errorf("invalid IFunc type; expected *ir.IFunc, got %T", v))
+	}
+	ifunc.Resolver = m.irConstant(old.Resolver)
+}
+
 // === [ Functions ] ===========================================================
 
 // funcDecl translates the given function declaration to LLVM IR, emitting code
@@ -264,6 +491,9 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 	if !ok {
 		panic(fmt.Errorf("invalid function type for function %s; expected *ir.Function, got %T", enc.Global(oldFunc.Name), v))
 	}
+	if oldFunc.Personality != nil {
+		f.Personality = m.irConstant(oldFunc.Personality)
+	}
 
 	// Index function parameters.
 	for _, param := range f.Params() {
@@ -389,6 +619,35 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 					Name:   oldInst.Name,
 				}
 
+			// Vector instructions
+			case *ast.InstExtractElement:
+				inst = &ir.InstExtractElement{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstInsertElement:
+				inst = &ir.InstInsertElement{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstShuffleVector:
+				inst = &ir.InstShuffleVector{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+
+			// Aggregate instructions
+			case *ast.InstExtractValue:
+				inst = &ir.InstExtractValue{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstInsertValue:
+				inst = &ir.InstInsertValue{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+
 			// Memory instructions
 			case *ast.InstAlloca:
 				inst = &ir.InstAlloca{
@@ -406,6 +665,22 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 				inst = &ir.InstStore{
 					Parent: block,
 				}
+			case *ast.InstFence:
+				// Fence instructions produce no value, and are thus not assigned
+				// names.
+				inst = &ir.InstFence{
+					Parent: block,
+				}
+			case *ast.InstCmpXchg:
+				inst = &ir.InstCmpXchg{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstAtomicRMW:
+				inst = &ir.InstAtomicRMW{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
 			case *ast.InstGetElementPtr:
 				inst = &ir.InstGetElementPtr{
 					Parent: block,
@@ -505,6 +780,21 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 					Parent: block,
 					Name:   oldInst.Name,
 				}
+			case *ast.InstLandingPad:
+				inst = &ir.InstLandingPad{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstCatchPad:
+				inst = &ir.InstCatchPad{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
+			case *ast.InstCleanupPad:
+				inst = &ir.InstCleanupPad{
+					Parent: block,
+					Name:   oldInst.Name,
+				}
 
 			default:
 				panic(fmt.Errorf("support for instruction %T not yet implemented", oldInst))
@@ -523,14 +813,34 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 			// Index local variable.
 			if inst, ok := inst.(value.Named); ok {
 				// Ignore local value if of type void.
-				if oldInst, ok := oldInst.(*ast.InstCall); ok {
-					if _, ok := oldInst.Type.(*ast.VoidType); ok {
-						continue
-					}
+				if oldInst, ok := oldInst.(*ast.InstCall); ok && isVoidCallType(oldInst.Type) {
+					continue
 				}
 				m.locals[inst.GetName()] = inst
 			}
 		}
+
+		// Index local variables produced by terminators.
+		switch oldTerm := oldBlock.Term.(type) {
+		case *ast.TermInvoke:
+			term := &ir.TermInvoke{
+				Parent: block,
+				Name:   oldTerm.Name,
+			}
+			block.Term = term
+			// Ignore local value if of type void.
+			if isVoidCallType(oldTerm.Type) {
+				continue
+			}
+			m.locals[term.GetName()] = term
+		case *ast.TermCatchSwitch:
+			term := &ir.TermCatchSwitch{
+				Parent: block,
+				Name:   oldTerm.Name,
+			}
+			block.Term = term
+			m.locals[term.GetName()] = term
+		}
 	}
 
 	// Fix basic blocks.
@@ -541,6 +851,32 @@ func (m *Module) funcDecl(oldFunc *ast.Function) {
 	}
 }
 
+// === [ Block addresses ] =====================================================
+
+// A blockAddr represents a blockaddress constant whose basic block has yet to
+// be resolved.
+type blockAddr struct {
+	// blockaddress constant.
+	c *constant.BlockAddress
+	// Basic block label name.
+	block string
+}
+
+// blockAddress resolves the basic block of the given blockaddress constant.
+func (m *Module) blockAddress(addr *blockAddr) {
+	f, ok := addr.c.Func.(*ir.Function)
+	if !ok {
+		panic(fmt.Errorf("invalid blockaddress function type; expected *ir.Function, got %T", addr.c.Func))
+	}
+	for _, block := range f.Blocks {
+		if block.Name == addr.block {
+			addr.c.Block = block
+			return
+		}
+	}
+	m.errs = append(m.errs, errors.Errorf("unable to locate basic block %s of function %s in blockaddress constant", enc.Local(addr.block), f.Ident()))
+}
+
 // === [ Identifiers ] =========================================================
 
 // === [ Types ] ===============================================================
@@ -576,6 +912,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.OverflowFlags = irOverflowFlags(oldInst.OverflowFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFAdd:
 			inst, ok := v.(*ir.InstFAdd)
 			if !ok {
@@ -583,6 +921,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSub:
 			inst, ok := v.(*ir.InstSub)
 			if !ok {
@@ -590,6 +930,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.OverflowFlags = irOverflowFlags(oldInst.OverflowFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFSub:
 			inst, ok := v.(*ir.InstFSub)
 			if !ok {
@@ -597,6 +939,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstMul:
 			inst, ok := v.(*ir.InstMul)
 			if !ok {
@@ -604,6 +948,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.OverflowFlags = irOverflowFlags(oldInst.OverflowFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFMul:
 			inst, ok := v.(*ir.InstFMul)
 			if !ok {
@@ -611,6 +957,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstUDiv:
 			inst, ok := v.(*ir.InstUDiv)
 			if !ok {
@@ -618,6 +966,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Exact = oldInst.Exact
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSDiv:
 			inst, ok := v.(*ir.InstSDiv)
 			if !ok {
@@ -625,6 +975,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Exact = oldInst.Exact
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFDiv:
 			inst, ok := v.(*ir.InstFDiv)
 			if !ok {
@@ -632,6 +984,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstURem:
 			inst, ok := v.(*ir.InstURem)
 			if !ok {
@@ -639,6 +993,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSRem:
 			inst, ok := v.(*ir.InstSRem)
 			if !ok {
@@ -646,6 +1001,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFRem:
 			inst, ok := v.(*ir.InstFRem)
 			if !ok {
@@ -653,6 +1009,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 
 		// Bitwise instructions
 		case *ast.InstShl:
@@ -662,6 +1020,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.OverflowFlags = irOverflowFlags(oldInst.OverflowFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstLShr:
 			inst, ok := v.(*ir.InstLShr)
 			if !ok {
@@ -669,6 +1029,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Exact = oldInst.Exact
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstAShr:
 			inst, ok := v.(*ir.InstAShr)
 			if !ok {
@@ -676,6 +1038,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Exact = oldInst.Exact
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstAnd:
 			inst, ok := v.(*ir.InstAnd)
 			if !ok {
@@ -683,6 +1047,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstOr:
 			inst, ok := v.(*ir.InstOr)
 			if !ok {
@@ -690,6 +1055,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstXor:
 			inst, ok := v.(*ir.InstXor)
 			if !ok {
@@ -697,6 +1063,78 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+
+		// Vector instructions
+		case *ast.InstExtractElement:
+			inst, ok := v.(*ir.InstExtractElement)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstExtractElement, got %T", v))
+			}
+			x := m.irValue(oldInst.X)
+			t, ok := x.Type().(*types.VectorType)
+			if !ok {
+				panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", x.Type()))
+			}
+			inst.Typ = t.Elem
+			inst.X = x
+			inst.Index = m.irValue(oldInst.Index)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstInsertElement:
+			inst, ok := v.(*ir.InstInsertElement)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstInsertElement, got %T", v))
+			}
+			inst.X = m.irValue(oldInst.X)
+			inst.Elem = m.irValue(oldInst.Elem)
+			inst.Index = m.irValue(oldInst.Index)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstShuffleVector:
+			inst, ok := v.(*ir.InstShuffleVector)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstShuffleVector, got %T", v))
+			}
+			x := m.irValue(oldInst.X)
+			xType, ok := x.Type().(*types.VectorType)
+			if !ok {
+				panic(fmt.Errorf("invalid vector type; expected *types.VectorType, got %T", x.Type()))
+			}
+			mask := m.irValue(oldInst.Mask)
+			maskType, ok := mask.Type().(*types.VectorType)
+			if !ok {
+				panic(fmt.Errorf("invalid shuffle mask type; expected *types.VectorType, got %T", mask.Type()))
+			}
+			inst.Typ = types.NewVector(xType.Elem, maskType.Len)
+			inst.Typ.Scalable = maskType.Scalable
+			inst.X = x
+			inst.Y = m.irValue(oldInst.Y)
+			inst.Mask = mask
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+
+		// Aggregate instructions
+		case *ast.InstExtractValue:
+			inst, ok := v.(*ir.InstExtractValue)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstExtractValue, got %T", v))
+			}
+			x := m.irValue(oldInst.X)
+			typ, err := types.AggregateElemType(x.Type(), oldInst.Indices)
+			if err != nil {
+				m.errs = append(m.errs, err)
+			}
+			inst.Typ = typ
+			inst.X = x
+			inst.Indices = oldInst.Indices
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstInsertValue:
+			inst, ok := v.(*ir.InstInsertValue)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstInsertValue, got %T", v))
+			}
+			inst.X = m.irValue(oldInst.X)
+			inst.Elem = m.irValue(oldInst.Elem)
+			inst.Indices = oldInst.Indices
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 
 		// Memory instructions
 		case *ast.InstAlloca:
@@ -711,6 +1149,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			if oldInst.NElems != nil {
 				inst.NElems = m.irValue(oldInst.NElems)
 			}
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstLoad:
 			inst, ok := v.(*ir.InstLoad)
 			if !ok {
@@ -727,6 +1166,11 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.Typ = typ
 			inst.Src = src
+			inst.Volatile = oldInst.Volatile
+			inst.Ordering = irAtomicOrdering(oldInst.Ordering)
+			inst.SyncScope = oldInst.SyncScope
+			inst.Align = oldInst.Align
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstStore:
 			inst, ok := v.(*ir.InstStore)
 			if !ok {
@@ -734,6 +1178,49 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.Src = m.irValue(oldInst.Src)
 			inst.Dst = m.irValue(oldInst.Dst)
+			inst.Volatile = oldInst.Volatile
+			inst.Ordering = irAtomicOrdering(oldInst.Ordering)
+			inst.SyncScope = oldInst.SyncScope
+			inst.Align = oldInst.Align
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstFence:
+			inst, ok := v.(*ir.InstFence)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstFence, got %T", v))
+			}
+			inst.Ordering = irAtomicOrdering(oldInst.Ordering)
+			inst.SyncScope = oldInst.SyncScope
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstCmpXchg:
+			inst, ok := v.(*ir.InstCmpXchg)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstCmpXchg, got %T", v))
+			}
+			cmp := m.irValue(oldInst.Cmp)
+			inst.Typ = types.NewStruct(cmp.Type(), types.I1)
+			inst.Ptr = m.irValue(oldInst.Ptr)
+			inst.Cmp = cmp
+			inst.New = m.irValue(oldInst.New)
+			inst.Success = irAtomicOrdering(oldInst.Success)
+			inst.Failure = irAtomicOrdering(oldInst.Failure)
+			inst.Weak = oldInst.Weak
+			inst.Volatile = oldInst.Volatile
+			inst.SyncScope = oldInst.SyncScope
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstAtomicRMW:
+			inst, ok := v.(*ir.InstAtomicRMW)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstAtomicRMW, got %T", v))
+			}
+			x := m.irValue(oldInst.X)
+			inst.Typ = x.Type()
+			inst.Op = irAtomicOp(oldInst.Op)
+			inst.Ptr = m.irValue(oldInst.Ptr)
+			inst.X = x
+			inst.Ordering = irAtomicOrdering(oldInst.Ordering)
+			inst.Volatile = oldInst.Volatile
+			inst.SyncScope = oldInst.SyncScope
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstGetElementPtr:
 			inst, ok := v.(*ir.InstGetElementPtr)
 			if !ok {
@@ -783,6 +1270,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			inst.Elem = elem
 			inst.Src = src
 			inst.Indices = indices
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 
 		// Conversion instructions
 		case *ast.InstTrunc:
@@ -792,6 +1280,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstZExt:
 			inst, ok := v.(*ir.InstZExt)
 			if !ok {
@@ -799,6 +1288,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSExt:
 			inst, ok := v.(*ir.InstSExt)
 			if !ok {
@@ -806,6 +1296,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFPTrunc:
 			inst, ok := v.(*ir.InstFPTrunc)
 			if !ok {
@@ -813,6 +1304,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFPExt:
 			inst, ok := v.(*ir.InstFPExt)
 			if !ok {
@@ -820,6 +1312,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFPToUI:
 			inst, ok := v.(*ir.InstFPToUI)
 			if !ok {
@@ -827,6 +1320,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFPToSI:
 			inst, ok := v.(*ir.InstFPToSI)
 			if !ok {
@@ -834,6 +1328,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstUIToFP:
 			inst, ok := v.(*ir.InstUIToFP)
 			if !ok {
@@ -841,6 +1336,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSIToFP:
 			inst, ok := v.(*ir.InstSIToFP)
 			if !ok {
@@ -848,6 +1344,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstPtrToInt:
 			inst, ok := v.(*ir.InstPtrToInt)
 			if !ok {
@@ -855,6 +1352,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstIntToPtr:
 			inst, ok := v.(*ir.InstIntToPtr)
 			if !ok {
@@ -862,6 +1360,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstBitCast:
 			inst, ok := v.(*ir.InstBitCast)
 			if !ok {
@@ -869,6 +1368,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstAddrSpaceCast:
 			inst, ok := v.(*ir.InstAddrSpaceCast)
 			if !ok {
@@ -876,6 +1376,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			}
 			inst.From = m.irValue(oldInst.From)
 			inst.To = m.irType(oldInst.To)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 
 		// Other instructions
 		case *ast.InstICmp:
@@ -888,12 +1389,15 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			y := m.irValue(oldInst.Y)
 			var typ types.Type = types.I1
 			if t, ok := x.Type().(*types.VectorType); ok {
-				typ = types.NewVector(types.I1, t.Len)
+				vt := types.NewVector(types.I1, t.Len)
+				vt.Scalable = t.Scalable
+				typ = vt
 			}
 			inst.Typ = typ
 			inst.Cond = cond
 			inst.X = x
 			inst.Y = y
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstFCmp:
 			inst, ok := v.(*ir.InstFCmp)
 			if !ok {
@@ -904,12 +1408,16 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			y := m.irValue(oldInst.Y)
 			var typ types.Type = types.I1
 			if t, ok := x.Type().(*types.VectorType); ok {
-				typ = types.NewVector(types.I1, t.Len)
+				vt := types.NewVector(types.I1, t.Len)
+				vt.Scalable = t.Scalable
+				typ = vt
 			}
 			inst.Typ = typ
 			inst.Cond = cond
 			inst.X = x
 			inst.Y = y
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstPhi:
 			inst, ok := v.(*ir.InstPhi)
 			if !ok {
@@ -929,6 +1437,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 				}
 				inst.Incs = append(inst.Incs, inc)
 			}
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstSelect:
 			inst, ok := v.(*ir.InstSelect)
 			if !ok {
@@ -937,6 +1446,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			inst.Cond = m.irValue(oldInst.Cond)
 			inst.X = m.irValue(oldInst.X)
 			inst.Y = m.irValue(oldInst.Y)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		case *ast.InstCall:
 			inst, ok := v.(*ir.InstCall)
 			if !ok {
@@ -962,7 +1472,53 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 				arg := m.irValue(oldArg)
 				inst.Args = append(inst.Args, arg)
 			}
+			inst.FastMathFlags = irFastMathFlags(oldInst.FastMathFlags)
+			inst.ReturnAttrs = irParamAttrs(oldInst.ReturnAttrs)
+			inst.FuncAttrs = m.irFuncAttrs(oldInst.FuncAttrs)
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstLandingPad:
+			inst, ok := v.(*ir.InstLandingPad)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstLandingPad, got %T", v))
+			}
+			inst.Typ = m.irType(oldInst.Type)
+			inst.Cleanup = oldInst.Cleanup
+			for _, oldClause := range oldInst.Clauses {
+				clause := &ir.Clause{
+					Kind: irClauseKind(oldClause.Kind),
+					X:    m.irConstant(oldClause.X),
+				}
+				inst.Clauses = append(inst.Clauses, clause)
+			}
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstCatchPad:
+			inst, ok := v.(*ir.InstCatchPad)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstCatchPad, got %T", v))
+			}
+			cs := m.irValue(oldInst.CatchSwitch)
+			catchSwitch, ok := cs.(*ir.TermCatchSwitch)
+			if !ok {
+				panic(fmt.Errorf("invalid parent catchswitch type; expected *ir.TermCatchSwitch, got %T", cs))
+			}
+			inst.CatchSwitch = catchSwitch
+			for _, oldArg := range oldInst.Args {
+				arg := m.irValue(oldArg)
+				inst.Args = append(inst.Args, arg)
+			}
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
+		case *ast.InstCleanupPad:
+			inst, ok := v.(*ir.InstCleanupPad)
+			if !ok {
+				panic(fmt.Errorf("invalid instruction type; expected *ir.InstCleanupPad, got %T", v))
+			}
+			inst.ParentPad = m.irValue(oldInst.ParentPad)
+			for _, oldArg := range oldInst.Args {
+				arg := m.irValue(oldArg)
+				inst.Args = append(inst.Args, arg)
+			}
 
+			inst.Metadata = m.irMetadataAttachments(oldInst.Metadata)
 		default:
 			panic(fmt.Errorf("support for instruction %T not yet implemented", oldInst))
 		}
@@ -977,6 +1533,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 		if oldTerm.X != nil {
 			term.X = m.irValue(oldTerm.X)
 		}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
 		block.Term = term
 	case *ast.TermBr:
 		term := &ir.TermBr{
@@ -988,6 +1545,8 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			panic(fmt.Errorf("invalid target branch type, expected *ir.BasicBlock, got %T", v))
 		}
 		term.Target = target
+		term.Successors = []*ir.BasicBlock{target}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
 		block.Term = term
 	case *ast.TermCondBr:
 		term := &ir.TermCondBr{
@@ -1008,6 +1567,7 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 		term.TargetTrue = targetTrue
 		term.TargetFalse = targetFalse
 		term.Successors = successors
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
 		block.Term = term
 	case *ast.TermSwitch:
 		term := &ir.TermSwitch{
@@ -1040,11 +1600,142 @@ func (m *Module) basicBlock(oldBlock *ast.BasicBlock, block *ir.BasicBlock) {
 			successors = append(successors, target)
 		}
 		term.Successors = successors
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+		block.Term = term
+	case *ast.TermIndirectBr:
+		term := &ir.TermIndirectBr{
+			Parent: block,
+		}
+		term.Addr = m.irValue(oldTerm.Addr)
+		for _, oldTarget := range oldTerm.ValidTargets {
+			v := m.irValue(oldTarget)
+			target, ok := v.(*ir.BasicBlock)
+			if !ok {
+				panic(fmt.Errorf("invalid target branch type, expected *ir.BasicBlock, got %T", v))
+			}
+			term.ValidTargets = append(term.ValidTargets, target)
+		}
+		term.Successors = term.ValidTargets
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+		block.Term = term
+	case *ast.TermInvoke:
+		term, ok := block.Term.(*ir.TermInvoke)
+		if !ok {
+			panic(fmt.Errorf("invalid terminator type; expected *ir.TermInvoke, got %T", block.Term))
+		}
+		v := m.irValue(oldTerm.Callee)
+		callee, ok := v.(value.Named)
+		if !ok {
+			panic(fmt.Errorf("invalid callee type; expected value.Named, got %T", v))
+		}
+		typ, ok := callee.Type().(*types.PointerType)
+		if !ok {
+			panic(fmt.Errorf("invalid callee type, expected *types.PointerType, got %T", callee.Type()))
+		}
+		sig, ok := typ.Elem.(*types.FuncType)
+		if !ok {
+			panic(fmt.Errorf("invalid callee signature type, expected *types.FuncType, got %T", typ.Elem))
+		}
+		term.Callee = callee
+		term.Sig = sig
+		// TODO: Validate oldTerm.Type against term.Sig.
+		for _, oldArg := range oldTerm.Args {
+			arg := m.irValue(oldArg)
+			term.Args = append(term.Args, arg)
+		}
+		tNormal := m.irValue(oldTerm.TargetNormal)
+		targetNormal, ok := tNormal.(*ir.BasicBlock)
+		if !ok {
+			panic(fmt.Errorf("invalid normal target branch type, expected *ir.BasicBlock, got %T", tNormal))
+		}
+		tUnwind := m.irValue(oldTerm.TargetUnwind)
+		targetUnwind, ok := tUnwind.(*ir.BasicBlock)
+		if !ok {
+			panic(fmt.Errorf("invalid unwind target branch type, expected *ir.BasicBlock, got %T", tUnwind))
+		}
+		term.TargetNormal = targetNormal
+		term.TargetUnwind = targetUnwind
+		term.Successors = []*ir.BasicBlock{targetNormal, targetUnwind}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+	case *ast.TermResume:
+		term := &ir.TermResume{
+			Parent: block,
+		}
+		term.X = m.irValue(oldTerm.X)
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+		block.Term = term
+	case *ast.TermCatchSwitch:
+		term, ok := block.Term.(*ir.TermCatchSwitch)
+		if !ok {
+			panic(fmt.Errorf("invalid terminator type; expected *ir.TermCatchSwitch, got %T", block.Term))
+		}
+		term.ParentPad = m.irValue(oldTerm.ParentPad)
+		var successors []*ir.BasicBlock
+		for _, oldHandler := range oldTerm.Handlers {
+			v := m.irValue(oldHandler)
+			handler, ok := v.(*ir.BasicBlock)
+			if !ok {
+				panic(fmt.Errorf("invalid exception handler type, expected *ir.BasicBlock, got %T", v))
+			}
+			term.Handlers = append(term.Handlers, handler)
+			successors = append(successors, handler)
+		}
+		if oldTerm.UnwindTarget != nil {
+			v := m.irValue(oldTerm.UnwindTarget)
+			unwindTarget, ok := v.(*ir.BasicBlock)
+			if !ok {
+				panic(fmt.Errorf("invalid unwind target branch type, expected *ir.BasicBlock, got %T", v))
+			}
+			term.UnwindTarget = unwindTarget
+			successors = append(successors, unwindTarget)
+		}
+		term.Successors = successors
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+	case *ast.TermCatchRet:
+		term := &ir.TermCatchRet{
+			Parent: block,
+		}
+		cp := m.irValue(oldTerm.CatchPad)
+		catchPad, ok := cp.(*ir.InstCatchPad)
+		if !ok {
+			panic(fmt.Errorf("invalid exited catchpad type; expected *ir.InstCatchPad, got %T", cp))
+		}
+		v := m.irValue(oldTerm.Target)
+		target, ok := v.(*ir.BasicBlock)
+		if !ok {
+			panic(fmt.Errorf("invalid target branch type, expected *ir.BasicBlock, got %T", v))
+		}
+		term.CatchPad = catchPad
+		term.Target = target
+		term.Successors = []*ir.BasicBlock{target}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
+		block.Term = term
+	case *ast.TermCleanupRet:
+		term := &ir.TermCleanupRet{
+			Parent: block,
+		}
+		cp := m.irValue(oldTerm.CleanupPad)
+		cleanupPad, ok := cp.(*ir.InstCleanupPad)
+		if !ok {
+			panic(fmt.Errorf("invalid exited cleanuppad type; expected *ir.InstCleanupPad, got %T", cp))
+		}
+		term.CleanupPad = cleanupPad
+		if oldTerm.UnwindTarget != nil {
+			v := m.irValue(oldTerm.UnwindTarget)
+			unwindTarget, ok := v.(*ir.BasicBlock)
+			if !ok {
+				panic(fmt.Errorf("invalid unwind target branch type, expected *ir.BasicBlock, got %T", v))
+			}
+			term.UnwindTarget = unwindTarget
+			term.Successors = []*ir.BasicBlock{unwindTarget}
+		}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
 		block.Term = term
 	case *ast.TermUnreachable:
 		term := &ir.TermUnreachable{
 			Parent: block,
 		}
+		term.Metadata = m.irMetadataAttachments(oldTerm.Metadata)
 		block.Term = term
 	default:
 		panic(fmt.Errorf("support for terminator %T not yet implemented", oldTerm))
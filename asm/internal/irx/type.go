@@ -16,6 +16,7 @@ func (m *Module) irType(old ast.Type) types.Type {
 		params := make([]*types.Param, len(old.Params))
 		for i, oldParam := range old.Params {
 			params[i] = types.NewParam(oldParam.Name, m.irType(oldParam.Type))
+			params[i].Attrs = irParamAttrs(oldParam.Attrs)
 		}
 		typ := types.NewFunc(m.irType(old.Ret), params...)
 		typ.Variadic = old.Variadic
@@ -36,6 +37,8 @@ func (m *Module) irType(old ast.Type) types.Type {
 			return types.X86_FP80
 		case ast.FloatKindDoubleDouble_128:
 			return types.PPC_FP128
+		case ast.FloatKindBrain_16:
+			return types.BFloat
 		default:
 			panic(fmt.Errorf("support for %v not yet implemented", old.Kind))
 		}
@@ -44,11 +47,17 @@ func (m *Module) irType(old ast.Type) types.Type {
 		typ.AddrSpace = old.AddrSpace
 		return typ
 	case *ast.VectorType:
-		return types.NewVector(m.irType(old.Elem), old.Len)
+		typ := types.NewVector(m.irType(old.Elem), old.Len)
+		typ.Scalable = old.Scalable
+		return typ
+	case *ast.MMXType:
+		return types.X86_MMX
 	case *ast.LabelType:
 		return types.Label
 	case *ast.MetadataType:
 		return types.Metadata
+	case *ast.TokenType:
+		return types.Token
 	case *ast.ArrayType:
 		return types.NewArray(m.irType(old.Elem), old.Len)
 	case *ast.StructType:
@@ -57,6 +66,7 @@ func (m *Module) irType(old ast.Type) types.Type {
 			fields[i] = m.irType(oldField)
 		}
 		typ := types.NewStruct(fields...)
+		typ.Packed = old.Packed
 		typ.Opaque = old.Opaque
 		return typ
 	case *ast.NamedType:
@@ -5,8 +5,294 @@ import (
 
 	"github.com/llir/llvm/asm/internal/ast"
 	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
 )
 
+// irLinkage returns the corresponding LLVM IR linkage type of the given linkage
+// type.
+func irLinkage(linkage ast.Linkage) ir.Linkage {
+	switch linkage {
+	case ast.LinkageExternal:
+		return ir.LinkageExternal
+	case ast.LinkagePrivate:
+		return ir.LinkagePrivate
+	case ast.LinkageInternal:
+		return ir.LinkageInternal
+	case ast.LinkageAvailableExternally:
+		return ir.LinkageAvailableExternally
+	case ast.LinkageLinkOnce:
+		return ir.LinkageLinkOnce
+	case ast.LinkageWeak:
+		return ir.LinkageWeak
+	case ast.LinkageCommon:
+		return ir.LinkageCommon
+	case ast.LinkageAppending:
+		return ir.LinkageAppending
+	case ast.LinkageExternWeak:
+		return ir.LinkageExternWeak
+	case ast.LinkageLinkOnceODR:
+		return ir.LinkageLinkOnceODR
+	case ast.LinkageWeakODR:
+		return ir.LinkageWeakODR
+	}
+	panic(fmt.Errorf("support for linkage type %v not yet implemented", linkage))
+}
+
+// irPreemption returns the corresponding LLVM IR runtime preemption specifier
+// of the given runtime preemption specifier.
+func irPreemption(preemption ast.Preemption) ir.Preemption {
+	switch preemption {
+	case ast.PreemptionDSOPreemptable:
+		return ir.PreemptionDSOPreemptable
+	case ast.PreemptionDSOLocal:
+		return ir.PreemptionDSOLocal
+	}
+	panic(fmt.Errorf("support for runtime preemption specifier %v not yet implemented", preemption))
+}
+
+// irVisibility returns the corresponding LLVM IR visibility style of the given
+// visibility style.
+func irVisibility(visibility ast.Visibility) ir.Visibility {
+	switch visibility {
+	case ast.VisibilityDefault:
+		return ir.VisibilityDefault
+	case ast.VisibilityHidden:
+		return ir.VisibilityHidden
+	case ast.VisibilityProtected:
+		return ir.VisibilityProtected
+	}
+	panic(fmt.Errorf("support for visibility style %v not yet implemented", visibility))
+}
+
+// irDLLStorageClass returns the corresponding LLVM IR DLL storage class of the
+// given DLL storage class.
+func irDLLStorageClass(class ast.DLLStorageClass) ir.DLLStorageClass {
+	switch class {
+	case ast.DLLStorageNone:
+		return ir.DLLStorageNone
+	case ast.DLLStorageImport:
+		return ir.DLLStorageImport
+	case ast.DLLStorageExport:
+		return ir.DLLStorageExport
+	}
+	panic(fmt.Errorf("support for DLL storage class %v not yet implemented", class))
+}
+
+// irThreadLocalMode returns the corresponding LLVM IR thread-local storage
+// model of the given thread-local storage model.
+func irThreadLocalMode(mode ast.ThreadLocalMode) ir.ThreadLocalMode {
+	switch mode {
+	case ast.TLSNone:
+		return ir.TLSNone
+	case ast.TLSGeneralDynamic:
+		return ir.TLSGeneralDynamic
+	case ast.TLSLocalDynamic:
+		return ir.TLSLocalDynamic
+	case ast.TLSInitialExec:
+		return ir.TLSInitialExec
+	case ast.TLSLocalExec:
+		return ir.TLSLocalExec
+	}
+	panic(fmt.Errorf("support for thread-local storage model %v not yet implemented", mode))
+}
+
+// irUnnamedAddr returns the corresponding LLVM IR unnamed address specifier of
+// the given unnamed address specifier.
+func irUnnamedAddr(addr ast.UnnamedAddr) ir.UnnamedAddr {
+	switch addr {
+	case ast.UnnamedAddrNone:
+		return ir.UnnamedAddrNone
+	case ast.UnnamedAddrLocal:
+		return ir.UnnamedAddrLocal
+	case ast.UnnamedAddrGlobal:
+		return ir.UnnamedAddrGlobal
+	}
+	panic(fmt.Errorf("support for unnamed address specifier %v not yet implemented", addr))
+}
+
+// irSelectionKind returns the corresponding LLVM IR comdat selection kind of
+// the given comdat selection kind.
+func irSelectionKind(kind ast.SelectionKind) ir.SelectionKind {
+	switch kind {
+	case ast.SelectionAny:
+		return ir.SelectionAny
+	case ast.SelectionExactMatch:
+		return ir.SelectionExactMatch
+	case ast.SelectionLargest:
+		return ir.SelectionLargest
+	case ast.SelectionNoDuplicates:
+		return ir.SelectionNoDuplicates
+	case ast.SelectionSameSize:
+		return ir.SelectionSameSize
+	}
+	panic(fmt.Errorf("support for comdat selection kind %v not yet implemented", kind))
+}
+
+// irAttrKind returns the corresponding LLVM IR enum attribute of the given enum
+// attribute.
+func irAttrKind(kind ast.AttrKind) ir.AttrKind {
+	switch kind {
+	case ast.AttrAlwaysInline:
+		return ir.AttrAlwaysInline
+	case ast.AttrArgMemOnly:
+		return ir.AttrArgMemOnly
+	case ast.AttrBuiltin:
+		return ir.AttrBuiltin
+	case ast.AttrByVal:
+		return ir.AttrByVal
+	case ast.AttrCold:
+		return ir.AttrCold
+	case ast.AttrConvergent:
+		return ir.AttrConvergent
+	case ast.AttrInAlloca:
+		return ir.AttrInAlloca
+	case ast.AttrInReg:
+		return ir.AttrInReg
+	case ast.AttrInaccessibleMemOnly:
+		return ir.AttrInaccessibleMemOnly
+	case ast.AttrInaccessibleMemOrArgMemOnly:
+		return ir.AttrInaccessibleMemOrArgMemOnly
+	case ast.AttrInlineHint:
+		return ir.AttrInlineHint
+	case ast.AttrJumpTable:
+		return ir.AttrJumpTable
+	case ast.AttrMinSize:
+		return ir.AttrMinSize
+	case ast.AttrNaked:
+		return ir.AttrNaked
+	case ast.AttrNest:
+		return ir.AttrNest
+	case ast.AttrNoAlias:
+		return ir.AttrNoAlias
+	case ast.AttrNoBuiltin:
+		return ir.AttrNoBuiltin
+	case ast.AttrNoCapture:
+		return ir.AttrNoCapture
+	case ast.AttrNoDuplicate:
+		return ir.AttrNoDuplicate
+	case ast.AttrNoImplicitFloat:
+		return ir.AttrNoImplicitFloat
+	case ast.AttrNoInline:
+		return ir.AttrNoInline
+	case ast.AttrNoRecurse:
+		return ir.AttrNoRecurse
+	case ast.AttrNoRedZone:
+		return ir.AttrNoRedZone
+	case ast.AttrNoReturn:
+		return ir.AttrNoReturn
+	case ast.AttrNoUnwind:
+		return ir.AttrNoUnwind
+	case ast.AttrNonLazyBind:
+		return ir.AttrNonLazyBind
+	case ast.AttrNonNull:
+		return ir.AttrNonNull
+	case ast.AttrOptNone:
+		return ir.AttrOptNone
+	case ast.AttrOptSize:
+		return ir.AttrOptSize
+	case ast.AttrReadNone:
+		return ir.AttrReadNone
+	case ast.AttrReadOnly:
+		return ir.AttrReadOnly
+	case ast.AttrReturned:
+		return ir.AttrReturned
+	case ast.AttrReturnsTwice:
+		return ir.AttrReturnsTwice
+	case ast.AttrSExt:
+		return ir.AttrSExt
+	case ast.AttrSRet:
+		return ir.AttrSRet
+	case ast.AttrSafeStack:
+		return ir.AttrSafeStack
+	case ast.AttrSanitizeAddress:
+		return ir.AttrSanitizeAddress
+	case ast.AttrSanitizeMemory:
+		return ir.AttrSanitizeMemory
+	case ast.AttrSanitizeThread:
+		return ir.AttrSanitizeThread
+	case ast.AttrSpeculatable:
+		return ir.AttrSpeculatable
+	case ast.AttrSSP:
+		return ir.AttrSSP
+	case ast.AttrSSPReq:
+		return ir.AttrSSPReq
+	case ast.AttrSSPStrong:
+		return ir.AttrSSPStrong
+	case ast.AttrSwiftError:
+		return ir.AttrSwiftError
+	case ast.AttrSwiftSelf:
+		return ir.AttrSwiftSelf
+	case ast.AttrUWTable:
+		return ir.AttrUWTable
+	case ast.AttrWriteOnly:
+		return ir.AttrWriteOnly
+	case ast.AttrZExt:
+		return ir.AttrZExt
+	}
+	panic(fmt.Errorf("support for enum attribute %v not yet implemented", kind))
+}
+
+// irAttrIntKind returns the corresponding LLVM IR integer attribute kind of the
+// given integer attribute kind.
+func irAttrIntKind(kind ast.AttrIntKind) ir.AttrIntKind {
+	switch kind {
+	case ast.AttrAlign:
+		return ir.AttrAlign
+	case ast.AttrAlignStack:
+		return ir.AttrAlignStack
+	case ast.AttrDereferenceable:
+		return ir.AttrDereferenceable
+	case ast.AttrDereferenceableOrNull:
+		return ir.AttrDereferenceableOrNull
+	}
+	panic(fmt.Errorf("support for integer attribute kind %v not yet implemented", kind))
+}
+
+// irParamAttrs returns the corresponding LLVM IR parameter attributes of the
+// given parameter attributes.
+func irParamAttrs(attrs []ast.ParamAttribute) []types.ParamAttribute {
+	var as []types.ParamAttribute
+	for _, attr := range attrs {
+		switch attr := attr.(type) {
+		case ast.AttrKind:
+			as = append(as, irAttrKind(attr))
+		case *ast.AttrInt:
+			as = append(as, ir.NewAttrInt(irAttrIntKind(attr.Kind), attr.N))
+		case *ast.AttrString:
+			as = append(as, ir.NewAttrString(attr.Key, attr.Val))
+		default:
+			panic(fmt.Errorf("support for parameter attribute %T not yet implemented", attr))
+		}
+	}
+	return as
+}
+
+// irFuncAttrs returns the corresponding LLVM IR function attributes of the
+// given function attributes.
+func (m *Module) irFuncAttrs(attrs []ast.FuncAttribute) []ir.FuncAttribute {
+	var as []ir.FuncAttribute
+	for _, attr := range attrs {
+		switch attr := attr.(type) {
+		case ast.AttrKind:
+			as = append(as, irAttrKind(attr))
+		case *ast.AttrInt:
+			as = append(as, ir.NewAttrInt(irAttrIntKind(attr.Kind), attr.N))
+		case *ast.AttrAllocSize:
+			as = append(as, ir.NewAttrAllocSize(attr.ElemSize, attr.NumElems))
+		case *ast.AttrString:
+			as = append(as, ir.NewAttrString(attr.Key, attr.Val))
+		case ast.AttrGroupID:
+			if group := m.getAttrGroup(int64(attr)); group != nil {
+				as = append(as, group)
+			}
+		default:
+			panic(fmt.Errorf("support for function attribute %T not yet implemented", attr))
+		}
+	}
+	return as
+}
+
 // irIntPred returns the corresponding LLVM IR integer predicate of the given
 // integer predicate.
 func irIntPred(cond ast.IntPred) ir.IntPred {
@@ -74,3 +360,138 @@ func irFloatPred(cond ast.FloatPred) ir.FloatPred {
 	}
 	panic(fmt.Errorf("support for floating-point predicate %v not yet implemented", cond))
 }
+
+// irAtomicOrdering returns the corresponding LLVM IR atomic memory ordering
+// constraint of the given atomic memory ordering constraint.
+func irAtomicOrdering(ordering ast.AtomicOrdering) ir.AtomicOrdering {
+	switch ordering {
+	case ast.OrderingNone:
+		return ir.OrderingNone
+	case ast.OrderingUnordered:
+		return ir.OrderingUnordered
+	case ast.OrderingMonotonic:
+		return ir.OrderingMonotonic
+	case ast.OrderingAcquire:
+		return ir.OrderingAcquire
+	case ast.OrderingRelease:
+		return ir.OrderingRelease
+	case ast.OrderingAcqRel:
+		return ir.OrderingAcqRel
+	case ast.OrderingSeqCst:
+		return ir.OrderingSeqCst
+	}
+	panic(fmt.Errorf("support for atomic memory ordering %v not yet implemented", ordering))
+}
+
+// irAtomicOp returns the corresponding LLVM IR atomic operation of the given
+// atomic operation.
+func irAtomicOp(op ast.AtomicOp) ir.AtomicOp {
+	switch op {
+	case ast.AtomicXchg:
+		return ir.AtomicXchg
+	case ast.AtomicAdd:
+		return ir.AtomicAdd
+	case ast.AtomicSub:
+		return ir.AtomicSub
+	case ast.AtomicAnd:
+		return ir.AtomicAnd
+	case ast.AtomicNand:
+		return ir.AtomicNand
+	case ast.AtomicOr:
+		return ir.AtomicOr
+	case ast.AtomicXor:
+		return ir.AtomicXor
+	case ast.AtomicMax:
+		return ir.AtomicMax
+	case ast.AtomicMin:
+		return ir.AtomicMin
+	case ast.AtomicUMax:
+		return ir.AtomicUMax
+	case ast.AtomicUMin:
+		return ir.AtomicUMin
+	}
+	panic(fmt.Errorf("support for atomic operation %v not yet implemented", op))
+}
+
+// irClauseKind returns the corresponding LLVM IR landing pad clause kind of the
+// given clause kind.
+func irClauseKind(kind ast.ClauseKind) ir.ClauseKind {
+	switch kind {
+	case ast.ClauseCatch:
+		return ir.ClauseCatch
+	case ast.ClauseFilter:
+		return ir.ClauseFilter
+	}
+	panic(fmt.Errorf("support for clause kind %v not yet implemented", kind))
+}
+
+// irOverflowFlags returns the corresponding LLVM IR overflow flags of the
+// given overflow flags.
+func irOverflowFlags(flags []ast.OverflowFlag) []ir.OverflowFlag {
+	var fs []ir.OverflowFlag
+	for _, flag := range flags {
+		switch flag {
+		case ast.OverflowNUW:
+			fs = append(fs, ir.OverflowNUW)
+		case ast.OverflowNSW:
+			fs = append(fs, ir.OverflowNSW)
+		default:
+			panic(fmt.Errorf("support for overflow flag %v not yet implemented", flag))
+		}
+	}
+	return fs
+}
+
+// irConstOverflowFlags returns the corresponding LLVM IR constant expression
+// overflow flags of the given overflow flags.
+func irConstOverflowFlags(flags []ast.OverflowFlag) []constant.OverflowFlag {
+	var fs []constant.OverflowFlag
+	for _, flag := range flags {
+		switch flag {
+		case ast.OverflowNUW:
+			fs = append(fs, constant.OverflowNUW)
+		case ast.OverflowNSW:
+			fs = append(fs, constant.OverflowNSW)
+		default:
+			panic(fmt.Errorf("support for overflow flag %v not yet implemented", flag))
+		}
+	}
+	return fs
+}
+
+// irFastMathFlags returns the corresponding LLVM IR fast-math flags of the
+// given fast-math flags.
+func irFastMathFlags(flags []ast.FastMathFlag) []ir.FastMathFlag {
+	var fs []ir.FastMathFlag
+	for _, flag := range flags {
+		switch flag {
+		case ast.FastMathNNaN:
+			fs = append(fs, ir.FastMathNNaN)
+		case ast.FastMathNInf:
+			fs = append(fs, ir.FastMathNInf)
+		case ast.FastMathNSZ:
+			fs = append(fs, ir.FastMathNSZ)
+		case ast.FastMathARcp:
+			fs = append(fs, ir.FastMathARcp)
+		case ast.FastMathFast:
+			fs = append(fs, ir.FastMathFast)
+		default:
+			panic(fmt.Errorf("support for fast-math flag %v not yet implemented", flag))
+		}
+	}
+	return fs
+}
+
+// isVoidCallType reports whether the given type of a call or invoke
+// instruction denotes a void return.
+func isVoidCallType(typ ast.Type) bool {
+	if _, ok := typ.(*ast.VoidType); ok {
+		return true
+	}
+	if sig, ok := typ.(*ast.FuncType); ok {
+		if _, ok := sig.Ret.(*ast.VoidType); ok {
+			return true
+		}
+	}
+	return false
+}
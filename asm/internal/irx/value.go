@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/llir/llvm/asm/internal/ast"
+	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/value"
 )
 
@@ -17,14 +18,20 @@ func (m *Module) irValue(old ast.Value) value.Value {
 	case ast.NamedValue:
 		switch old := old.(type) {
 		// Global identifiers.
-		case *ast.Global, *ast.GlobalDummy, *ast.Function:
+		case *ast.Global, *ast.Alias, *ast.IFunc, *ast.GlobalDummy, *ast.Function:
 			return m.getGlobal(old.GetName())
 		// Local identifiers.
-		case *ast.Param, *ast.BasicBlock, *ast.LocalDummy, ast.Instruction:
+		case *ast.Param, *ast.BasicBlock, *ast.LocalDummy, ast.Instruction, ast.Terminator:
 			return m.getLocal(old.GetName())
 		default:
 			panic(fmt.Errorf("support for named value %T not yet implemented", old))
 		}
+	// Function arguments.
+	case *ast.Arg:
+		return ir.NewArg(m.irValue(old.Value), irParamAttrs(old.Attrs)...)
+	// Metadata.
+	case ast.Metadata:
+		return m.irMetadata(old)
 	default:
 		panic(fmt.Errorf("support for value %T not yet implemented", old))
 	}
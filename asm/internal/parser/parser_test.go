@@ -39,8 +39,26 @@ func TestParseString(t *testing.T) {
 		{path: "../testdata/gep_forward_reference.ll"},
 		{path: "../testdata/const_struct.ll"},
 		{path: "../testdata/float16.ll"},
+		{path: "../testdata/aggregate.ll"},
+		{path: "../testdata/vector.ll"},
+		{path: "../testdata/atomic.ll"},
+		{path: "../testdata/exception.ll"},
+		{path: "../testdata/funclet.ll"},
+		{path: "../testdata/blockaddress.ll"},
+		{path: "../testdata/flags.ll"},
+		{path: "../testdata/linkage.ll"},
+		{path: "../testdata/alias.ll"},
+		{path: "../testdata/comdat.ll"},
+		{path: "../testdata/attribute.ll"},
+		{path: "../testdata/metadata.ll"},
+		{path: "../testdata/debug.ll"},
+		{path: "../testdata/debug_cxx.ll"},
+		{path: "../testdata/undef.ll"},
+		{path: "../testdata/module.ll"},
+		{path: "../testdata/packed_struct.ll"},
+		{path: "../testdata/types.ll"},
 		//{path: "../testdata/float128.ll"},
-		//{path: "../testdata/hex_float.ll"},
+		{path: "../testdata/hex_float.ll"},
 		//{path: "../testdata/float_literals.ll"},
 	}
 	for _, g := range golden {
@@ -0,0 +1,16 @@
+%pair = type { i32, i8 }
+@x = global i32 extractvalue (%pair { i32 1, i8 2 }, 0)
+@y = global [2 x %pair] insertvalue ([2 x %pair] zeroinitializer, i8 3, 1, 1)
+define %pair @f(%pair, i32) {
+; <label>:2
+	%3 = extractvalue %pair %0, 0
+	%4 = add i32 %3, %1
+	%5 = insertvalue %pair %0, i32 %4, 0
+	ret %pair %5
+}
+define [2 x %pair] @g([2 x %pair]) {
+; <label>:1
+	%2 = extractvalue [2 x %pair] %0, 1, 1
+	%3 = insertvalue [2 x %pair] %0, i8 %2, 0, 1
+	ret [2 x %pair] %3
+}
@@ -0,0 +1,21 @@
+@x = global i32 0
+@a = internal alias i32, i32* @x
+@b = hidden unnamed_addr alias i8, i8* bitcast (i32* @a to i8*)
+@c = dllexport alias void (), void ()* @f
+@d = dso_local alias i32, i32* @x
+@g = weak_odr ifunc void (), void ()* ()* @resolve_f
+@h = dso_local ifunc void (), void ()* ()* @resolve_f
+define void @f() {
+; <label>:0
+	ret void
+}
+define void ()* @resolve_f() {
+; <label>:0
+	ret void ()* @f
+}
+define void @call_g() {
+; <label>:0
+	call void @g()
+	%1 = load i8, i8* @b
+	ret void
+}
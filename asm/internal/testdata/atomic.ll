@@ -0,0 +1,26 @@
+define i32 @f(i32*, i32) {
+; <label>:2
+	%3 = load i32, i32* %0, align 4
+	%4 = load volatile i32, i32* %0
+	%5 = load atomic i32, i32* %0 acquire, align 4
+	%6 = load atomic volatile i32, i32* %0 syncscope("singlethread") seq_cst, align 4
+	store volatile i32 %3, i32* %0
+	store atomic i32 %4, i32* %0 release, align 4
+	store atomic i32 %5, i32* %0 syncscope("agent") unordered, align 4
+	fence acquire
+	fence syncscope("singlethread") seq_cst
+	%7 = cmpxchg i32* %0, i32 %6, i32 %1 acq_rel monotonic
+	%8 = cmpxchg weak volatile i32* %0, i32 %6, i32 0 syncscope("singlethread") seq_cst acquire
+	%9 = atomicrmw volatile xchg i32* %0, i32 %1 syncscope("singlethread") seq_cst
+	%10 = atomicrmw add i32* %0, i32 %1 monotonic
+	%11 = atomicrmw sub i32* %0, i32 %1 monotonic
+	%12 = atomicrmw and i32* %0, i32 %1 monotonic
+	%13 = atomicrmw nand i32* %0, i32 %1 monotonic
+	%14 = atomicrmw or i32* %0, i32 %1 monotonic
+	%15 = atomicrmw xor i32* %0, i32 %1 monotonic
+	%16 = atomicrmw max i32* %0, i32 %1 monotonic
+	%17 = atomicrmw min i32* %0, i32 %1 monotonic
+	%18 = atomicrmw umax i32* %0, i32 %1 monotonic
+	%19 = atomicrmw umin i32* %0, i32 %1 monotonic
+	ret i32 %19
+}
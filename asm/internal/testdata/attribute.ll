@@ -0,0 +1,17 @@
+define signext i32 @f(i8* nocapture readonly align 8 %p, i32 zeroext %n) #0 cold {
+; <label>:0
+	ret i32 0
+}
+declare noalias nonnull i8* @h(i8* dereferenceable(4) dereferenceable_or_null(8) "x"="y") #1 alignstack(8) "k"
+declare noalias i8* @malloc(i64) #2
+declare noalias i8* @calloc(i64, i64) allocsize(0,1)
+define i32 @main() {
+; <label>:0
+	%1 = alloca i8
+	%2 = call signext i32 @f(i8* nonnull %1, i32 zeroext 1) #1 nounwind
+	%3 = call i8* @h(i8* %1)
+	ret i32 %2
+}
+attributes #0 = { noinline nounwind uwtable alignstack=16 "no-frame-pointer-elim"="true" "less-precise-fpmad" }
+attributes #1 = { readnone norecurse }
+attributes #2 = { nounwind allocsize(0) "no-frame-pointer-elim"="true" }
@@ -0,0 +1,13 @@
+@targets = global [2 x i8*] [i8* blockaddress(@f, %a), i8* blockaddress(@f, %b)]
+define i8* @g() {
+; <label>:0
+	ret i8* blockaddress(@f, %b)
+}
+define void @f(i8* %addr) {
+; <label>:0
+	indirectbr i8* %addr, [label %a, label %b]
+a:
+	ret void
+b:
+	ret void
+}
@@ -0,0 +1,14 @@
+$foo = comdat any
+$bar = comdat exactmatch
+$baz = comdat largest
+$qux = comdat noduplicates
+$f = comdat samesize
+@x = global i32 0, comdat($foo), align 4
+@y = global i64 1, section ".data.y", partition "part", align 8
+@bar = constant i8 2, comdat
+@w = external global i32, section "ext"
+define void @f() section ".text.f" comdat align 16 {
+; <label>:0
+	ret void
+}
+declare void @g() partition "part" align 32
@@ -0,0 +1,10 @@
+int g = 42;
+int arr[4] = {1, 2, 3, 4};
+
+int sum(int n) {
+  int s = 0;
+  for (int i = 0; i < n; i++) {
+    s += arr[i];
+  }
+  return s + g;
+}
@@ -0,0 +1,106 @@
+; ModuleID = 'debug.c'
+source_filename = "debug.c"
+target datalayout = "e-m:e-i64:64-f80:128-n8:16:32:64-S128"
+target triple = "x86_64-unknown-linux-gnu"
+
+@g = dso_local global i32 42, align 4, !dbg !0
+@arr = dso_local global [4 x i32] [i32 1, i32 2, i32 3, i32 4], align 16, !dbg !6
+
+; Function Attrs: noinline nounwind optnone uwtable
+define dso_local i32 @sum(i32 %n) #0 !dbg !16 {
+entry:
+  %n.addr = alloca i32, align 4
+  %s = alloca i32, align 4
+  %i = alloca i32, align 4
+  store i32 %n, i32* %n.addr, align 4
+  call void @llvm.dbg.declare(metadata i32* %n.addr, metadata !19, metadata !DIExpression()), !dbg !20
+  call void @llvm.dbg.declare(metadata i32* %s, metadata !21, metadata !DIExpression()), !dbg !22
+  store i32 0, i32* %s, align 4, !dbg !22
+  call void @llvm.dbg.declare(metadata i32* %i, metadata !23, metadata !DIExpression()), !dbg !25
+  store i32 0, i32* %i, align 4, !dbg !25
+  br label %for.cond, !dbg !26
+
+for.cond:                                         ; preds = %for.inc, %entry
+  %0 = load i32, i32* %i, align 4, !dbg !27
+  %1 = load i32, i32* %n.addr, align 4, !dbg !29
+  %cmp = icmp slt i32 %0, %1, !dbg !30
+  br i1 %cmp, label %for.body, label %for.end, !dbg !31
+
+for.body:                                         ; preds = %for.cond
+  %2 = load i32, i32* %i, align 4, !dbg !32
+  %idxprom = sext i32 %2 to i64, !dbg !34
+  %arrayidx = getelementptr [4 x i32], [4 x i32]* @arr, i64 0, i64 %idxprom, !dbg !34
+  %3 = load i32, i32* %arrayidx, align 4, !dbg !34
+  %4 = load i32, i32* %s, align 4, !dbg !35
+  %add = add nsw i32 %4, %3, !dbg !35
+  store i32 %add, i32* %s, align 4, !dbg !35
+  br label %for.inc, !dbg !36
+
+for.inc:                                          ; preds = %for.body
+  %5 = load i32, i32* %i, align 4, !dbg !37
+  %inc = add nsw i32 %5, 1, !dbg !37
+  store i32 %inc, i32* %i, align 4, !dbg !37
+  br label %for.cond, !dbg !38, !llvm.loop !39
+
+for.end:                                          ; preds = %for.cond
+  %6 = load i32, i32* %s, align 4, !dbg !41
+  %7 = load i32, i32* @g, align 4, !dbg !42
+  %add1 = add nsw i32 %6, %7, !dbg !43
+  ret i32 %add1, !dbg !44
+}
+
+; Function Attrs: nounwind readnone speculatable
+declare void @llvm.dbg.declare(metadata, metadata, metadata) #1
+
+attributes #0 = { noinline nounwind optnone uwtable "correctly-rounded-divide-sqrt-fp-math"="false" "disable-tail-calls"="false" "less-precise-fpmad"="false" "min-legal-vector-width"="0" "no-frame-pointer-elim"="true" "no-frame-pointer-elim-non-leaf" "no-infs-fp-math"="false" "no-jump-tables"="false" "no-nans-fp-math"="false" "no-signed-zeros-fp-math"="false" "no-trapping-math"="false" "stack-protector-buffer-size"="8" "target-cpu"="x86-64" "target-features"="+fxsr,+mmx,+sse,+sse2,+x87" "unsafe-fp-math"="false" "use-soft-float"="false" }
+attributes #1 = { nounwind readnone speculatable }
+
+!llvm.dbg.cu = !{!2}
+!llvm.module.flags = !{!12, !13, !14}
+!llvm.ident = !{!15}
+
+!0 = !DIGlobalVariableExpression(var: !1, expr: !DIExpression())
+!1 = distinct !DIGlobalVariable(name: "g", scope: !2, file: !3, line: 1, type: !9, isLocal: false, isDefinition: true)
+!2 = distinct !DICompileUnit(language: DW_LANG_C99, file: !3, producer: "clang version 8.0.0 (tags/RELEASE_800/final)", isOptimized: false, runtimeVersion: 0, emissionKind: FullDebug, enums: !4, globals: !5, nameTableKind: None)
+!3 = !DIFile(filename: "debug.c", directory: "/home/u/src")
+!4 = !{}
+!5 = !{!0, !6}
+!6 = !DIGlobalVariableExpression(var: !7, expr: !DIExpression())
+!7 = distinct !DIGlobalVariable(name: "arr", scope: !2, file: !3, line: 2, type: !8, isLocal: false, isDefinition: true)
+!8 = !DICompositeType(tag: DW_TAG_array_type, baseType: !9, size: 128, elements: !10)
+!9 = !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)
+!10 = !{!11}
+!11 = !DISubrange(count: 4)
+!12 = !{i32 2, !"Dwarf Version", i32 4}
+!13 = !{i32 2, !"Debug Info Version", i32 3}
+!14 = !{i32 1, !"wchar_size", i32 4}
+!15 = !{!"clang version 8.0.0 (tags/RELEASE_800/final)"}
+!16 = distinct !DISubprogram(name: "sum", scope: !3, file: !3, line: 4, type: !17, scopeLine: 4, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2, retainedNodes: !4)
+!17 = !DISubroutineType(types: !18)
+!18 = !{!9, !9}
+!19 = !DILocalVariable(name: "n", arg: 1, scope: !16, file: !3, line: 4, type: !9)
+!20 = !DILocation(line: 4, column: 13, scope: !16)
+!21 = !DILocalVariable(name: "s", scope: !16, file: !3, line: 5, type: !9)
+!22 = !DILocation(line: 5, column: 7, scope: !16)
+!23 = !DILocalVariable(name: "i", scope: !24, file: !3, line: 6, type: !9)
+!24 = distinct !DILexicalBlock(scope: !16, file: !3, line: 6, column: 3)
+!25 = !DILocation(line: 6, column: 12, scope: !24)
+!26 = !DILocation(line: 6, column: 8, scope: !24)
+!27 = !DILocation(line: 6, column: 19, scope: !28)
+!28 = distinct !DILexicalBlock(scope: !24, file: !3, line: 6, column: 3)
+!29 = !DILocation(line: 6, column: 23, scope: !28)
+!30 = !DILocation(line: 6, column: 21, scope: !28)
+!31 = !DILocation(line: 6, column: 3, scope: !24)
+!32 = !DILocation(line: 7, column: 14, scope: !33)
+!33 = distinct !DILexicalBlock(scope: !28, file: !3, line: 6, column: 31)
+!34 = !DILocation(line: 7, column: 10, scope: !33)
+!35 = !DILocation(line: 7, column: 7, scope: !33)
+!36 = !DILocation(line: 8, column: 3, scope: !33)
+!37 = !DILocation(line: 6, column: 27, scope: !28)
+!38 = !DILocation(line: 6, column: 3, scope: !28)
+!39 = distinct !{!39, !31, !40}
+!40 = !DILocation(line: 8, column: 3, scope: !24)
+!41 = !DILocation(line: 9, column: 10, scope: !16)
+!42 = !DILocation(line: 9, column: 14, scope: !16)
+!43 = !DILocation(line: 9, column: 12, scope: !16)
+!44 = !DILocation(line: 9, column: 3, scope: !16)
@@ -0,0 +1,5 @@
+struct S {
+  virtual int f();
+};
+
+int S::f() { return 1; }
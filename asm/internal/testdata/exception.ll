@@ -0,0 +1,21 @@
+@_ZTIi = external constant i8*
+declare i32 @__gxx_personality_v0(...)
+declare void @g(i32)
+declare i32 @h()
+define i32 @f(i32 %x) personality i32 (...)* @__gxx_personality_v0 {
+; <label>:0
+	invoke void @g(i32 %x) to label %cont unwind label %lpad
+cont:
+	%1 = invoke i32 @h() to label %exit unwind label %lpad2
+exit:
+	ret i32 %1
+lpad:
+	%2 = landingpad { i8*, i32 }
+		cleanup
+		catch i8** @_ZTIi
+	resume { i8*, i32 } %2
+lpad2:
+	%3 = landingpad { i8*, i32 }
+		filter [1 x i8**] [i8** @_ZTIi]
+	resume { i8*, i32 } %3
+}
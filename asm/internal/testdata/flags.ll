@@ -0,0 +1,15 @@
+@x = global i32 add nuw nsw (i32 1, i32 2)
+@y = global i32 sdiv exact (i32 8, i32 2)
+declare double @sqrt(double %x)
+define double @f(i32 %a, double %b) {
+; <label>:0
+	%1 = add nsw i32 %a, %a
+	%2 = mul nuw nsw i32 %1, %a
+	%3 = udiv exact i32 %2, %a
+	%4 = ashr exact i32 %3, %a
+	%5 = fadd nnan ninf double %b, %b
+	%6 = fmul fast double %5, %b
+	%7 = fcmp nsz olt double %6, %b
+	%8 = call arcp double @sqrt(double %6)
+	ret double %8
+}
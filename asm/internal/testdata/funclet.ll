@@ -0,0 +1,34 @@
+declare i32 @__CxxFrameHandler3(...)
+declare void @g(i32)
+declare void @use(token)
+define void @f() personality i32 (...)* @__CxxFrameHandler3 {
+; <label>:0
+	invoke void @g(i32 1) to label %exit unwind label %dispatch
+dispatch:
+	%1 = catchswitch within none [label %handler, label %handler2] unwind label %cleanup
+handler:
+	%2 = catchpad within %1 [i8* null, i32 64, i8* null]
+	catchret from %2 to label %exit
+handler2:
+	%3 = catchpad within %1 []
+	catchret from %3 to label %inner
+cleanup:
+	%4 = cleanuppad within none []
+	cleanupret from %4 unwind to caller
+inner:
+	%5 = cleanuppad within %3 []
+	cleanupret from %5 unwind label %cleanup
+exit:
+	ret void
+}
+define void @forward() personality i32 (...)* @__CxxFrameHandler3 {
+entry:
+	invoke void @g(i32 2) to label %exit unwind label %dispatch
+handler:
+	%cp = catchpad within %cs [i8* null, i32 64, i8* null]
+	catchret from %cp to label %exit
+dispatch:
+	%cs = catchswitch within none [label %handler] unwind to caller
+exit:
+	ret void
+}
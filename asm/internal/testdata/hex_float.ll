@@ -0,0 +1,25 @@
+@fp80_1 = global x86_fp80 0xK3FFF8000000000000000 ; 1.0
+@fp80_2 = global x86_fp80 0xKC000C000000000000000 ; -3.0
+@fp80_3 = global x86_fp80 0xK7FFF8000000000000000 ; +inf
+@fp80_4 = global x86_fp80 0xK7FFFC000000000000001 ; NaN
+@fp80_5 = global x86_fp80 0xK00000000000000000001 ; smallest subnormal
+@fp128_1 = global fp128 0xL00000000000000003FFF000000000000 ; 1.0
+@fp128_2 = global fp128 0xL0000000000000000C000800000000000 ; -3.0
+@fp128_3 = global fp128 0xL00000000000000007FFF000000000000 ; +inf
+@fp128_4 = global fp128 0xL00000000000000017FFF800000000000 ; NaN
+@fp128_5 = global fp128 0xL00000000000000013FFF000000000000 ; 1.0 + 2^-112
+@ppc_fp128_1 = global ppc_fp128 0xM3FF00000000000000000000000000000 ; 1.0
+@ppc_fp128_2 = global ppc_fp128 0xM3FF00000000000003C90000000000000 ; 1.0 + 2^-54
+@ppc_fp128_3 = global ppc_fp128 0xM3FF00000000000008000000000000000 ; 1.0 with non-canonical low-order double
+@ppc_fp128_4 = global ppc_fp128 0xM7FF80000000000010000000000000000 ; NaN
+@bfloat_1 = global bfloat 0xR3F80 ; 1.0
+@bfloat_2 = global bfloat 0xRFF80 ; -inf
+@bfloat_3 = global bfloat 0xR7FC1 ; NaN
+@half_1 = global half 0xH7E01 ; NaN
+@float_1 = global float 0x7FF8000000000000 ; NaN
+@double_1 = global double 0xFFF0000000000001 ; NaN
+@float_2 = global float 0x7FF0000020000000 ; signalling NaN
+@float_3 = global float 0xFFF0000000000000 ; -inf
+@float_4 = global float 0.1 ; rounded to single precision
+@double_2 = global double 0x7FF0000000000001 ; signalling NaN
+@double_3 = global double -0.0
@@ -0,0 +1,34 @@
+@a = private unnamed_addr constant i32 0
+@b = internal thread_local global i32 0
+@c = weak_odr hidden thread_local(initialexec) local_unnamed_addr global i32 0
+@d = protected dllexport global i32 0
+@e = extern_weak dllimport global i32
+@f = external thread_local(localexec) global i32
+@g = common thread_local(localdynamic) global i32 0
+@h = linkonce global i32 0
+@i = dso_local global i32 0
+@j = external dso_local global i32
+@k = internal dso_preemptable global i32 0
+declare extern_weak void @f1()
+declare dllimport void @f2() unnamed_addr
+define internal void @f3() local_unnamed_addr {
+; <label>:0
+	ret void
+}
+define linkonce_odr hidden void @f4() {
+; <label>:0
+	ret void
+}
+define available_externally dllexport void @f5() {
+; <label>:0
+	ret void
+}
+define protected void @f6() {
+; <label>:0
+	ret void
+}
+declare dso_local void @f7()
+define internal dso_local hidden void @f8() {
+; <label>:0
+	ret void
+}
@@ -0,0 +1,17 @@
+@x = global i32 42, align 4, !dbg !0
+define void @f(i32 %a) !dbg !1 {
+; <label>:0
+	%1 = add i32 %a, 1, !range !2
+	call void @llvm.dbg.value(metadata i32 %1, metadata !3, metadata !{})
+	store i32 %1, i32* @x, align 4, !tbaa !4, !dbg !1
+	ret void, !dbg !1
+}
+declare !dbg !5 void @llvm.dbg.value(metadata, metadata, metadata)
+!llvm.ident = !{!0, !5}
+!empty = !{}
+!0 = !{!"foo", null, i32 1}
+!1 = distinct !{!0, !{!2, !"bar"}}
+!2 = !{i32 0, i32 10}
+!3 = !{}
+!4 = !{i32* @x}
+!5 = !{!5}
@@ -0,0 +1,6 @@
+source_filename = "foo.c"
+target datalayout = "e-m:e-i64:64-f80:128-n8:16:32:64-S128"
+target triple = "x86_64-unknown-linux-gnu"
+module asm ".globl bar"
+module asm "bar: ret"
+declare void @bar()
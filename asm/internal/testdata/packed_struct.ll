@@ -0,0 +1,9 @@
+%packed = type <{ i8, i32 }>
+%unpacked = type { i8, i32 }
+%empty = type <{}>
+%opaque = type opaque
+@x = global %packed <{ i8 1, i32 2 }>
+@y = global %unpacked { i8 1, i32 2 }
+@z = global <{ i16, i8* }> <{ i16 3, i8* null }>
+@e = global %empty <{}>
+@p = global %opaque* null
@@ -0,0 +1,12 @@
+%mmx = type x86_mmx
+%nxv4i32 = type <vscale x 4 x i32>
+declare x86_mmx @llvm.x86.mmx.padd.b(x86_mmx, x86_mmx)
+declare <vscale x 4 x i32> @llvm.experimental.stepvector.nxv4i32()
+declare token @llvm.experimental.gc.statepoint()
+declare void @llvm.dbg.declare(metadata, metadata, metadata)
+define <vscale x 4 x i32> @f(<vscale x 4 x i32>* %p, x86_mmx %x) {
+; <label>:0
+	%1 = load <vscale x 4 x i32>, <vscale x 4 x i32>* %p
+	%2 = call x86_mmx @llvm.x86.mmx.padd.b(x86_mmx %x, x86_mmx %x)
+	ret <vscale x 4 x i32> %1
+}
@@ -0,0 +1,13 @@
+@a = global i32 undef
+@b = global <4 x float> poison
+@c = global { i32, i8 } { i32 1, i8 undef }
+define i32 @f(i1 %cond) {
+entry:
+	br i1 %cond, label %a, label %b
+a:
+	%0 = add i32 poison, 1
+	br label %b
+b:
+	%1 = phi i32 [ undef, %entry ], [ %0, %a ]
+	ret i32 %1
+}
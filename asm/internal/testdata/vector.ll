@@ -0,0 +1,10 @@
+@x = global i32 extractelement (<4 x i32> <i32 1, i32 2, i32 3, i32 4>, i32 2)
+@y = global <4 x i32> insertelement (<4 x i32> <i32 1, i32 2, i32 3, i32 4>, i32 5, i64 0)
+@z = global <2 x i32> shufflevector (<4 x i32> <i32 1, i32 2, i32 3, i32 4>, <4 x i32> <i32 1, i32 2, i32 3, i32 4>, <2 x i32> <i32 0, i32 7>)
+define <2 x i32> @f(<4 x i32>, <4 x i32>, i64) {
+; <label>:3
+	%4 = extractelement <4 x i32> %0, i64 %2
+	%5 = insertelement <4 x i32> %1, i32 %4, i32 3
+	%6 = shufflevector <4 x i32> %0, <4 x i32> %5, <2 x i32> <i32 1, i32 4>
+	ret <2 x i32> %6
+}
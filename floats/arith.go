@@ -0,0 +1,231 @@
+package floats
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Status represents the set of IEEE 754 exceptions signaled by a
+// floating-point operation. The zero value signals no exceptions; i.e. the
+// result is exact.
+type Status uint8
+
+// Floating-point exceptions.
+const (
+	// Invalid operation; e.g. 0/0, inf-inf or an operation on a signaling NaN.
+	Invalid Status = 1 << iota
+	// Division of a finite non-zero value by zero.
+	DivByZero
+	// Rounded result exceeds the range of finite values.
+	Overflow
+	// Inexact result is subnormal or zero.
+	Underflow
+	// Rounded result differs from the exact result.
+	Inexact
+)
+
+// Ordering represents the result of a floating-point comparison.
+type Ordering uint8
+
+// Floating-point comparison results.
+const (
+	Unordered Ordering = iota // at least one operand is NaN
+	Less                      // x < y
+	Equal                     // x == y
+	Greater                   // x > y
+)
+
+// String returns the string representation of the comparison result.
+func (ord Ordering) String() string {
+	m := map[Ordering]string{
+		Unordered: "unordered",
+		Less:      "less",
+		Equal:     "equal",
+		Greater:   "greater",
+	}
+	if s, ok := m[ord]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown ordering %d>", uint8(ord))
+}
+
+// Add returns x + y, rounded in the direction of the given rounding mode, and
+// the exceptions signaled by the operation.
+func (x Float) Add(y Float, mode big.RoundingMode) (Float, Status) {
+	return x.arith("add", y, mode)
+}
+
+// Sub returns x - y, rounded in the direction of the given rounding mode, and
+// the exceptions signaled by the operation.
+func (x Float) Sub(y Float, mode big.RoundingMode) (Float, Status) {
+	return x.arith("sub", y, mode)
+}
+
+// Mul returns x * y, rounded in the direction of the given rounding mode, and
+// the exceptions signaled by the operation.
+func (x Float) Mul(y Float, mode big.RoundingMode) (Float, Status) {
+	return x.arith("mul", y, mode)
+}
+
+// Div returns x / y, rounded in the direction of the given rounding mode, and
+// the exceptions signaled by the operation.
+func (x Float) Div(y Float, mode big.RoundingMode) (Float, Status) {
+	return x.arith("div", y, mode)
+}
+
+// Rem returns the remainder of x / y, where the quotient is rounded toward
+// zero, and the exceptions signaled by the operation; as computed by fmod in C
+// and the frem instruction of LLVM IR. The result has the sign of x, and is
+// always exact.
+func (x Float) Rem(y Float) (Float, Status) {
+	return x.arith("rem", y, big.ToNearestEven)
+}
+
+// Compare compares x and y and returns their ordering. Positive and negative
+// zero compare equal, and NaN values are unordered.
+func (x Float) Compare(y Float) Ordering {
+	checkKind(x, y)
+	if x.IsNaN() || y.IsNaN() {
+		return Unordered
+	}
+	switch x.BigFloat().Cmp(y.BigFloat()) {
+	case -1:
+		return Less
+	case 1:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// arith returns the result of the given arithmetic operation, rounded in the
+// direction of the given rounding mode, and the exceptions signaled by the
+// operation.
+//
+// NaN operands are propagated as quiet NaN, and invalid operations yield the
+// default quiet NaN.
+func (x Float) arith(op string, y Float, mode big.RoundingMode) (Float, Status) {
+	checkKind(x, y)
+	kind := x.kind
+	var status Status
+	if x.IsSignalingNaN() || y.IsSignalingNaN() {
+		status |= Invalid
+	}
+	switch {
+	case x.IsNaN():
+		return x.quiet(), status
+	case y.IsNaN():
+		return y.quiet(), status
+	}
+	a, b := x.BigFloat(), y.BigFloat()
+	// The rounding mode determines the sign of exact zero sums.
+	z := new(big.Float).SetMode(mode)
+	switch op {
+	case "add", "sub":
+		bothInf := a.IsInf() && b.IsInf()
+		if op == "add" && bothInf && a.Signbit() != b.Signbit() || op == "sub" && bothInf && a.Signbit() == b.Signbit() {
+			return NewNaN(kind), Invalid
+		}
+		// Compute exact sum.
+		z.SetPrec(addPrec(a, b))
+		if op == "add" {
+			z.Add(a, b)
+		} else {
+			z.Sub(a, b)
+		}
+	case "mul":
+		if a.IsInf() && b.Sign() == 0 || a.Sign() == 0 && b.IsInf() {
+			return NewNaN(kind), Invalid
+		}
+		// Compute exact product.
+		z.SetPrec(a.MinPrec() + b.MinPrec() + 1)
+		z.Mul(a, b)
+	case "div":
+		if a.Sign() == 0 && b.Sign() == 0 || a.IsInf() && b.IsInf() {
+			return NewNaN(kind), Invalid
+		}
+		if b.Sign() == 0 && !a.IsInf() {
+			status |= DivByZero
+		}
+		// A precision of at least 2p+2 bits, where p is the precision of the
+		// floating-point kind, prevents double rounding errors.
+		z.SetPrec(2*kind.Prec() + 2)
+		z.Quo(a, b)
+	case "rem":
+		switch {
+		case a.IsInf() || b.Sign() == 0:
+			return NewNaN(kind), Invalid
+		case b.IsInf() || a.Sign() == 0:
+			return x, 0
+		}
+		z = fmod(a, b)
+	default:
+		panic(fmt.Errorf("support for floating-point operation %q not yet implemented", op))
+	}
+	result, s := NewFromBigFloat(kind, z, mode)
+	return result, status | s
+}
+
+// ### [ helper functions ] ####################################################
+
+// checkKind panics if x and y are not of the same floating-point kind.
+func checkKind(x, y Float) {
+	if x.kind != y.kind {
+		panic(fmt.Errorf("floating-point kind mismatch; %v and %v", x.kind, y.kind))
+	}
+}
+
+// addPrec returns the precision required to represent the sum of x and y
+// exactly.
+func addPrec(x, y *big.Float) uint {
+	if x.Sign() == 0 || x.IsInf() || y.Sign() == 0 || y.IsInf() {
+		if x.MinPrec() > y.MinPrec() {
+			return x.MinPrec() + 1
+		}
+		return y.MinPrec() + 1
+	}
+	// Exponents of the most and least significant bits.
+	xHi, yHi := x.MantExp(nil), y.MantExp(nil)
+	xLo, yLo := xHi-int(x.MinPrec()), yHi-int(y.MinPrec())
+	hi, lo := xHi, xLo
+	if yHi > hi {
+		hi = yHi
+	}
+	if yLo < lo {
+		lo = yLo
+	}
+	// Extra bit for carry.
+	return uint(hi-lo) + 1
+}
+
+// fmod returns the exact remainder of x / y, where the quotient is rounded
+// toward zero; the result has the sign of x. Both x and y must be finite and
+// non-zero.
+func fmod(x, y *big.Float) *big.Float {
+	// x = mx * 2^ex and y = my * 2^ey, with integer mx and my.
+	mx, ex := intMantExp(x)
+	my, ey := intMantExp(y)
+	e := ex
+	if ey < e {
+		e = ey
+	}
+	mx.Lsh(mx, uint(ex-e))
+	my.Lsh(my, uint(ey-e))
+	r := new(big.Int).Rem(mx, my)
+	z := new(big.Float).SetInt(r)
+	z.SetMantExp(z, e)
+	if x.Signbit() {
+		z.Neg(z)
+	}
+	return z
+}
+
+// intMantExp returns the integer mantissa and exponent of the absolute value of
+// the finite and non-zero x; i.e. |x| = m * 2^exp.
+func intMantExp(x *big.Float) (m *big.Int, exp int) {
+	mant := new(big.Float)
+	exp = x.MantExp(mant)
+	prec := int(x.MinPrec())
+	m, _ = mant.SetMantExp(mant.Abs(mant), prec).Int(nil)
+	return m, exp - prec
+}
@@ -0,0 +1,66 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// BFloat16 represents a 16-bit brain floating-point value, in bfloat16 format;
+// i.e. the 16 most significant bits of an IEEE 754 binary32 value.
+//
+// References:
+//    https://en.wikipedia.org/wiki/Bfloat16_floating-point_format
+type BFloat16 struct {
+	// Sign, exponent and fraction.
+	//
+	//    1 bit:   sign
+	//    8 bits:  exponent
+	//    7 bits:  fraction
+	a uint16
+}
+
+// Bits returns the bfloat16 binary representation of f.
+func (f BFloat16) Bits() uint16 {
+	return f.a
+}
+
+// String returns the bfloat16 binary representation of f as a string,
+// containing 4 bytes in hexadecimal format.
+func (f BFloat16) String() string {
+	return fmt.Sprintf("%04X", f.Bits())
+}
+
+// IsNaN reports whether f is Not-a-Number.
+func (f BFloat16) IsNaN() bool {
+	return f.a&0x7F80 == 0x7F80 && f.a&0x7F != 0
+}
+
+// Float32 returns the float32 representation of f, which is always exact.
+func (f BFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(f.a) << 16)
+}
+
+// BigFloat returns the exact value of f as a big.Float. It panics if f is NaN.
+func (f BFloat16) BigFloat() *big.Float {
+	return bfloat16.decode(new(big.Int).SetUint64(uint64(f.a)))
+}
+
+// NewBFloat16FromBigFloat returns the nearest bfloat16 value for x, rounding
+// ties to even, and a bool indicating whether f represents x exactly.
+func NewBFloat16FromBigFloat(x *big.Float) (f BFloat16, exact bool) {
+	bits, status := bfloat16.encode(x, big.ToNearestEven)
+	return NewBFloat16FromBits(uint16(bits.Uint64())), status&Inexact == 0
+}
+
+// NewBFloat16FromString returns a new bfloat16 value based on s, which
+// contains 4 bytes in hexadecimal format.
+func NewBFloat16FromString(s string) BFloat16 {
+	checkHexLen(s, 4)
+	return NewBFloat16FromBits(uint16(unhexUint64(s)))
+}
+
+// NewBFloat16FromBits returns a new bfloat16 value based on bits.
+func NewBFloat16FromBits(bits uint16) BFloat16 {
+	return BFloat16{a: bits}
+}
@@ -0,0 +1,260 @@
+package floats
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// format describes the layout of a binary floating-point interchange format.
+//
+//    1 bit:         sign
+//    exp bits:      exponent
+//    (1 bit:        integer part, if explicit)
+//    frac bits:     fraction
+type format struct {
+	// Number of exponent bits.
+	exp uint
+	// Number of fraction bits, not including the integer part.
+	frac uint
+	// Integer part of the significand stored explicitly (x86 extended
+	// precision format).
+	explicit bool
+}
+
+// Binary interchange formats.
+var (
+	binary16  = format{exp: 5, frac: 10}
+	bfloat16  = format{exp: 8, frac: 7}
+	binary32  = format{exp: 8, frac: 23}
+	binary64  = format{exp: 11, frac: 52}
+	binary128 = format{exp: 15, frac: 112}
+	extended  = format{exp: 15, frac: 63, explicit: true}
+)
+
+// bias returns the exponent bias of the format.
+func (f format) bias() int {
+	return 1<<(f.exp-1) - 1
+}
+
+// sigBits returns the number of bits used to store the significand.
+func (f format) sigBits() uint {
+	if f.explicit {
+		return f.frac + 1
+	}
+	return f.frac
+}
+
+// isNaN reports whether the given binary representation is NaN. Following
+// LLVM, pseudo-NaNs, pseudo-infinities and unnormals of the x86 extended
+// precision format are treated as NaN.
+func (f format) isNaN(bits *big.Int) bool {
+	exp := f.expField(bits)
+	sig := f.sigField(bits)
+	frac := new(big.Int).And(sig, mask(f.frac))
+	if exp == 1<<f.exp-1 {
+		if f.explicit && sig.Bit(int(f.frac)) == 0 {
+			return true
+		}
+		return frac.Sign() != 0
+	}
+	return f.explicit && exp != 0 && sig.Bit(int(f.frac)) == 0
+}
+
+// expField returns the biased exponent of the given binary representation.
+func (f format) expField(bits *big.Int) int {
+	e := new(big.Int).Rsh(bits, f.sigBits())
+	return int(e.And(e, mask(f.exp)).Int64())
+}
+
+// sigField returns the stored significand of the given binary representation.
+func (f format) sigField(bits *big.Int) *big.Int {
+	return new(big.Int).And(bits, mask(f.sigBits()))
+}
+
+// signBit returns the sign bit of the given binary representation.
+func (f format) signBit(bits *big.Int) bool {
+	return bits.Bit(int(f.exp+f.sigBits())) == 1
+}
+
+// decode returns the exact value of the given binary representation. It panics
+// if bits represents NaN.
+func (f format) decode(bits *big.Int) *big.Float {
+	if f.isNaN(bits) {
+		panic(fmt.Errorf("unable to decode NaN binary representation 0x%X", bits))
+	}
+	x := new(big.Float).SetPrec(f.frac + 1)
+	neg := f.signBit(bits)
+	exp := f.expField(bits)
+	sig := f.sigField(bits)
+	switch {
+	case exp == 1<<f.exp-1:
+		x.SetInf(neg)
+		return x
+	case exp == 0:
+		// Subnormal number, or zero.
+		exp = 1
+	case !f.explicit:
+		// Add implicit integer part of normalized numbers.
+		sig.SetBit(sig, int(f.frac), 1)
+	}
+	x.SetInt(sig)
+	x.SetMantExp(x, exp-f.bias()-int(f.frac))
+	if neg {
+		x.Neg(x)
+	}
+	return x
+}
+
+// encode returns the binary representation of the value nearest to x in the
+// direction of the given rounding mode, and the exceptions signaled by the
+// rounding; Inexact if the binary representation does not represent x exactly,
+// Overflow if the rounded value exceeds the range of finite values, and
+// Underflow if the inexact result is subnormal or zero.
+func (f format) encode(x *big.Float, mode big.RoundingMode) (bits *big.Int, status Status) {
+	maxExp := 1<<f.exp - 1
+	neg := x.Signbit()
+	var (
+		exp int
+		sig = new(big.Int)
+	)
+	switch {
+	case x.IsInf():
+		exp = maxExp
+	case x.Sign() == 0:
+		// Zero.
+	default:
+		// |x| = m * 2^e with integer m.
+		mant := new(big.Float)
+		e := x.MantExp(mant)
+		prec := int(x.MinPrec())
+		m, _ := mant.SetMantExp(mant.Abs(mant), prec).Int(nil)
+		e -= prec
+		// Unbiased exponent of the most significant bit of x, clamped to the
+		// minimum exponent of normalized numbers.
+		emin := 1 - f.bias()
+		msb := e + m.BitLen() - 1
+		if msb < emin {
+			msb = emin
+		}
+		// Scale m so that the integer part has weight 2^msb.
+		shift := e - (msb - int(f.frac))
+		if shift >= 0 {
+			sig.Lsh(m, uint(shift))
+		} else if !roundShift(sig, m, uint(-shift), mode, neg) {
+			status |= Inexact
+		}
+		// Rounding may carry into the next binade.
+		if sig.BitLen() > int(f.frac)+1 {
+			sig.Rsh(sig, 1)
+			msb++
+		}
+		if sig.Bit(int(f.frac)) == 1 {
+			exp = msb + f.bias()
+		} else if status&Inexact != 0 {
+			// Inexact subnormal number, or zero.
+			status |= Underflow
+		}
+		if exp >= maxExp {
+			status |= Overflow | Inexact
+			if roundsToInf(mode, neg) {
+				exp = maxExp
+				sig.SetInt64(0)
+			} else {
+				// Largest finite value.
+				exp = maxExp - 1
+				sig = mask(f.frac + 1)
+			}
+		}
+		if !f.explicit {
+			// Drop implicit integer part.
+			sig.And(sig, mask(f.frac))
+		}
+	}
+	if exp == maxExp && f.explicit {
+		// Infinity has its integer part set in the x86 extended precision
+		// format.
+		sig.SetBit(sig, int(f.frac), 1)
+	}
+	bits = new(big.Int).Lsh(big.NewInt(int64(exp)), f.sigBits())
+	bits.Or(bits, sig)
+	if neg {
+		bits.SetBit(bits, int(f.exp+f.sigBits()), 1)
+	}
+	return bits, status
+}
+
+// ### [ helper functions ] ####################################################
+
+// mask returns a bit mask of the n least significant bits.
+func mask(n uint) *big.Int {
+	m := new(big.Int).Lsh(big.NewInt(1), n)
+	return m.Sub(m, big.NewInt(1))
+}
+
+// roundShift sets z to x >> n, rounding in the direction of the given rounding
+// mode, and reports whether no bits were lost. The non-negative x is the
+// magnitude of a value with the sign specified by neg.
+func roundShift(z, x *big.Int, n uint, mode big.RoundingMode, neg bool) (exact bool) {
+	rem := new(big.Int).And(x, mask(n))
+	z.Rsh(x, n)
+	if rem.Sign() == 0 {
+		return true
+	}
+	var inc bool
+	switch mode {
+	case big.ToNearestEven, big.ToNearestAway:
+		half := new(big.Int).Lsh(big.NewInt(1), n-1)
+		switch rem.Cmp(half) {
+		case 1:
+			inc = true
+		case 0:
+			inc = mode == big.ToNearestAway || z.Bit(0) == 1
+		}
+	case big.ToZero:
+	case big.AwayFromZero:
+		inc = true
+	case big.ToNegativeInf:
+		inc = neg
+	case big.ToPositiveInf:
+		inc = !neg
+	default:
+		panic(fmt.Errorf("support for rounding mode %v not yet implemented", mode))
+	}
+	if inc {
+		z.Add(z, big.NewInt(1))
+	}
+	return false
+}
+
+// roundsToInf reports whether values exceeding the range of finite values round
+// to infinity, rather than to the largest finite value, in the given rounding
+// mode. The sign of the value is specified by neg.
+func roundsToInf(mode big.RoundingMode, neg bool) bool {
+	switch mode {
+	case big.ToZero:
+		return false
+	case big.ToNegativeInf:
+		return neg
+	case big.ToPositiveInf:
+		return !neg
+	default:
+		return true
+	}
+}
+
+// unhexUint64 returns the numeric value represented by the hexadecimal digits
+// of s. It panics if s contains non-hexadecimal digits.
+func unhexUint64(s string) uint64 {
+	var x uint64
+	for i := 0; i < len(s); i++ {
+		x = x<<4 | unhex(s[i])
+	}
+	return x
+}
+
+// checkHexLen panics if the hexadecimal representation s is not of length n.
+func checkHexLen(s string, n int) {
+	if len(s) != n {
+		panic(fmt.Errorf("invalid length of hexadecimal representation, expected %d, got %d", n, len(s)))
+	}
+}
@@ -0,0 +1,96 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// DoubleDouble represents a 128-bit double-double floating-point value, in
+// PowerPC format; i.e. the unevaluated sum of two IEEE 754 binary64 values,
+// where the high-order double is the value rounded to double precision.
+//
+// References:
+//    https://en.wikipedia.org/wiki/Quadruple-precision_floating-point_format#Double-double_arithmetic
+type DoubleDouble struct {
+	// High-order double.
+	hi uint64
+	// Low-order double.
+	lo uint64
+}
+
+// Bits returns the binary representation of f, as the IEEE 754 binary
+// representation of the high-order and low-order doubles.
+func (f DoubleDouble) Bits() (hi, lo uint64) {
+	return f.hi, f.lo
+}
+
+// Bytes returns the binary representation of f as a byte slice, containing 32
+// bytes in hexadecimal format.
+func (f DoubleDouble) Bytes() []byte {
+	return []byte(f.String())
+}
+
+// String returns the binary representation of f as a string, containing 32
+// bytes in hexadecimal format; the high-order double followed by the
+// low-order double.
+func (f DoubleDouble) String() string {
+	return fmt.Sprintf("%016X%016X", f.hi, f.lo)
+}
+
+// IsNaN reports whether f is Not-a-Number; i.e. whether the high-order double
+// is NaN.
+func (f DoubleDouble) IsNaN() bool {
+	return math.IsNaN(math.Float64frombits(f.hi))
+}
+
+// BigFloat returns the exact value of f as a big.Float. It panics if f is NaN.
+func (f DoubleDouble) BigFloat() *big.Float {
+	if f.IsNaN() {
+		panic(fmt.Errorf("unable to decode NaN binary representation 0x%s", f))
+	}
+	hi := math.Float64frombits(f.hi)
+	lo := math.Float64frombits(f.lo)
+	if math.IsInf(hi, 0) || lo == 0 {
+		return big.NewFloat(hi)
+	}
+	// The exponents of two doubles differ by at most 2098 (from 2^1023 to
+	// 2^-1074); thus their sum is exact at this precision.
+	const prec = 2098 + 53
+	x := new(big.Float).SetPrec(prec).SetFloat64(hi)
+	if !math.IsNaN(lo) && !math.IsInf(lo, 0) {
+		x.Add(x, big.NewFloat(lo))
+	}
+	if x.Sign() == 0 {
+		// Use the sign of the zero sum in IEEE 754 arithmetic.
+		return big.NewFloat(hi + lo)
+	}
+	return x.SetPrec(x.MinPrec())
+}
+
+// NewDoubleDoubleFromBigFloat returns the nearest double-double value for x in
+// canonical form, and a bool indicating whether f represents x exactly.
+func NewDoubleDoubleFromBigFloat(x *big.Float) (f DoubleDouble, exact bool) {
+	hi, acc := x.Float64()
+	if math.IsInf(hi, 0) || acc == big.Exact {
+		return NewDoubleDoubleFromBits(math.Float64bits(hi), 0), acc == big.Exact
+	}
+	rem := new(big.Float).SetPrec(x.Prec() + 64)
+	rem.Sub(x, big.NewFloat(hi))
+	lo, acc := rem.Float64()
+	return NewDoubleDoubleFromBits(math.Float64bits(hi), math.Float64bits(lo)), acc == big.Exact
+}
+
+// NewDoubleDoubleFromString returns a new double-double value based on s,
+// which contains 32 bytes in hexadecimal format; the high-order double followed
+// by the low-order double.
+func NewDoubleDoubleFromString(s string) DoubleDouble {
+	checkHexLen(s, 32)
+	return NewDoubleDoubleFromBits(unhexUint64(s[:16]), unhexUint64(s[16:]))
+}
+
+// NewDoubleDoubleFromBits returns a new double-double value based on the IEEE
+// 754 binary representation of the high-order and low-order doubles.
+func NewDoubleDoubleFromBits(hi, lo uint64) DoubleDouble {
+	return DoubleDouble{hi: hi, lo: lo}
+}
@@ -0,0 +1,376 @@
+package floats
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Kind represents the set of floating-point kinds of LLVM IR.
+type Kind uint8
+
+// Floating-point kinds.
+const (
+	KindFloat16      Kind = iota + 1 // half: IEEE 754 binary16
+	KindBFloat16                     // bfloat: bfloat16
+	KindFloat32                      // float: IEEE 754 binary32
+	KindFloat64                      // double: IEEE 754 binary64
+	KindFloat128                     // fp128: IEEE 754 binary128
+	KindFloat80                      // x86_fp80: x86 extended precision
+	KindDoubleDouble                 // ppc_fp128: PowerPC double-double
+)
+
+// String returns the LLVM syntax representation of the floating-point kind.
+func (kind Kind) String() string {
+	m := map[Kind]string{
+		KindFloat16:      "half",
+		KindBFloat16:     "bfloat",
+		KindFloat32:      "float",
+		KindFloat64:      "double",
+		KindFloat128:     "fp128",
+		KindFloat80:      "x86_fp80",
+		KindDoubleDouble: "ppc_fp128",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown floating-point kind %d>", uint8(kind))
+}
+
+// Size returns the size in bits of the floating-point kind.
+func (kind Kind) Size() int {
+	switch kind {
+	case KindDoubleDouble:
+		return 128
+	default:
+		f := kind.format()
+		return int(1 + f.exp + f.sigBits())
+	}
+}
+
+// Prec returns the precision in bits of the floating-point kind, including the
+// integer part. The precision of double-double is that of two doubles.
+func (kind Kind) Prec() uint {
+	switch kind {
+	case KindDoubleDouble:
+		return 2 * (binary64.frac + 1)
+	default:
+		return kind.format().frac + 1
+	}
+}
+
+// format returns the binary format of the floating-point kind. The binary
+// format of double-double is that of its high-order double.
+func (kind Kind) format() format {
+	switch kind {
+	case KindFloat16:
+		return binary16
+	case KindBFloat16:
+		return bfloat16
+	case KindFloat32:
+		return binary32
+	case KindFloat64, KindDoubleDouble:
+		return binary64
+	case KindFloat128:
+		return binary128
+	case KindFloat80:
+		return extended
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+}
+
+// Float represents a floating-point value of any of the floating-point kinds of
+// LLVM IR. The zero value is not a valid floating-point value; use NewFromBits,
+// NewFromBigFloat or NewFromInt to create floating-point values.
+//
+// Arithmetic of double-double values is computed exactly, and the result is
+// rounded to the nearest double-double value in canonical form; i.e. the
+// high-order double is the result rounded to nearest, ties to even, and the
+// low-order double is the remainder rounded in the direction of the rounding
+// mode.
+type Float struct {
+	// Floating-point kind.
+	kind Kind
+	// Binary representation (see Float.Bits).
+	bits *big.Int
+}
+
+// NewFromBits returns a new floating-point value of the given kind based on the
+// binary representation. The binary representation is that of a bitcast to an
+// integer type of the same bit width; for double-double the high-order double
+// is stored in the 64 least significant bits, as is done by LLVM.
+func NewFromBits(kind Kind, bits *big.Int) Float {
+	if bits.Sign() < 0 || bits.BitLen() > kind.Size() {
+		panic(fmt.Errorf("invalid binary representation 0x%X of %v floating-point value", bits, kind))
+	}
+	return Float{kind: kind, bits: new(big.Int).Set(bits)}
+}
+
+// NewFromBigFloat returns the floating-point value of the given kind nearest to
+// x in the direction of the given rounding mode, and the exceptions signaled by
+// the rounding.
+func NewFromBigFloat(kind Kind, x *big.Float, mode big.RoundingMode) (Float, Status) {
+	if kind == KindDoubleDouble {
+		return newDoubleDouble(x, mode)
+	}
+	bits, status := kind.format().encode(x, mode)
+	return Float{kind: kind, bits: bits}, status
+}
+
+// NewFromInt returns the floating-point value of the given kind nearest to x in
+// the direction of the given rounding mode, and the exceptions signaled by the
+// rounding.
+func NewFromInt(kind Kind, x *big.Int, mode big.RoundingMode) (Float, Status) {
+	return NewFromBigFloat(kind, new(big.Float).SetInt(x), mode)
+}
+
+// ParseFloat returns the floating-point value of the given kind nearest to the
+// number represented by s in the direction of the given rounding mode, and the
+// exceptions signaled by the rounding. The number is a decimal or hexadecimal
+// floating-point number, as accepted by big.Rat.SetString (e.g. "-0.1",
+// "1.5e10" or "0x1.8p3"), or "inf", optionally preceded by a sign.
+func ParseFloat(kind Kind, s string, mode big.RoundingMode) (Float, Status, error) {
+	str := s
+	neg := strings.HasPrefix(str, "-")
+	if neg || strings.HasPrefix(str, "+") {
+		str = str[1:]
+	}
+	if str == "inf" {
+		return NewInf(kind, sign(neg)), 0, nil
+	}
+	r, ok := new(big.Rat).SetString(str)
+	if !ok || strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		return Float{}, 0, fmt.Errorf("invalid floating-point number %q", s)
+	}
+	// Append a sticky bit to inexact binary approximations of r, which
+	// preserves the rounding direction of r as long as the approximation has at
+	// least two more bits than the precision of the floating-point kind.
+	prec := kind.Prec() + 2
+	if kind == KindDoubleDouble {
+		// The low-order double may represent bits far below the precision of
+		// double-double; from 2^1023 to 2^-1074.
+		prec = 2098 + 53 + 2
+	}
+	num, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	shift := int(prec) - (num.BitLen() - den.BitLen())
+	if shift > 0 {
+		num.Lsh(num, uint(shift))
+	} else {
+		den.Lsh(den, uint(-shift))
+	}
+	q, rem := num.QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		q.Lsh(q, 1)
+		q.SetBit(q, 0, 1)
+		shift++
+	}
+	x := new(big.Float).SetInt(q)
+	x.SetMantExp(x, -shift)
+	if neg {
+		x.Neg(x)
+	}
+	f, status := NewFromBigFloat(kind, x, mode)
+	return f, status, nil
+}
+
+// NewNaN returns the default quiet NaN of the given kind; i.e. positive, with
+// only the quiet bit of the payload set.
+func NewNaN(kind Kind) Float {
+	f := kind.format()
+	bits := mask(f.exp)
+	bits.Lsh(bits, f.sigBits())
+	if f.explicit {
+		bits.SetBit(bits, int(f.frac), 1)
+	}
+	bits.SetBit(bits, int(f.frac-1), 1)
+	return Float{kind: kind, bits: bits}
+}
+
+// NewInf returns positive infinity of the given kind if sign >= 0, and negative
+// infinity if sign < 0.
+func NewInf(kind Kind, sign int) Float {
+	x, _ := NewFromBigFloat(kind, new(big.Float).SetInf(sign < 0), big.ToNearestEven)
+	return x
+}
+
+// Kind returns the floating-point kind of x.
+func (x Float) Kind() Kind {
+	return x.kind
+}
+
+// Bits returns the binary representation of x (see NewFromBits).
+func (x Float) Bits() *big.Int {
+	return new(big.Int).Set(x.bits)
+}
+
+// String returns the binary representation of x as a string, in hexadecimal
+// format.
+func (x Float) String() string {
+	return fmt.Sprintf("%0*X", x.kind.Size()/4, x.bits)
+}
+
+// IsNaN reports whether x is Not-a-Number. Following LLVM, pseudo-NaNs,
+// pseudo-infinities and unnormals of the x86 extended precision format are
+// treated as NaN, and double-double values are NaN if the high-order double is
+// NaN.
+func (x Float) IsNaN() bool {
+	return x.kind.format().isNaN(x.high())
+}
+
+// IsSignalingNaN reports whether x is a signaling NaN; i.e. a NaN with the
+// quiet bit clear.
+func (x Float) IsSignalingNaN() bool {
+	return x.IsNaN() && x.high().Bit(int(x.kind.format().frac-1)) == 0
+}
+
+// IsInf reports whether x is positive or negative infinity.
+func (x Float) IsInf() bool {
+	return !x.IsNaN() && x.BigFloat().IsInf()
+}
+
+// IsZero reports whether x is positive or negative zero.
+func (x Float) IsZero() bool {
+	return !x.IsNaN() && x.BigFloat().Sign() == 0
+}
+
+// Signbit reports whether the sign bit of x is set.
+func (x Float) Signbit() bool {
+	return x.kind.format().signBit(x.high())
+}
+
+// BigFloat returns the exact value of x as a big.Float. It panics if x is NaN.
+func (x Float) BigFloat() *big.Float {
+	if x.kind == KindDoubleDouble {
+		hi, lo := x.doubles()
+		return NewDoubleDoubleFromBits(hi, lo).BigFloat()
+	}
+	return x.kind.format().decode(x.bits)
+}
+
+// Neg returns x with its sign bit flipped.
+func (x Float) Neg() Float {
+	bits := new(big.Int).Set(x.bits)
+	f := x.kind.format()
+	signBit := int(f.exp + f.sigBits())
+	bits.SetBit(bits, signBit, bits.Bit(signBit)^1)
+	if x.kind == KindDoubleDouble {
+		// Flip the sign of the low-order double as well.
+		bits.SetBit(bits, 64+signBit, bits.Bit(64+signBit)^1)
+	}
+	return Float{kind: x.kind, bits: bits}
+}
+
+// Int returns the integer value of x, rounded in the direction of the given
+// rounding mode, and the exceptions signaled by the conversion; Invalid if x is
+// NaN or infinity, in which case the integer value is nil, and Inexact if x is
+// not an integer.
+func (x Float) Int(mode big.RoundingMode) (*big.Int, Status) {
+	if x.IsNaN() || x.IsInf() {
+		return nil, Invalid
+	}
+	v := x.BigFloat()
+	if v.Sign() == 0 {
+		return new(big.Int), 0
+	}
+	m, exp := intMantExp(v)
+	z := new(big.Int)
+	var status Status
+	if exp >= 0 {
+		z.Lsh(m, uint(exp))
+	} else if !roundShift(z, m, uint(-exp), mode, v.Signbit()) {
+		status |= Inexact
+	}
+	if v.Signbit() {
+		z.Neg(z)
+	}
+	return z, status
+}
+
+// Convert returns the floating-point value of the given kind nearest to x in
+// the direction of the given rounding mode, and the exceptions signaled by the
+// conversion. NaN values are converted to quiet NaN, preserving the sign and
+// the most significant bits of the payload; signaling NaN values signal
+// Invalid.
+func (x Float) Convert(kind Kind, mode big.RoundingMode) (Float, Status) {
+	if !x.IsNaN() {
+		return NewFromBigFloat(kind, x.BigFloat(), mode)
+	}
+	var status Status
+	if x.IsSignalingNaN() {
+		status |= Invalid
+	}
+	src, dst := x.kind.format(), kind.format()
+	// Align the most significant bits of the payload.
+	payload := new(big.Int).And(x.high(), mask(src.frac))
+	if src.frac > dst.frac {
+		payload.Rsh(payload, src.frac-dst.frac)
+	} else {
+		payload.Lsh(payload, dst.frac-src.frac)
+	}
+	bits := NewNaN(kind).bits
+	bits.Or(bits, payload)
+	if x.Signbit() {
+		bits.SetBit(bits, int(dst.exp+dst.sigBits()), 1)
+	}
+	return Float{kind: kind, bits: bits}, status
+}
+
+// high returns the binary representation of x, or of the high-order double if x
+// is a double-double value.
+func (x Float) high() *big.Int {
+	if x.kind == KindDoubleDouble {
+		return new(big.Int).And(x.bits, mask(64))
+	}
+	return x.bits
+}
+
+// doubles returns the binary representation of the high-order and low-order
+// doubles of the double-double value x.
+func (x Float) doubles() (hi, lo uint64) {
+	hi = new(big.Int).And(x.bits, mask(64)).Uint64()
+	lo = new(big.Int).Rsh(x.bits, 64).Uint64()
+	return hi, lo
+}
+
+// newDoubleDouble returns the double-double value in canonical form nearest to
+// x in the direction of the given rounding mode, and the exceptions signaled by
+// the rounding. The high-order double is x rounded to nearest, ties to even,
+// and the low-order double is the remainder rounded in the direction of the
+// rounding mode.
+func newDoubleDouble(x *big.Float, mode big.RoundingMode) (Float, Status) {
+	hiBits, status := binary64.encode(x, big.ToNearestEven)
+	hi := binary64.decode(hiBits)
+	if status&Overflow != 0 {
+		// Round to infinity or the largest finite double, in the direction of
+		// the rounding mode.
+		hiBits, status = binary64.encode(x, mode)
+		return Float{kind: KindDoubleDouble, bits: hiBits}, status
+	}
+	if status&Inexact == 0 {
+		return Float{kind: KindDoubleDouble, bits: hiBits}, status
+	}
+	rem := new(big.Float).SetPrec(addPrec(x, hi))
+	rem.Sub(x, hi)
+	loBits, status := binary64.encode(rem, mode)
+	bits := new(big.Int).Lsh(loBits, 64)
+	bits.Or(bits, hiBits)
+	// Underflow of the low-order double is not signaled, as the precision of
+	// double-double is not fixed.
+	return Float{kind: KindDoubleDouble, bits: bits}, status &^ Underflow
+}
+
+// quiet returns the quiet NaN of the NaN value x; i.e. with the quiet bit set.
+func (x Float) quiet() Float {
+	bits := new(big.Int).Set(x.bits)
+	bits.SetBit(bits, int(x.kind.format().frac-1), 1)
+	return Float{kind: x.kind, bits: bits}
+}
+
+// sign returns -1 if neg is true, and 1 otherwise.
+func sign(neg bool) int {
+	if neg {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,78 @@
+package floats
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Float128 represents a 128-bit IEEE 754 quadruple-precision floating-point
+// value, in binary128 format.
+//
+// References:
+//    https://en.wikipedia.org/wiki/Quadruple-precision_floating-point_format#IEEE_754_quadruple-precision_binary_floating-point_format:_binary128
+type Float128 struct {
+	// Sign, exponent and fraction.
+	//
+	//    1 bit:    sign
+	//    15 bits:  exponent
+	//    112 bits: fraction
+	a, b uint64
+}
+
+// Bits returns the IEEE 754 binary representation of f, as the most and least
+// significant 64 bits.
+func (f Float128) Bits() (a, b uint64) {
+	return f.a, f.b
+}
+
+// Bytes returns the IEEE 754 binary representation of f as a byte slice,
+// containing 32 bytes in hexadecimal format.
+func (f Float128) Bytes() []byte {
+	return []byte(f.String())
+}
+
+// String returns the IEEE 754 binary representation of f as a string,
+// containing 32 bytes in hexadecimal format.
+func (f Float128) String() string {
+	return fmt.Sprintf("%016X%016X", f.a, f.b)
+}
+
+// IsNaN reports whether f is Not-a-Number.
+func (f Float128) IsNaN() bool {
+	return binary128.isNaN(f.bigInt())
+}
+
+// BigFloat returns the exact value of f as a big.Float. It panics if f is NaN.
+func (f Float128) BigFloat() *big.Float {
+	return binary128.decode(f.bigInt())
+}
+
+// bigInt returns the IEEE 754 binary representation of f as an integer.
+func (f Float128) bigInt() *big.Int {
+	bits := new(big.Int).SetUint64(f.a)
+	bits.Lsh(bits, 64)
+	return bits.Or(bits, new(big.Int).SetUint64(f.b))
+}
+
+// NewFloat128FromBigFloat returns the nearest 128-bit floating-point value for
+// x, rounding ties to even, and a bool indicating whether f represents x
+// exactly.
+func NewFloat128FromBigFloat(x *big.Float) (f Float128, exact bool) {
+	bits, status := binary128.encode(x, big.ToNearestEven)
+	b := new(big.Int).And(bits, mask(64)).Uint64()
+	a := new(big.Int).Rsh(bits, 64).Uint64()
+	return NewFloat128FromBits(a, b), status&Inexact == 0
+}
+
+// NewFloat128FromString returns a new 128-bit floating-point value based on s,
+// which contains 32 bytes in hexadecimal format.
+func NewFloat128FromString(s string) Float128 {
+	checkHexLen(s, 32)
+	return NewFloat128FromBits(unhexUint64(s[:16]), unhexUint64(s[16:]))
+}
+
+// NewFloat128FromBits returns a new 128-bit floating-point value based on the
+// most and least significant 64 bits of the binary representation.
+func NewFloat128FromBits(a, b uint64) Float128 {
+	return Float128{a: a, b: b}
+}
@@ -1,13 +1,19 @@
 // The implementation of Float16 is heavily inspired by
 // https://github.com/h2so5/half which is released into the public domain.
 
-// Package floats implements encoding and decoding of IEEE 754 floating-point
-// values.
+// Package floats implements encoding, decoding and arithmetic of the
+// floating-point formats of LLVM IR; i.e. IEEE 754 binary16, binary32, binary64
+// and binary128, bfloat16, x86 extended precision and PowerPC double-double.
+//
+// Arithmetic is implemented in software, with correctly rounded results in all
+// rounding modes of big.RoundingMode; see Float for the rounding of
+// double-double values.
 package floats
 
 import (
 	"fmt"
 	"math"
+	"math/big"
 )
 
 // Float16 represents a 16-bit IEEE 754 half-precision floating-point value, in
@@ -104,6 +110,16 @@ func (f Float16) Float32() float32 {
 	return math.Float32frombits(bits)
 }
 
+// IsNaN reports whether f is Not-a-Number.
+func (f Float16) IsNaN() bool {
+	return f.a&0x7C00 == 0x7C00 && f.a&0x3FF != 0
+}
+
+// BigFloat returns the exact value of f as a big.Float. It panics if f is NaN.
+func (f Float16) BigFloat() *big.Float {
+	return binary16.decode(new(big.Int).SetUint64(uint64(f.a)))
+}
+
 // Float64 returns the float64 representation of f.
 func (f Float16) Float64() float64 {
 	a := uint64(f.a)
@@ -264,6 +280,13 @@ func NewFloat16FromFloat64(x float64) (f Float16, exact bool) {
 	return NewFloat16FromBits(a), exact
 }
 
+// NewFloat16FromBigFloat returns the nearest 16-bit floating-point value for x,
+// rounding ties to even, and a bool indicating whether f represents x exactly.
+func NewFloat16FromBigFloat(x *big.Float) (f Float16, exact bool) {
+	bits, status := binary16.encode(x, big.ToNearestEven)
+	return NewFloat16FromBits(uint16(bits.Uint64())), status&Inexact == 0
+}
+
 // NewFloat16FromString returns a new 16-bit floating-point value based on s,
 // which contains 4 bytes in hexadecimal format.
 func NewFloat16FromString(s string) Float16 {
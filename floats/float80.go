@@ -0,0 +1,83 @@
+package floats
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Float80 represents an 80-bit IEEE 754 extended precision floating-point
+// value, in x86 extended precision format.
+//
+// References:
+//    https://en.wikipedia.org/wiki/Extended_precision#x86_extended_precision_format
+type Float80 struct {
+	// Sign and exponent.
+	//
+	//    1 bit:   sign
+	//    15 bits: exponent
+	se uint16
+	// Integer part and fraction.
+	//
+	//    1 bit:   integer part
+	//    63 bits: fraction
+	m uint64
+}
+
+// Bits returns the x86 extended precision binary representation of f, as the
+// sign and exponent followed by the integer part and fraction.
+func (f Float80) Bits() (se uint16, m uint64) {
+	return f.se, f.m
+}
+
+// Bytes returns the x86 extended precision binary representation of f as a byte
+// slice, containing 20 bytes in hexadecimal format.
+func (f Float80) Bytes() []byte {
+	return []byte(f.String())
+}
+
+// String returns the x86 extended precision binary representation of f as a
+// string, containing 20 bytes in hexadecimal format.
+func (f Float80) String() string {
+	return fmt.Sprintf("%04X%016X", f.se, f.m)
+}
+
+// IsNaN reports whether f is Not-a-Number. Pseudo-NaNs, pseudo-infinities and
+// unnormals are treated as NaN.
+func (f Float80) IsNaN() bool {
+	return extended.isNaN(f.bigInt())
+}
+
+// BigFloat returns the exact value of f as a big.Float. It panics if f is NaN.
+func (f Float80) BigFloat() *big.Float {
+	return extended.decode(f.bigInt())
+}
+
+// bigInt returns the x86 extended precision binary representation of f as an
+// integer.
+func (f Float80) bigInt() *big.Int {
+	bits := new(big.Int).SetUint64(uint64(f.se))
+	bits.Lsh(bits, 64)
+	return bits.Or(bits, new(big.Int).SetUint64(f.m))
+}
+
+// NewFloat80FromBigFloat returns the nearest 80-bit floating-point value for x,
+// rounding ties to even, and a bool indicating whether f represents x exactly.
+func NewFloat80FromBigFloat(x *big.Float) (f Float80, exact bool) {
+	bits, status := extended.encode(x, big.ToNearestEven)
+	m := new(big.Int).And(bits, mask(64)).Uint64()
+	se := new(big.Int).Rsh(bits, 64).Uint64()
+	return NewFloat80FromBits(uint16(se), m), status&Inexact == 0
+}
+
+// NewFloat80FromString returns a new 80-bit floating-point value based on s,
+// which contains 20 bytes in hexadecimal format.
+func NewFloat80FromString(s string) Float80 {
+	checkHexLen(s, 20)
+	return NewFloat80FromBits(uint16(unhexUint64(s[:4])), unhexUint64(s[4:]))
+}
+
+// NewFloat80FromBits returns a new 80-bit floating-point value based on the
+// sign and exponent se, and the integer part and fraction m.
+func NewFloat80FromBits(se uint16, m uint64) Float80 {
+	return Float80{se: se, m: m}
+}
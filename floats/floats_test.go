@@ -0,0 +1,495 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFloat16Float32(t *testing.T) {
+	golden := []struct {
+		in   string
+		want float32
+	}{
+		{in: "3C00", want: 1},
+		{in: "4000", want: 2},
+		{in: "C000", want: -2},
+		{in: "7BFE", want: 65472},
+		{in: "7BFF", want: 65504},
+		{in: "FBFF", want: -65504},
+		{in: "0000", want: 0},
+		{in: "8000", want: float32(math.Copysign(0, -1))},
+		{in: "7C00", want: float32(math.Inf(1))},
+		{in: "FC00", want: float32(math.Inf(-1))},
+		{in: "5B8F", want: 241.875},
+		{in: "48C8", want: 9.5625},
+	}
+	for _, g := range golden {
+		f := NewFloat16FromString(g.in)
+		got := f.Float32()
+		if got != g.want {
+			t.Errorf("float32 mismatch for binary16 0x%04X; expected %v, got %v", g.in, g.want, got)
+		}
+	}
+}
+
+func TestFloat16Float64(t *testing.T) {
+	golden := []struct {
+		in   uint16
+		want float64
+	}{
+		{in: 0x3C00, want: 1},
+		{in: 0x4000, want: 2},
+		{in: 0xC000, want: -2},
+		{in: 0x7BFE, want: 65472},
+		{in: 0x7BFF, want: 65504},
+		{in: 0xFBFF, want: -65504},
+		{in: 0x0000, want: 0},
+		{in: 0x8000, want: math.Copysign(0, -1)},
+		{in: 0x7C00, want: math.Inf(1)},
+		{in: 0xFC00, want: math.Inf(-1)},
+		{in: 0x5B8F, want: 241.875},
+		{in: 0x48C8, want: 9.5625},
+	}
+	for _, g := range golden {
+		f := NewFloat16FromBits(g.in)
+		got := f.Float64()
+		if got != g.want {
+			t.Errorf("float64 mismatch for binary16 0x%04X; expected %v, got %v", g.in, g.want, got)
+		}
+	}
+}
+
+func TestNewFloat16FromFloat32(t *testing.T) {
+	golden := []struct {
+		want uint16
+		in   float32
+	}{
+		{want: 0x3C00, in: 1},
+		{want: 0x4000, in: 2},
+		{want: 0xC000, in: -2},
+		{want: 0x7BFE, in: 65472},
+		{want: 0x7BFF, in: 65504},
+		{want: 0xFBFF, in: -65504},
+		{want: 0x0000, in: 0},
+		{want: 0x8000, in: float32(math.Copysign(0, -1))},
+		{want: 0x7C00, in: float32(math.Inf(1))},
+		{want: 0xFC00, in: float32(math.Inf(-1))},
+		{want: 0x5B8F, in: 241.875},
+		{want: 0x48C8, in: 9.5625},
+	}
+	for _, g := range golden {
+		f, exact := NewFloat16FromFloat32(g.in)
+		if !exact {
+			t.Errorf("unable to represent %v exactly using binary16 format", g.in)
+		}
+		got := f.Bits()
+		if got != g.want {
+			t.Errorf("binary16 mismatch for float32 %v; expected 0x%04X, got 0x%04X", g.in, g.want, got)
+		}
+	}
+}
+
+func TestNewFloat16FromFloat64(t *testing.T) {
+	golden := []struct {
+		want uint16
+		in   float64
+	}{
+		{want: 0x3C00, in: 1},
+		{want: 0x4000, in: 2},
+		{want: 0xC000, in: -2},
+		{want: 0x7BFE, in: 65472},
+		{want: 0x7BFF, in: 65504},
+		{want: 0xFBFF, in: -65504},
+		{want: 0x0000, in: 0},
+		{want: 0x8000, in: math.Copysign(0, -1)},
+		{want: 0x7C00, in: math.Inf(1)},
+		{want: 0xFC00, in: math.Inf(-1)},
+		{want: 0x5B8F, in: 241.875},
+		{want: 0x48C8, in: 9.5625},
+	}
+	for _, g := range golden {
+		f, exact := NewFloat16FromFloat64(g.in)
+		if !exact {
+			t.Errorf("unable to represent %v exactly using binary16 format", g.in)
+		}
+		got := f.Bits()
+		if got != g.want {
+			t.Errorf("binary16 mismatch for float64 %v; expected 0x%04X, got 0x%04X", g.in, g.want, got)
+		}
+	}
+}
+
+func TestNewFloat16FromBigFloat(t *testing.T) {
+	golden := []struct {
+		want  uint16
+		in    string
+		exact bool
+	}{
+		{want: 0x3C00, in: "1", exact: true},
+		{want: 0x0001, in: "0x1p-24", exact: true},
+		// Round ties to even.
+		{want: 0x3C00, in: "0x1.002p0", exact: false},
+		{want: 0x3C02, in: "0x1.006p0", exact: false},
+		// Round to nearest subnormal.
+		{want: 0x0000, in: "0x1p-25", exact: false},
+		{want: 0x0001, in: "0x1.8p-25", exact: false},
+		// Round up to the smallest normalized number.
+		{want: 0x0400, in: "0x1.ffep-15", exact: false},
+		// Overflow to infinity.
+		{want: 0x7C00, in: "65520", exact: false},
+		{want: 0xFBFF, in: "-65519", exact: false},
+	}
+	for _, g := range golden {
+		x, _, err := big.ParseFloat(g.in, 0, 64, big.ToNearestEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, exact := NewFloat16FromBigFloat(x)
+		if exact != g.exact {
+			t.Errorf("exactness mismatch for %v; expected %v, got %v", g.in, g.exact, exact)
+		}
+		if got := f.Bits(); got != g.want {
+			t.Errorf("binary16 mismatch for %v; expected 0x%04X, got 0x%04X", g.in, g.want, got)
+		}
+	}
+}
+
+func TestFloat80(t *testing.T) {
+	golden := []struct {
+		in   string
+		want string
+	}{
+		{in: "3FFF8000000000000000", want: "0x1p+00"},
+		{in: "BFFFC000000000000000", want: "-0x1.8p+00"},
+		{in: "40008000000000000000", want: "0x1p+01"},
+		{in: "00000000000000000000", want: "0x0p+00"},
+		{in: "80000000000000000000", want: "-0x0p+00"},
+		{in: "7FFF8000000000000000", want: "+Inf"},
+		{in: "FFFF8000000000000000", want: "-Inf"},
+		{in: "00000000000000000001", want: "0x1p-16445"},
+		{in: "7FFEFFFFFFFFFFFFFFFF", want: "0x1.fffffffffffffffep+16383"},
+	}
+	for _, g := range golden {
+		f := NewFloat80FromString(g.in)
+		x := f.BigFloat()
+		if got := x.Text('x', -1); got != g.want {
+			t.Errorf("value mismatch for x86_fp80 0x%s; expected %v, got %v", g.in, g.want, got)
+		}
+		h, exact := NewFloat80FromBigFloat(x)
+		if !exact {
+			t.Errorf("unable to represent %v exactly using x86_fp80 format", x)
+		}
+		if got := h.String(); got != g.in {
+			t.Errorf("x86_fp80 mismatch for %v; expected 0x%s, got 0x%s", x, g.in, got)
+		}
+	}
+	for _, s := range []string{"7FFFC000000000000000", "7FFF0000000000000000", "3FFF0000000000000000"} {
+		if !NewFloat80FromString(s).IsNaN() {
+			t.Errorf("expected x86_fp80 0x%s to be NaN", s)
+		}
+	}
+}
+
+func TestFloat128(t *testing.T) {
+	golden := []struct {
+		in   string
+		want string
+	}{
+		{in: "3FFF0000000000000000000000000000", want: "0x1p+00"},
+		{in: "C0008000000000000000000000000000", want: "-0x1.8p+01"},
+		{in: "00000000000000000000000000000000", want: "0x0p+00"},
+		{in: "80000000000000000000000000000000", want: "-0x0p+00"},
+		{in: "7FFF0000000000000000000000000000", want: "+Inf"},
+		{in: "00000000000000000000000000000001", want: "0x1p-16494"},
+		{in: "3FFF0000000000000000000000000001", want: "0x1.0000000000000000000000000001p+00"},
+	}
+	for _, g := range golden {
+		f := NewFloat128FromString(g.in)
+		x := f.BigFloat()
+		if got := x.Text('x', -1); got != g.want {
+			t.Errorf("value mismatch for fp128 0x%s; expected %v, got %v", g.in, g.want, got)
+		}
+		h, exact := NewFloat128FromBigFloat(x)
+		if !exact {
+			t.Errorf("unable to represent %v exactly using fp128 format", x)
+		}
+		if got := h.String(); got != g.in {
+			t.Errorf("fp128 mismatch for %v; expected 0x%s, got 0x%s", x, g.in, got)
+		}
+	}
+	if !NewFloat128FromString("7FFF8000000000000000000000000000").IsNaN() {
+		t.Errorf("expected fp128 quiet NaN")
+	}
+}
+
+func TestDoubleDouble(t *testing.T) {
+	golden := []struct {
+		in        string
+		want      string
+		canonical bool
+	}{
+		{in: "3FF00000000000000000000000000000", want: "0x1p+00", canonical: true},
+		// 1 + 2^-54
+		{in: "3FF00000000000003C90000000000000", want: "0x1.00000000000004p+00", canonical: true},
+		// 1 - 2^-54
+		{in: "3FF0000000000000BC90000000000000", want: "0x1.fffffffffffff8p-01", canonical: true},
+		{in: "80000000000000000000000000000000", want: "-0x0p+00", canonical: true},
+		// Non-canonical low-order double.
+		{in: "3FF00000000000008000000000000000", want: "0x1p+00", canonical: false},
+		{in: "3FF00000000000003FF0000000000000", want: "0x1p+01", canonical: false},
+	}
+	for _, g := range golden {
+		f := NewDoubleDoubleFromString(g.in)
+		x := f.BigFloat()
+		if got := x.Text('x', -1); got != g.want {
+			t.Errorf("value mismatch for ppc_fp128 0x%s; expected %v, got %v", g.in, g.want, got)
+		}
+		h, exact := NewDoubleDoubleFromBigFloat(x)
+		if !exact {
+			t.Errorf("unable to represent %v exactly using ppc_fp128 format", x)
+		}
+		if got := h.String(); (got == g.in) != g.canonical {
+			t.Errorf("ppc_fp128 canonical mismatch for 0x%s; expected %v, got 0x%s", g.in, g.canonical, got)
+		}
+	}
+	if !NewDoubleDoubleFromString("7FF80000000000000000000000000000").IsNaN() {
+		t.Errorf("expected ppc_fp128 quiet NaN")
+	}
+}
+
+func TestBFloat16(t *testing.T) {
+	golden := []struct {
+		in   string
+		want float32
+	}{
+		{in: "3F80", want: 1},
+		{in: "C000", want: -2},
+		{in: "7F7F", want: 3.3895313892515355e38},
+		{in: "0001", want: 9.183549615799121e-41},
+		{in: "7F80", want: float32(math.Inf(1))},
+	}
+	for _, g := range golden {
+		f := NewBFloat16FromString(g.in)
+		if got := f.Float32(); got != g.want {
+			t.Errorf("float32 mismatch for bfloat16 0x%s; expected %v, got %v", g.in, g.want, got)
+		}
+		x := f.BigFloat()
+		if got, _ := x.Float32(); got != g.want {
+			t.Errorf("value mismatch for bfloat16 0x%s; expected %v, got %v", g.in, g.want, got)
+		}
+		h, exact := NewBFloat16FromBigFloat(x)
+		if !exact || h.String() != g.in {
+			t.Errorf("bfloat16 mismatch for %v; expected 0x%s, got 0x%s", g.want, g.in, h)
+		}
+	}
+}
+
+func TestArith(t *testing.T) {
+	const (
+		max = "7FEFFFFFFFFFFFFF"
+		inf = "7FF0000000000000"
+	)
+	golden := []struct {
+		kind   Kind
+		op     string
+		x, y   string
+		mode   big.RoundingMode
+		want   string
+		status Status
+	}{
+		// 0.1 + 0.2, where the exact sum is halfway between two doubles.
+		{kind: KindFloat64, op: "add", x: "3FB999999999999A", y: "3FC999999999999A", mode: big.ToNearestEven, want: "3FD3333333333334", status: Inexact},
+		{kind: KindFloat64, op: "add", x: "3FB999999999999A", y: "3FC999999999999A", mode: big.ToZero, want: "3FD3333333333333", status: Inexact},
+		{kind: KindFloat64, op: "add", x: "3FB999999999999A", y: "3FC999999999999A", mode: big.ToNearestAway, want: "3FD3333333333334", status: Inexact},
+		// 1 - 1 is -0 when rounding toward negative infinity.
+		{kind: KindFloat64, op: "sub", x: "3FF0000000000000", y: "3FF0000000000000", mode: big.ToNearestEven, want: "0000000000000000"},
+		{kind: KindFloat64, op: "sub", x: "3FF0000000000000", y: "3FF0000000000000", mode: big.ToNegativeInf, want: "8000000000000000"},
+		// 1 / 3
+		{kind: KindFloat64, op: "div", x: "3FF0000000000000", y: "4008000000000000", mode: big.ToNearestEven, want: "3FD5555555555555", status: Inexact},
+		{kind: KindFloat64, op: "div", x: "3FF0000000000000", y: "4008000000000000", mode: big.ToPositiveInf, want: "3FD5555555555556", status: Inexact},
+		{kind: KindFloat128, op: "div", x: "3FFF0000000000000000000000000000", y: "40008000000000000000000000000000", mode: big.ToNearestEven, want: "3FFD5555555555555555555555555555", status: Inexact},
+		{kind: KindFloat80, op: "div", x: "3FFF8000000000000000", y: "4000C000000000000000", mode: big.ToNearestEven, want: "3FFDAAAAAAAAAAAAAAAB", status: Inexact},
+		{kind: KindFloat80, op: "div", x: "3FFF8000000000000000", y: "4000C000000000000000", mode: big.ToZero, want: "3FFDAAAAAAAAAAAAAAAA", status: Inexact},
+		// The high-order double is stored in the 64 least significant bits.
+		{kind: KindDoubleDouble, op: "div", x: "00000000000000003FF0000000000000", y: "00000000000000004008000000000000", mode: big.ToNearestEven, want: "3C755555555555553FD5555555555555", status: Inexact},
+		// 1 + 2^-11, halfway between two half values.
+		{kind: KindFloat16, op: "add", x: "3C00", y: "1000", mode: big.ToNearestEven, want: "3C00", status: Inexact},
+		{kind: KindFloat16, op: "add", x: "3C00", y: "1000", mode: big.ToPositiveInf, want: "3C01", status: Inexact},
+		{kind: KindBFloat16, op: "mul", x: "3FC0", y: "4000", mode: big.ToNearestEven, want: "4040"},
+		// Overflow.
+		{kind: KindFloat64, op: "mul", x: max, y: "4000000000000000", mode: big.ToNearestEven, want: inf, status: Overflow | Inexact},
+		{kind: KindFloat64, op: "mul", x: max, y: "4000000000000000", mode: big.ToZero, want: max, status: Overflow | Inexact},
+		{kind: KindFloat64, op: "mul", x: max, y: "C000000000000000", mode: big.ToPositiveInf, want: "FFEFFFFFFFFFFFFF", status: Overflow | Inexact},
+		// Underflow.
+		{kind: KindFloat64, op: "div", x: "0000000000000001", y: "4000000000000000", mode: big.ToNearestEven, want: "0000000000000000", status: Underflow | Inexact},
+		{kind: KindFloat64, op: "div", x: "0000000000000001", y: "4000000000000000", mode: big.AwayFromZero, want: "0000000000000001", status: Underflow | Inexact},
+		// Infinity and NaN.
+		{kind: KindFloat64, op: "div", x: "BFF0000000000000", y: "0000000000000000", mode: big.ToNearestEven, want: "FFF0000000000000", status: DivByZero},
+		{kind: KindFloat64, op: "sub", x: inf, y: inf, mode: big.ToNearestEven, want: "7FF8000000000000", status: Invalid},
+		{kind: KindFloat64, op: "mul", x: inf, y: "0000000000000000", mode: big.ToNearestEven, want: "7FF8000000000000", status: Invalid},
+		{kind: KindFloat64, op: "add", x: "7FF4000000000001", y: "3FF0000000000000", mode: big.ToNearestEven, want: "7FFC000000000001", status: Invalid},
+		{kind: KindFloat64, op: "add", x: "3FF0000000000000", y: "FFF8000000000002", mode: big.ToNearestEven, want: "FFF8000000000002"},
+		{kind: KindFloat80, op: "add", x: "7FFFC000000000000001", y: "3FFF8000000000000000", mode: big.ToNearestEven, want: "7FFFC000000000000001"},
+		// Remainder.
+		{kind: KindFloat64, op: "rem", x: "4016000000000000", y: "4000000000000000", want: "3FF8000000000000"},
+		{kind: KindFloat64, op: "rem", x: "C016000000000000", y: "4000000000000000", want: "BFF8000000000000"},
+		{kind: KindFloat64, op: "rem", x: "3FF0000000000000", y: "0000000000000000", want: "7FF8000000000000", status: Invalid},
+		{kind: KindFloat32, op: "rem", x: "3F800000", y: "7F800000", want: "3F800000"},
+	}
+	for _, g := range golden {
+		x := NewFromBits(g.kind, hexBits(g.x))
+		y := NewFromBits(g.kind, hexBits(g.y))
+		var (
+			z      Float
+			status Status
+		)
+		switch g.op {
+		case "add":
+			z, status = x.Add(y, g.mode)
+		case "sub":
+			z, status = x.Sub(y, g.mode)
+		case "mul":
+			z, status = x.Mul(y, g.mode)
+		case "div":
+			z, status = x.Div(y, g.mode)
+		case "rem":
+			z, status = x.Rem(y)
+		}
+		if got := z.String(); got != g.want || status != g.status {
+			t.Errorf("%v %s 0x%s, 0x%s (mode %v): expected 0x%s (status %05b), got 0x%s (status %05b)", g.kind, g.op, g.x, g.y, g.mode, g.want, g.status, got, status)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	golden := []struct {
+		x, y string
+		want Ordering
+	}{
+		{x: "3FF0000000000000", y: "4000000000000000", want: Less},
+		{x: "4000000000000000", y: "3FF0000000000000", want: Greater},
+		{x: "8000000000000000", y: "0000000000000000", want: Equal},
+		{x: "FFF0000000000000", y: "FFF0000000000000", want: Equal},
+		{x: "7FF8000000000000", y: "7FF8000000000000", want: Unordered},
+		{x: "3FF0000000000000", y: "7FF0000000000001", want: Unordered},
+	}
+	for _, g := range golden {
+		x := NewFromBits(KindFloat64, hexBits(g.x))
+		y := NewFromBits(KindFloat64, hexBits(g.y))
+		if got := x.Compare(y); got != g.want {
+			t.Errorf("compare 0x%s and 0x%s: expected %v, got %v", g.x, g.y, g.want, got)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	golden := []struct {
+		from   Kind
+		x      string
+		to     Kind
+		mode   big.RoundingMode
+		want   string
+		status Status
+	}{
+		// 0.1
+		{from: KindFloat64, x: "3FB999999999999A", to: KindFloat32, mode: big.ToNearestEven, want: "3DCCCCCD", status: Inexact},
+		{from: KindFloat64, x: "3FB999999999999A", to: KindFloat32, mode: big.ToZero, want: "3DCCCCCC", status: Inexact},
+		{from: KindFloat32, x: "3FC00000", to: KindFloat80, mode: big.ToNearestEven, want: "3FFFC000000000000000"},
+		{from: KindFloat64, x: "3FF0000000000000", to: KindDoubleDouble, mode: big.ToNearestEven, want: "00000000000000003FF0000000000000"},
+		{from: KindFloat32, x: "7F7FFFFF", to: KindFloat16, mode: big.ToNearestEven, want: "7C00", status: Overflow | Inexact},
+		// NaN values are quieted, preserving the sign and payload.
+		{from: KindFloat64, x: "7FF4000000000001", to: KindFloat32, mode: big.ToNearestEven, want: "7FE00000", status: Invalid},
+		{from: KindFloat32, x: "FFC00001", to: KindFloat64, mode: big.ToNearestEven, want: "FFF8000020000000"},
+		{from: KindFloat32, x: "7FC00000", to: KindFloat80, mode: big.ToNearestEven, want: "7FFFC000000000000000"},
+	}
+	for _, g := range golden {
+		x := NewFromBits(g.from, hexBits(g.x))
+		z, status := x.Convert(g.to, g.mode)
+		if got := z.String(); got != g.want || status != g.status {
+			t.Errorf("convert %v 0x%s to %v (mode %v): expected 0x%s (status %05b), got 0x%s (status %05b)", g.from, g.x, g.to, g.mode, g.want, g.status, got, status)
+		}
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	golden := []struct {
+		kind   Kind
+		s      string
+		mode   big.RoundingMode
+		want   string
+		status Status
+	}{
+		{kind: KindFloat64, s: "0.1", mode: big.ToNearestEven, want: "3FB999999999999A", status: Inexact},
+		{kind: KindFloat32, s: "0.1", mode: big.ToNearestEven, want: "3DCCCCCD", status: Inexact},
+		{kind: KindFloat32, s: "0.1", mode: big.ToZero, want: "3DCCCCCC", status: Inexact},
+		{kind: KindFloat128, s: "0.1", mode: big.ToNearestEven, want: "3FFB999999999999999999999999999A", status: Inexact},
+		{kind: KindFloat80, s: "0.1", mode: big.ToNearestEven, want: "3FFBCCCCCCCCCCCCCCCD", status: Inexact},
+		{kind: KindFloat80, s: "-1.5e3", mode: big.ToNearestEven, want: "C009BB80000000000000"},
+		{kind: KindFloat16, s: "0x1.8p3", mode: big.ToNearestEven, want: "4A00"},
+		{kind: KindFloat64, s: "-0.0", mode: big.ToNearestEven, want: "8000000000000000"},
+		{kind: KindFloat64, s: "-inf", mode: big.ToNearestEven, want: "FFF0000000000000"},
+		// Underflow; the smallest subnormal double is about 4.94e-324.
+		{kind: KindFloat64, s: "1e-400", mode: big.ToNearestEven, want: "0000000000000000", status: Underflow | Inexact},
+		{kind: KindFloat64, s: "2.5e-324", mode: big.ToNearestEven, want: "0000000000000001", status: Underflow | Inexact},
+		{kind: KindFloat64, s: "2.4e-324", mode: big.ToNearestEven, want: "0000000000000000", status: Underflow | Inexact},
+	}
+	for _, g := range golden {
+		f, status, err := ParseFloat(g.kind, g.s, g.mode)
+		if err != nil {
+			t.Errorf("unable to parse %v %q; %v", g.kind, g.s, err)
+			continue
+		}
+		if got := f.String(); got != g.want || status != g.status {
+			t.Errorf("parse %v %q (mode %v): expected 0x%s (status %05b), got 0x%s (status %05b)", g.kind, g.s, g.mode, g.want, g.status, got, status)
+		}
+	}
+	for _, s := range []string{"", "abc", "--1", "+-1", "1e"} {
+		if _, _, err := ParseFloat(KindFloat64, s, big.ToNearestEven); err == nil {
+			t.Errorf("expected error when parsing %q", s)
+		}
+	}
+}
+
+func TestInt(t *testing.T) {
+	golden := []struct {
+		x      float64
+		mode   big.RoundingMode
+		want   int64
+		status Status
+	}{
+		{x: 2.5, mode: big.ToNearestEven, want: 2, status: Inexact},
+		{x: 2.5, mode: big.ToNearestAway, want: 3, status: Inexact},
+		{x: -2.5, mode: big.ToZero, want: -2, status: Inexact},
+		{x: -2.5, mode: big.ToNegativeInf, want: -3, status: Inexact},
+		{x: 0.25, mode: big.ToPositiveInf, want: 1, status: Inexact},
+		{x: -42, mode: big.ToZero, want: -42},
+	}
+	for _, g := range golden {
+		x := NewFromBits(KindFloat64, new(big.Int).SetUint64(math.Float64bits(g.x)))
+		z, status := x.Int(g.mode)
+		if z.Int64() != g.want || status != g.status {
+			t.Errorf("integer value of %v (mode %v): expected %d (status %05b), got %v (status %05b)", g.x, g.mode, g.want, g.status, z, status)
+		}
+	}
+	if z, status := NewNaN(KindFloat64).Int(big.ToZero); z != nil || status != Invalid {
+		t.Errorf("integer value of NaN: expected nil (status %05b), got %v (status %05b)", Invalid, z, status)
+	}
+	// 2^24 + 1 is not representable in single precision.
+	x := big.NewInt(1<<24 + 1)
+	if z, status := NewFromInt(KindFloat32, x, big.ToNearestEven); z.String() != "4B800000" || status != Inexact {
+		t.Errorf("float value of %v: expected 0x4B800000, got 0x%s (status %05b)", x, z, status)
+	}
+	if z, _ := NewFromInt(KindFloat32, x, big.ToPositiveInf); z.String() != "4B800001" {
+		t.Errorf("float value of %v: expected 0x4B800001, got 0x%s", x, z)
+	}
+}
+
+// hexBits returns the integer value of the given hexadecimal digits.
+func hexBits(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid hexadecimal digits " + s)
+	}
+	return x
+}
@@ -1,6 +1,9 @@
 package enc
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // Global encodes a global name to its LLVM IR assembly representation.
 //
@@ -28,6 +31,70 @@ func Local(name string) string {
 	return "%" + EscapeIdent(name)
 }
 
+// Comdat encodes a comdat name to its LLVM IR assembly representation.
+//
+// Examples:
+//    "foo" -> "$foo"
+//    "a b" -> `$"a\20b"`
+//    "世" -> `$"\E4\B8\96"`
+//
+// References:
+//    http://www.llvm.org/docs/LangRef.html#comdats
+func Comdat(name string) string {
+	return "$" + EscapeIdent(name)
+}
+
+// AttrGroupID encodes an attribute group ID to its LLVM IR assembly
+// representation.
+//
+// Examples:
+//    0 -> "#0"
+//    42 -> "#42"
+//
+// References:
+//    http://www.llvm.org/docs/LangRef.html#attribute-groups
+func AttrGroupID(id int64) string {
+	return "#" + strconv.FormatInt(id, 10)
+}
+
+// MetadataName encodes a metadata name to its LLVM IR assembly
+// representation.
+//
+// Examples:
+//    "foo" -> "!foo"
+//    "a b" -> `!a\20b`
+//    "世" -> `!\E4\B8\96`
+//
+// References:
+//    http://www.llvm.org/docs/LangRef.html#metadata
+func MetadataName(name string) string {
+	// Metadata names are never quoted; invalid characters are replaced with
+	// hexadecimal escape sequences (\XX).
+	const hextable = "0123456789ABCDEF"
+	buf := make([]byte, 0, len(name))
+	for i := 0; i < len(name); i++ {
+		b := name[i]
+		if strings.IndexByte(tail, b) != -1 {
+			buf = append(buf, b)
+			continue
+		}
+		buf = append(buf, '\\', hextable[b>>4], hextable[b&0x0F])
+	}
+	return "!" + string(buf)
+}
+
+// MetadataID encodes a metadata ID to its LLVM IR assembly representation.
+//
+// Examples:
+//    0 -> "!0"
+//    42 -> "!42"
+//
+// References:
+//    http://www.llvm.org/docs/LangRef.html#metadata
+func MetadataID(id int64) string {
+	return "!" + strconv.FormatInt(id, 10)
+}
+
 const (
 	// decimal specifies the decimal digit characters.
 	decimal = "0123456789"
@@ -76,6 +76,92 @@ func TestLocal(t *testing.T) {
 	}
 }
 
+func TestComdat(t *testing.T) {
+	golden := []struct {
+		s    string
+		want string
+	}{
+		// i=0
+		{s: "foo", want: "$foo"},
+		// i=1
+		{s: "a b", want: `$"a\20b"`},
+		// i=2
+		{s: "$a", want: "$$a"},
+		// i=3
+		{s: "foo世bar", want: `$"foo\E4\B8\96bar"`},
+	}
+
+	for i, g := range golden {
+		got := enc.Comdat(g.s)
+		if got != g.want {
+			t.Errorf("i=%d: name mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestAttrGroupID(t *testing.T) {
+	golden := []struct {
+		id   int64
+		want string
+	}{
+		// i=0
+		{id: 0, want: "#0"},
+		// i=1
+		{id: 42, want: "#42"},
+	}
+
+	for i, g := range golden {
+		got := enc.AttrGroupID(g.id)
+		if got != g.want {
+			t.Errorf("i=%d: attribute group ID mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestMetadataName(t *testing.T) {
+	golden := []struct {
+		s    string
+		want string
+	}{
+		// i=0
+		{s: "foo", want: "!foo"},
+		// i=1
+		{s: "llvm.module.flags", want: "!llvm.module.flags"},
+		// i=2
+		{s: "a b", want: `!a\20b`},
+		// i=3
+		{s: `a\b`, want: `!a\5Cb`},
+		// i=4
+		{s: "foo世bar", want: `!foo\E4\B8\96bar`},
+	}
+
+	for i, g := range golden {
+		got := enc.MetadataName(g.s)
+		if got != g.want {
+			t.Errorf("i=%d: name mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestMetadataID(t *testing.T) {
+	golden := []struct {
+		id   int64
+		want string
+	}{
+		// i=0
+		{id: 0, want: "!0"},
+		// i=1
+		{id: 42, want: "!42"},
+	}
+
+	for i, g := range golden {
+		got := enc.MetadataID(g.id)
+		if got != g.want {
+			t.Errorf("i=%d: metadata ID mismatch; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
 func TestUnescape(t *testing.T) {
 	golden := []struct {
 		s    string
@@ -1,25 +0,0 @@
-package floats
-
-// Float80 represents an 80-bit IEEE 754 extended precision floating-point
-// value, in x86 extended precision format.
-//
-// References:
-//    https://en.wikipedia.org/wiki/Extended_precision#x86_extended_precision_format
-type Float80 struct {
-	// Sign and exponent.
-	//
-	//    1 bit:   sign
-	//    15 bits: exponent
-	se uint16
-	// Integer part and fraction.
-	//
-	//    1 bit:   integer part
-	//    63 bits: fraction
-	m uint64
-}
-
-// Bytes returns the x86 extended precision binary representation of f as a byte
-// slice.
-func (f Float80) Bytes() []byte {
-	panic("not yet implemented")
-}
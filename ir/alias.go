@@ -0,0 +1,206 @@
+// === [ Aliases and IFuncs ] ==================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#aliases
+//    http://llvm.org/docs/LangRef.html#ifuncs
+
+package ir
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+)
+
+// --- [ Aliases ] -------------------------------------------------------------
+
+// An Alias represents an LLVM IR global alias; a new symbol for an existing
+// global variable, function, alias or constant expression thereof.
+//
+// Aliases may be referenced from instructions (e.g. load), and are thus
+// considered LLVM IR values of pointer type.
+type Alias struct {
+	// Alias name.
+	Name string
+	// Alias type.
+	Typ *types.PointerType
+	// Content type.
+	Content types.Type
+	// Aliasee.
+	Aliasee constant.Constant
+	// Linkage type of the alias.
+	Linkage Linkage
+	// Runtime preemption specifier of the alias.
+	Preemption Preemption
+	// Visibility style of the alias.
+	Visibility Visibility
+	// DLL storage class of the alias.
+	DLLStorageClass DLLStorageClass
+	// Thread-local storage model of the alias; or TLSNone if not thread-local.
+	ThreadLocal ThreadLocalMode
+	// Unnamed address specifier of the alias.
+	UnnamedAddr UnnamedAddr
+}
+
+// NewAlias returns a new global alias based on the given alias name and
+// aliasee.
+func NewAlias(name string, aliasee constant.Constant) *Alias {
+	typ, ok := aliasee.Type().(*types.PointerType)
+	if !ok {
+		panic(fmt.Errorf("invalid aliasee type; expected *types.PointerType, got %T", aliasee.Type()))
+	}
+	return &Alias{
+		Name:    name,
+		Typ:     typ,
+		Content: typ.Elem,
+		Aliasee: aliasee,
+	}
+}
+
+// Type returns the type of the alias.
+func (alias *Alias) Type() types.Type {
+	return alias.Typ
+}
+
+// Ident returns the identifier associated with the alias.
+func (alias *Alias) Ident() string {
+	return enc.Global(alias.Name)
+}
+
+// GetName returns the name of the alias.
+func (alias *Alias) GetName() string {
+	return alias.Name
+}
+
+// SetName sets the name of the alias.
+func (alias *Alias) SetName(name string) {
+	alias.Name = name
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*Alias) Immutable() {}
+
+// String returns the LLVM syntax representation of the alias.
+func (alias *Alias) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s =", alias.Ident())
+	if alias.Linkage != LinkageExternal {
+		fmt.Fprintf(buf, " %s", alias.Linkage)
+	}
+	if alias.Preemption != PreemptionDSOPreemptable {
+		fmt.Fprintf(buf, " %s", alias.Preemption)
+	}
+	if alias.Visibility != VisibilityDefault {
+		fmt.Fprintf(buf, " %s", alias.Visibility)
+	}
+	if alias.DLLStorageClass != DLLStorageNone {
+		fmt.Fprintf(buf, " %s", alias.DLLStorageClass)
+	}
+	if alias.ThreadLocal != TLSNone {
+		fmt.Fprintf(buf, " %s", alias.ThreadLocal)
+	}
+	if alias.UnnamedAddr != UnnamedAddrNone {
+		fmt.Fprintf(buf, " %s", alias.UnnamedAddr)
+	}
+	fmt.Fprintf(buf, " alias %s, %s %s",
+		alias.Content,
+		alias.Aliasee.Type(),
+		alias.Aliasee.Ident())
+	return buf.String()
+}
+
+// --- [ IFuncs ] --------------------------------------------------------------
+
+// An IFunc represents an LLVM IR indirect function; a function whose address
+// is determined at load time by calling a resolver function.
+//
+// IFuncs may be referenced from instructions (e.g. call), and are thus
+// considered LLVM IR values of pointer type.
+type IFunc struct {
+	// IFunc name.
+	Name string
+	// IFunc type.
+	Typ *types.PointerType
+	// Content type.
+	Content types.Type
+	// Resolver function.
+	Resolver constant.Constant
+	// Linkage type of the IFunc.
+	Linkage Linkage
+	// Runtime preemption specifier of the IFunc.
+	Preemption Preemption
+	// Visibility style of the IFunc.
+	Visibility Visibility
+}
+
+// NewIFunc returns a new indirect function based on the given IFunc name and
+// resolver function. The content type of the IFunc is the element type of the
+// pointer type returned by the resolver.
+func NewIFunc(name string, resolver constant.Constant) *IFunc {
+	typ, ok := resolver.Type().(*types.PointerType)
+	if !ok {
+		panic(fmt.Errorf("invalid resolver type; expected *types.PointerType, got %T", resolver.Type()))
+	}
+	sig, ok := typ.Elem.(*types.FuncType)
+	if !ok {
+		panic(fmt.Errorf("invalid resolver signature type; expected *types.FuncType, got %T", typ.Elem))
+	}
+	ret, ok := sig.Ret.(*types.PointerType)
+	if !ok {
+		panic(fmt.Errorf("invalid resolver return type; expected *types.PointerType, got %T", sig.Ret))
+	}
+	return &IFunc{
+		Name:     name,
+		Typ:      ret,
+		Content:  ret.Elem,
+		Resolver: resolver,
+	}
+}
+
+// Type returns the type of the IFunc.
+func (ifunc *IFunc) Type() types.Type {
+	return ifunc.Typ
+}
+
+// Ident returns the identifier associated with the IFunc.
+func (ifunc *IFunc) Ident() string {
+	return enc.Global(ifunc.Name)
+}
+
+// GetName returns the name of the IFunc.
+func (ifunc *IFunc) GetName() string {
+	return ifunc.Name
+}
+
+// SetName sets the name of the IFunc.
+func (ifunc *IFunc) SetName(name string) {
+	ifunc.Name = name
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*IFunc) Immutable() {}
+
+// String returns the LLVM syntax representation of the IFunc.
+func (ifunc *IFunc) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s =", ifunc.Ident())
+	if ifunc.Linkage != LinkageExternal {
+		fmt.Fprintf(buf, " %s", ifunc.Linkage)
+	}
+	if ifunc.Preemption != PreemptionDSOPreemptable {
+		fmt.Fprintf(buf, " %s", ifunc.Preemption)
+	}
+	if ifunc.Visibility != VisibilityDefault {
+		fmt.Fprintf(buf, " %s", ifunc.Visibility)
+	}
+	fmt.Fprintf(buf, " ifunc %s, %s %s",
+		ifunc.Content,
+		ifunc.Resolver.Type(),
+		ifunc.Resolver.Ident())
+	return buf.String()
+}
@@ -0,0 +1,356 @@
+// === [ Attributes ] ==========================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#parameter-attributes
+//    http://llvm.org/docs/LangRef.html#function-attributes
+//    http://llvm.org/docs/LangRef.html#attribute-groups
+
+package ir
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// A FuncAttribute represents a function attribute.
+//
+// FuncAttribute may have one of the following underlying types.
+//
+//    ir.AttrKind
+//    ir.AttrInt
+//    ir.AttrAllocSize
+//    ir.AttrString
+//    *ir.AttrGroupDef
+type FuncAttribute interface {
+	fmt.Stringer
+	// IsFuncAttribute ensures that only function attributes can be assigned to
+	// the ir.FuncAttribute interface.
+	IsFuncAttribute()
+}
+
+// --- [ Enum attributes ] -----------------------------------------------------
+
+// AttrKind represents the kind of an enum attribute; i.e. an attribute without
+// arguments.
+type AttrKind int
+
+// Enum attributes.
+const (
+	AttrAlwaysInline                AttrKind = iota + 1 // alwaysinline
+	AttrArgMemOnly                                      // argmemonly
+	AttrBuiltin                                         // builtin
+	AttrByVal                                           // byval
+	AttrCold                                            // cold
+	AttrConvergent                                      // convergent
+	AttrInAlloca                                        // inalloca
+	AttrInReg                                           // inreg
+	AttrInaccessibleMemOnly                             // inaccessiblememonly
+	AttrInaccessibleMemOrArgMemOnly                     // inaccessiblemem_or_argmemonly
+	AttrInlineHint                                      // inlinehint
+	AttrJumpTable                                       // jumptable
+	AttrMinSize                                         // minsize
+	AttrNaked                                           // naked
+	AttrNest                                            // nest
+	AttrNoAlias                                         // noalias
+	AttrNoBuiltin                                       // nobuiltin
+	AttrNoCapture                                       // nocapture
+	AttrNoDuplicate                                     // noduplicate
+	AttrNoImplicitFloat                                 // noimplicitfloat
+	AttrNoInline                                        // noinline
+	AttrNoRecurse                                       // norecurse
+	AttrNoRedZone                                       // noredzone
+	AttrNoReturn                                        // noreturn
+	AttrNoUnwind                                        // nounwind
+	AttrNonLazyBind                                     // nonlazybind
+	AttrNonNull                                         // nonnull
+	AttrOptNone                                         // optnone
+	AttrOptSize                                         // optsize
+	AttrReadNone                                        // readnone
+	AttrReadOnly                                        // readonly
+	AttrReturned                                        // returned
+	AttrReturnsTwice                                    // returns_twice
+	AttrSExt                                            // signext
+	AttrSRet                                            // sret
+	AttrSafeStack                                       // safestack
+	AttrSanitizeAddress                                 // sanitize_address
+	AttrSanitizeMemory                                  // sanitize_memory
+	AttrSanitizeThread                                  // sanitize_thread
+	AttrSpeculatable                                    // speculatable
+	AttrSSP                                             // ssp
+	AttrSSPReq                                          // sspreq
+	AttrSSPStrong                                       // sspstrong
+	AttrSwiftError                                      // swifterror
+	AttrSwiftSelf                                       // swiftself
+	AttrUWTable                                         // uwtable
+	AttrWriteOnly                                       // writeonly
+	AttrZExt                                            // zeroext
+)
+
+// String returns the LLVM syntax representation of the enum attribute.
+func (kind AttrKind) String() string {
+	m := map[AttrKind]string{
+		AttrAlwaysInline:                "alwaysinline",
+		AttrArgMemOnly:                  "argmemonly",
+		AttrBuiltin:                     "builtin",
+		AttrByVal:                       "byval",
+		AttrCold:                        "cold",
+		AttrConvergent:                  "convergent",
+		AttrInAlloca:                    "inalloca",
+		AttrInReg:                       "inreg",
+		AttrInaccessibleMemOnly:         "inaccessiblememonly",
+		AttrInaccessibleMemOrArgMemOnly: "inaccessiblemem_or_argmemonly",
+		AttrInlineHint:                  "inlinehint",
+		AttrJumpTable:                   "jumptable",
+		AttrMinSize:                     "minsize",
+		AttrNaked:                       "naked",
+		AttrNest:                        "nest",
+		AttrNoAlias:                     "noalias",
+		AttrNoBuiltin:                   "nobuiltin",
+		AttrNoCapture:                   "nocapture",
+		AttrNoDuplicate:                 "noduplicate",
+		AttrNoImplicitFloat:             "noimplicitfloat",
+		AttrNoInline:                    "noinline",
+		AttrNoRecurse:                   "norecurse",
+		AttrNoRedZone:                   "noredzone",
+		AttrNoReturn:                    "noreturn",
+		AttrNoUnwind:                    "nounwind",
+		AttrNonLazyBind:                 "nonlazybind",
+		AttrNonNull:                     "nonnull",
+		AttrOptNone:                     "optnone",
+		AttrOptSize:                     "optsize",
+		AttrReadNone:                    "readnone",
+		AttrReadOnly:                    "readonly",
+		AttrReturned:                    "returned",
+		AttrReturnsTwice:                "returns_twice",
+		AttrSExt:                        "signext",
+		AttrSRet:                        "sret",
+		AttrSafeStack:                   "safestack",
+		AttrSanitizeAddress:             "sanitize_address",
+		AttrSanitizeMemory:              "sanitize_memory",
+		AttrSanitizeThread:              "sanitize_thread",
+		AttrSpeculatable:                "speculatable",
+		AttrSSP:                         "ssp",
+		AttrSSPReq:                      "sspreq",
+		AttrSSPStrong:                   "sspstrong",
+		AttrSwiftError:                  "swifterror",
+		AttrSwiftSelf:                   "swiftself",
+		AttrUWTable:                     "uwtable",
+		AttrWriteOnly:                   "writeonly",
+		AttrZExt:                        "zeroext",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown enum attribute %d>", int(kind))
+}
+
+// IsFuncAttribute ensures that only function attributes can be assigned to the
+// ir.FuncAttribute interface.
+func (AttrKind) IsFuncAttribute() {}
+
+// IsParamAttribute ensures that only parameter attributes can be assigned to
+// the types.ParamAttribute interface.
+func (AttrKind) IsParamAttribute() {}
+
+// --- [ Integer attributes ] --------------------------------------------------
+
+// AttrInt represents an integer attribute; i.e. an attribute with an integer
+// argument.
+type AttrInt struct {
+	// Integer attribute kind.
+	Kind AttrIntKind
+	// Integer argument.
+	N int64
+}
+
+// NewAttrInt returns a new integer attribute based on the given integer
+// attribute kind and argument.
+func NewAttrInt(kind AttrIntKind, n int64) AttrInt {
+	return AttrInt{Kind: kind, N: n}
+}
+
+// String returns the LLVM syntax representation of the integer attribute.
+func (attr AttrInt) String() string {
+	if attr.Kind == AttrAlign {
+		return fmt.Sprintf("align %d", attr.N)
+	}
+	return fmt.Sprintf("%s(%d)", attr.Kind, attr.N)
+}
+
+// IsFuncAttribute ensures that only function attributes can be assigned to the
+// ir.FuncAttribute interface.
+func (AttrInt) IsFuncAttribute() {}
+
+// IsParamAttribute ensures that only parameter attributes can be assigned to
+// the types.ParamAttribute interface.
+func (AttrInt) IsParamAttribute() {}
+
+// AttrIntKind represents the kind of an integer attribute.
+type AttrIntKind int
+
+// Integer attributes.
+const (
+	AttrAlign                 AttrIntKind = iota + 1 // align
+	AttrAlignStack                                   // alignstack
+	AttrDereferenceable                              // dereferenceable
+	AttrDereferenceableOrNull                        // dereferenceable_or_null
+)
+
+// String returns the LLVM syntax representation of the integer attribute kind.
+func (kind AttrIntKind) String() string {
+	m := map[AttrIntKind]string{
+		AttrAlign:                 "align",
+		AttrAlignStack:            "alignstack",
+		AttrDereferenceable:       "dereferenceable",
+		AttrDereferenceableOrNull: "dereferenceable_or_null",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown integer attribute %d>", int(kind))
+}
+
+// AttrAllocSize represents an allocsize function attribute; i.e. an integer
+// attribute specifying the parameters which hold the allocation size of the
+// returned pointer.
+type AttrAllocSize struct {
+	// Index of the parameter holding the element size in bytes.
+	ElemSize int64
+	// Index of the parameter holding the number of elements; or -1 if not
+	// present.
+	NumElems int64
+}
+
+// NewAttrAllocSize returns a new allocsize attribute based on the given
+// parameter indices of the element size and number of elements. The number of
+// elements is -1 if not present.
+func NewAttrAllocSize(elemSize, numElems int64) AttrAllocSize {
+	return AttrAllocSize{ElemSize: elemSize, NumElems: numElems}
+}
+
+// String returns the LLVM syntax representation of the allocsize attribute.
+func (attr AttrAllocSize) String() string {
+	if attr.NumElems == -1 {
+		return fmt.Sprintf("allocsize(%d)", attr.ElemSize)
+	}
+	return fmt.Sprintf("allocsize(%d,%d)", attr.ElemSize, attr.NumElems)
+}
+
+// IsFuncAttribute ensures that only function attributes can be assigned to the
+// ir.FuncAttribute interface.
+func (AttrAllocSize) IsFuncAttribute() {}
+
+// --- [ String attributes ] ---------------------------------------------------
+
+// AttrString represents a string attribute; i.e. a target-dependent key-value
+// attribute.
+type AttrString struct {
+	// Attribute key.
+	Key string
+	// Attribute value; or empty if not present.
+	Val string
+}
+
+// NewAttrString returns a new string attribute based on the given key and
+// optional value.
+func NewAttrString(key, val string) AttrString {
+	return AttrString{Key: key, Val: val}
+}
+
+// String returns the LLVM syntax representation of the string attribute.
+func (attr AttrString) String() string {
+	if len(attr.Val) == 0 {
+		return fmt.Sprintf(`"%s"`, enc.Escape(attr.Key))
+	}
+	return fmt.Sprintf(`"%s"="%s"`, enc.Escape(attr.Key), enc.Escape(attr.Val))
+}
+
+// IsFuncAttribute ensures that only function attributes can be assigned to the
+// ir.FuncAttribute interface.
+func (AttrString) IsFuncAttribute() {}
+
+// IsParamAttribute ensures that only parameter attributes can be assigned to
+// the types.ParamAttribute interface.
+func (AttrString) IsParamAttribute() {}
+
+// --- [ Attribute groups ] ----------------------------------------------------
+
+// An AttrGroupDef represents an attribute group definition; a named set of
+// function attributes which may be referenced from functions and call sites.
+type AttrGroupDef struct {
+	// Attribute group ID.
+	ID int64
+	// Function attributes of the attribute group.
+	FuncAttrs []FuncAttribute
+}
+
+// NewAttrGroupDef returns a new attribute group definition based on the given
+// attribute group ID and function attributes.
+func NewAttrGroupDef(id int64, attrs ...FuncAttribute) *AttrGroupDef {
+	return &AttrGroupDef{ID: id, FuncAttrs: attrs}
+}
+
+// Ident returns the identifier associated with the attribute group.
+func (group *AttrGroupDef) Ident() string {
+	return enc.AttrGroupID(group.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the
+// attribute group.
+func (group *AttrGroupDef) String() string {
+	return group.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the
+// attribute group.
+func (group *AttrGroupDef) Def() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("{")
+	for _, attr := range group.FuncAttrs {
+		// Integer attributes are written as key-value pairs within attribute
+		// groups; e.g. alignstack=8.
+		if attr, ok := attr.(AttrInt); ok && (attr.Kind == AttrAlign || attr.Kind == AttrAlignStack) {
+			fmt.Fprintf(buf, " %s=%d", attr.Kind, attr.N)
+			continue
+		}
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	buf.WriteString(" }")
+	return buf.String()
+}
+
+// IsFuncAttribute ensures that only function attributes can be assigned to the
+// ir.FuncAttribute interface.
+func (*AttrGroupDef) IsFuncAttribute() {}
+
+// --- [ Function arguments ] --------------------------------------------------
+
+// An Arg represents a function argument of a call site with parameter
+// attributes.
+type Arg struct {
+	// Argument value.
+	value.Value
+	// Parameter attributes of the argument.
+	Attrs []types.ParamAttribute
+}
+
+// NewArg returns a new function argument based on the given value and
+// parameter attributes.
+func NewArg(x value.Value, attrs ...types.ParamAttribute) *Arg {
+	return &Arg{Value: x, Attrs: attrs}
+}
+
+// String returns the LLVM syntax representation of the function argument.
+func (arg *Arg) String() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(arg.Type().String())
+	for _, attr := range arg.Attrs {
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	fmt.Fprintf(buf, " %s", arg.Ident())
+	return buf.String()
+}
@@ -234,8 +234,48 @@ func (block *BasicBlock) NewXor(x, y value.Value) *InstXor {
 
 // --- [ Vector instructions ] -------------------------------------------------
 
+// NewExtractElement appends a new extractelement instruction to the basic block
+// based on the given vector and index.
+func (block *BasicBlock) NewExtractElement(x, index value.Value) *InstExtractElement {
+	inst := NewExtractElement(x, index)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewInsertElement appends a new insertelement instruction to the basic block
+// based on the given vector, element and index.
+func (block *BasicBlock) NewInsertElement(x, elem, index value.Value) *InstInsertElement {
+	inst := NewInsertElement(x, elem, index)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewShuffleVector appends a new shufflevector instruction to the basic block
+// based on the given vectors and shuffle mask.
+func (block *BasicBlock) NewShuffleVector(x, y, mask value.Value) *InstShuffleVector {
+	inst := NewShuffleVector(x, y, mask)
+	block.AppendInst(inst)
+	return inst
+}
+
 // --- [ Aggregate instructions ] ----------------------------------------------
 
+// NewExtractValue appends a new extractvalue instruction to the basic block
+// based on the given aggregate value and indices.
+func (block *BasicBlock) NewExtractValue(x value.Value, indices ...int64) *InstExtractValue {
+	inst := NewExtractValue(x, indices...)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewInsertValue appends a new insertvalue instruction to the basic block based
+// on the given aggregate value, element and indices.
+func (block *BasicBlock) NewInsertValue(x, elem value.Value, indices ...int64) *InstInsertValue {
+	inst := NewInsertValue(x, elem, indices...)
+	block.AppendInst(inst)
+	return inst
+}
+
 // --- [ Memory instructions ] -------------------------------------------------
 
 // NewAlloca appends a new alloca instruction to the basic block based on the
@@ -262,6 +302,32 @@ func (block *BasicBlock) NewStore(src, dst value.Value) *InstStore {
 	return inst
 }
 
+// NewFence appends a new fence instruction to the basic block based on the
+// given atomic memory ordering constraints.
+func (block *BasicBlock) NewFence(ordering AtomicOrdering) *InstFence {
+	inst := NewFence(ordering)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewCmpXchg appends a new cmpxchg instruction to the basic block based on the
+// given address, value to compare against, new value to store, and atomic
+// memory ordering constraints on success and failure.
+func (block *BasicBlock) NewCmpXchg(ptr, cmp, newValue value.Value, success, failure AtomicOrdering) *InstCmpXchg {
+	inst := NewCmpXchg(ptr, cmp, newValue, success, failure)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewAtomicRMW appends a new atomicrmw instruction to the basic block based on
+// the given atomic operation, address, operand and atomic memory ordering
+// constraints.
+func (block *BasicBlock) NewAtomicRMW(op AtomicOp, ptr, x value.Value, ordering AtomicOrdering) *InstAtomicRMW {
+	inst := NewAtomicRMW(op, ptr, x, ordering)
+	block.AppendInst(inst)
+	return inst
+}
+
 // NewGetElementPtr appends a new getelementptr instruction to the basic block
 // based on the given source address and element indices.
 func (block *BasicBlock) NewGetElementPtr(src value.Value, indices ...value.Value) *InstGetElementPtr {
@@ -423,6 +489,30 @@ func (block *BasicBlock) NewCall(callee value.Named, args ...value.Value) *InstC
 	return inst
 }
 
+// NewLandingPad appends a new landingpad instruction to the basic block based
+// on the given result type and landing pad clauses.
+func (block *BasicBlock) NewLandingPad(typ types.Type, clauses ...*Clause) *InstLandingPad {
+	inst := NewLandingPad(typ, clauses...)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewCatchPad appends a new catchpad instruction to the basic block based on
+// the given parent catchswitch terminator and exception arguments.
+func (block *BasicBlock) NewCatchPad(catchSwitch *TermCatchSwitch, args ...value.Value) *InstCatchPad {
+	inst := NewCatchPad(catchSwitch, args...)
+	block.AppendInst(inst)
+	return inst
+}
+
+// NewCleanupPad appends a new cleanuppad instruction to the basic block based
+// on the given parent exception pad and exception arguments.
+func (block *BasicBlock) NewCleanupPad(parentPad value.Value, args ...value.Value) *InstCleanupPad {
+	inst := NewCleanupPad(parentPad, args...)
+	block.AppendInst(inst)
+	return inst
+}
+
 // --- [ Terminators ] ---------------------------------------------------------
 
 // NewRet sets the terminator of the basic block to a new ret terminator based
@@ -458,6 +548,63 @@ func (block *BasicBlock) NewSwitch(x value.Value, targetDefault *BasicBlock, cas
 	return term
 }
 
+// NewIndirectBr sets the terminator of the basic block to a new indirectbr
+// terminator based on the given target address and list of possible
+// destinations.
+func (block *BasicBlock) NewIndirectBr(addr value.Value, validTargets ...*BasicBlock) *TermIndirectBr {
+	term := NewIndirectBr(addr, validTargets...)
+	block.SetTerm(term)
+	return term
+}
+
+// NewInvoke sets the terminator of the basic block to a new invoke terminator
+// based on the given callee, function arguments and target branches.
+//
+// The callee value may have one of the following underlying types.
+//
+//    *ir.Function
+//    *types.Param
+func (block *BasicBlock) NewInvoke(callee value.Named, args []value.Value, targetNormal, targetUnwind *BasicBlock) *TermInvoke {
+	term := NewInvoke(callee, args, targetNormal, targetUnwind)
+	block.SetTerm(term)
+	return term
+}
+
+// NewResume sets the terminator of the basic block to a new resume terminator
+// based on the given exception value.
+func (block *BasicBlock) NewResume(x value.Value) *TermResume {
+	term := NewResume(x)
+	block.SetTerm(term)
+	return term
+}
+
+// NewCatchSwitch sets the terminator of the basic block to a new catchswitch
+// terminator based on the given parent exception pad, exception handlers and
+// optional unwind target branch. A nil unwind target indicates unwinding to the
+// caller.
+func (block *BasicBlock) NewCatchSwitch(parentPad value.Value, handlers []*BasicBlock, unwindTarget *BasicBlock) *TermCatchSwitch {
+	term := NewCatchSwitch(parentPad, handlers, unwindTarget)
+	block.SetTerm(term)
+	return term
+}
+
+// NewCatchRet sets the terminator of the basic block to a new catchret
+// terminator based on the given exited catchpad instruction and target branch.
+func (block *BasicBlock) NewCatchRet(catchPad *InstCatchPad, target *BasicBlock) *TermCatchRet {
+	term := NewCatchRet(catchPad, target)
+	block.SetTerm(term)
+	return term
+}
+
+// NewCleanupRet sets the terminator of the basic block to a new cleanupret
+// terminator based on the given exited cleanuppad instruction and optional
+// unwind target branch. A nil unwind target indicates unwinding to the caller.
+func (block *BasicBlock) NewCleanupRet(cleanupPad *InstCleanupPad, unwindTarget *BasicBlock) *TermCleanupRet {
+	term := NewCleanupRet(cleanupPad, unwindTarget)
+	block.SetTerm(term)
+	return term
+}
+
 // NewUnreachable sets the terminator of the basic block to a new unreachable
 // terminator.
 func (block *BasicBlock) NewUnreachable() *TermUnreachable {
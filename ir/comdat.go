@@ -0,0 +1,65 @@
+// === [ Comdats ] =============================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#comdats
+
+package ir
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/internal/enc"
+)
+
+// A Comdat represents an LLVM IR comdat definition; a named section of object
+// files, whose duplicates are discarded by the linker based on the selection
+// kind of the comdat.
+type Comdat struct {
+	// Comdat name.
+	Name string
+	// Comdat selection kind.
+	Kind SelectionKind
+}
+
+// NewComdat returns a new comdat definition based on the given comdat name and
+// selection kind.
+func NewComdat(name string, kind SelectionKind) *Comdat {
+	return &Comdat{Name: name, Kind: kind}
+}
+
+// Ident returns the identifier associated with the comdat.
+func (comdat *Comdat) Ident() string {
+	return enc.Comdat(comdat.Name)
+}
+
+// String returns the LLVM syntax representation of the comdat definition.
+func (comdat *Comdat) String() string {
+	return fmt.Sprintf("%s = comdat %s", comdat.Ident(), comdat.Kind)
+}
+
+// SelectionKind represents the selection kind of a comdat.
+type SelectionKind int
+
+// Comdat selection kinds.
+const (
+	SelectionAny          SelectionKind = iota // any
+	SelectionExactMatch                        // exactmatch
+	SelectionLargest                           // largest
+	SelectionNoDuplicates                      // noduplicates
+	SelectionSameSize                          // samesize
+)
+
+// String returns the LLVM syntax representation of the comdat selection kind.
+func (kind SelectionKind) String() string {
+	m := map[SelectionKind]string{
+		SelectionAny:          "any",
+		SelectionExactMatch:   "exactmatch",
+		SelectionLargest:      "largest",
+		SelectionNoDuplicates: "noduplicates",
+		SelectionSameSize:     "samesize",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown comdat selection kind %d>", int(kind))
+}
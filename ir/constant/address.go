@@ -0,0 +1,55 @@
+// === [ Addresses of basic blocks ] ===========================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#addresses-of-basic-blocks
+
+package constant
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// --- [ blockaddress ] --------------------------------------------------------
+
+// BlockAddress represents a blockaddress constant; the address of a basic block
+// within a function.
+type BlockAddress struct {
+	// Constant type; always i8*.
+	Typ *types.PointerType
+	// Parent function of the basic block.
+	Func value.Named
+	// Basic block.
+	Block value.Named
+}
+
+// NewBlockAddress returns a new blockaddress constant based on the given parent
+// function and basic block.
+//
+// The parent function and basic block may have the following underlying types.
+//
+//    f       *ir.Function
+//    block   *ir.BasicBlock
+func NewBlockAddress(f, block value.Named) *BlockAddress {
+	return &BlockAddress{
+		Typ:   types.NewPointer(types.I8),
+		Func:  f,
+		Block: block,
+	}
+}
+
+// Type returns the type of the constant.
+func (c *BlockAddress) Type() types.Type {
+	return c.Typ
+}
+
+// Ident returns the string representation of the constant.
+func (c *BlockAddress) Ident() string {
+	return fmt.Sprintf("blockaddress(%s, %s)", c.Func.Ident(), c.Block.Ident())
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*BlockAddress) Immutable() {}
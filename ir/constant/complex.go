@@ -137,6 +137,14 @@ func NewStruct(fields ...Constant) *Struct {
 	return &Struct{Typ: typ, Fields: fields}
 }
 
+// NewPackedStruct returns a new packed struct constant based on the given
+// struct fields.
+func NewPackedStruct(fields ...Constant) *Struct {
+	c := NewStruct(fields...)
+	c.Typ.Packed = true
+	return c
+}
+
 // Type returns the type of the constant.
 func (c *Struct) Type() types.Type {
 	return c.Typ
@@ -145,6 +153,9 @@ func (c *Struct) Type() types.Type {
 // Ident returns the string representation of the constant.
 func (c *Struct) Ident() string {
 	buf := &bytes.Buffer{}
+	if c.Typ.Packed {
+		buf.WriteString("<")
+	}
 	buf.WriteString("{")
 	if len(c.Fields) > 0 {
 		// Use same output format as Clang.
@@ -163,6 +174,9 @@ func (c *Struct) Ident() string {
 		buf.WriteString(" ")
 	}
 	buf.WriteString("}")
+	if c.Typ.Packed {
+		buf.WriteString(">")
+	}
 	return buf.String()
 }
 
@@ -20,9 +20,10 @@ import (
 //
 // http://llvm.org/docs/LangRef.html#simple-constants
 //
-//    *constant.Int     (https://godoc.org/github.com/llir/llvm/ir/constant#Int)
-//    *constant.Float   (https://godoc.org/github.com/llir/llvm/ir/constant#Float)
-//    *constant.Null    (https://godoc.org/github.com/llir/llvm/ir/constant#Null)
+//    *constant.Int         (https://godoc.org/github.com/llir/llvm/ir/constant#Int)
+//    *constant.Float       (https://godoc.org/github.com/llir/llvm/ir/constant#Float)
+//    *constant.Null        (https://godoc.org/github.com/llir/llvm/ir/constant#Null)
+//    *constant.NoneToken   (https://godoc.org/github.com/llir/llvm/ir/constant#NoneToken)
 //
 // Complex constants
 //
@@ -33,11 +34,26 @@ import (
 //    *constant.Struct            (https://godoc.org/github.com/llir/llvm/ir/constant#Struct)
 //    *constant.ZeroInitializer   (https://godoc.org/github.com/llir/llvm/ir/constant#ZeroInitializer)
 //
-// Global variable and function addresses
+// Undefined values
+//
+// http://llvm.org/docs/LangRef.html#undefined-values
+//
+//    *constant.Undef    (https://godoc.org/github.com/llir/llvm/ir/constant#Undef)
+//    *constant.Poison   (https://godoc.org/github.com/llir/llvm/ir/constant#Poison)
+//
+// Global variable, alias, IFunc and function addresses
 //
 //    *ir.Global     (https://godoc.org/github.com/llir/llvm/ir#Global)
+//    *ir.Alias      (https://godoc.org/github.com/llir/llvm/ir#Alias)
+//    *ir.IFunc      (https://godoc.org/github.com/llir/llvm/ir#IFunc)
 //    *ir.Function   (https://godoc.org/github.com/llir/llvm/ir#Function)
 //
+// Addresses of basic blocks
+//
+// http://llvm.org/docs/LangRef.html#addresses-of-basic-blocks
+//
+//    *constant.BlockAddress   (https://godoc.org/github.com/llir/llvm/ir/constant#BlockAddress)
+//
 // Constant expressions
 //
 // http://llvm.org/docs/LangRef.html#constant-expressions
@@ -1,20 +1,38 @@
 package constant_test
 
 import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/datalayout"
+	"github.com/llir/llvm/ir/types"
 )
 
+// x86_64 data layout of Linux targets.
+const x86_64 = "e-m:e-p270:32:32-p271:32:32-p272:64:64-i64:64-f80:128-n8:16:32:64-S128"
+
 // Validate that the relevant types satisfy the constant.Constant interface.
 var (
 	// Simple constants.
 	_ constant.Constant = &constant.Int{}
 	_ constant.Constant = &constant.Float{}
 	_ constant.Constant = &constant.Null{}
+	_ constant.Constant = &constant.NoneToken{}
 	// Complex constants.
 	_ constant.Constant = &constant.Vector{}
 	_ constant.Constant = &constant.Array{}
 	_ constant.Constant = &constant.Struct{}
 	_ constant.Constant = &constant.ZeroInitializer{}
+	// Undefined values.
+	_ constant.Constant = &constant.Undef{}
+	_ constant.Constant = &constant.Poison{}
+	// Addresses of basic blocks.
+	_ constant.Constant = &constant.BlockAddress{}
 )
 
 // Validate that the relevant types satisfy the constant.Expr interface.
@@ -40,6 +58,13 @@ var (
 	_ constant.Expr = &constant.ExprAnd{}
 	_ constant.Expr = &constant.ExprOr{}
 	_ constant.Expr = &constant.ExprXor{}
+	// Vector instructions
+	_ constant.Expr = &constant.ExprExtractElement{}
+	_ constant.Expr = &constant.ExprInsertElement{}
+	_ constant.Expr = &constant.ExprShuffleVector{}
+	// Aggregate instructions
+	_ constant.Expr = &constant.ExprExtractValue{}
+	_ constant.Expr = &constant.ExprInsertValue{}
 	// Memory instructions
 	_ constant.Expr = &constant.ExprGetElementPtr{}
 	// Conversion instructions
@@ -61,3 +86,678 @@ var (
 	_ constant.Expr = &constant.ExprFCmp{}
 	_ constant.Expr = &constant.ExprSelect{}
 )
+
+func TestSimplifyUndef(t *testing.T) {
+	i32 := types.I32
+	x := constant.NewInt(42, i32)
+	undef := constant.NewUndef(i32)
+	poison := constant.NewPoison(i32)
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		{want: "i32 poison", expr: constant.NewAdd(x, poison)},
+		{want: "i32 undef", expr: constant.NewAdd(x, undef)},
+		{want: "i32 0", expr: constant.NewMul(undef, x)},
+		{want: "i32 undef", expr: constant.NewAnd(undef, undef)},
+		{want: "i32 -1", expr: constant.NewOr(x, undef)},
+		{want: "i32 0", expr: constant.NewXor(undef, undef)},
+		{want: "i32 poison", expr: constant.NewUDiv(x, undef)},
+		{want: "i32 0", expr: constant.NewSDiv(undef, x)},
+		{want: "i32 poison", expr: constant.NewShl(x, undef)},
+		{want: "float undef", expr: constant.NewFAdd(constant.NewUndef(types.Float), constant.NewUndef(types.Float))},
+		{want: "i64 0", expr: constant.NewZExt(undef, types.I64)},
+		{want: "i8 undef", expr: constant.NewTrunc(undef, types.I8)},
+		{want: "i64 poison", expr: constant.NewSExt(poison, types.I64)},
+		{want: "i1 undef", expr: constant.NewICmp(constant.IntEQ, x, undef)},
+		{want: "i32 poison", expr: constant.NewSelect(constant.NewPoison(types.I1), x, x)},
+		{want: "i32 42", expr: constant.NewSelect(constant.NewUndef(types.I1), x, x)},
+		{want: "i32 42", expr: constant.NewSelect(constant.True, poison, x)},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestSimplifyInt(t *testing.T) {
+	i8 := types.I8
+	i32 := types.I32
+	i8ptr := types.NewPointer(i8)
+	nuw := []constant.OverflowFlag{constant.OverflowNUW}
+	nsw := []constant.OverflowFlag{constant.OverflowNSW}
+	x := func(v int64) *constant.Int { return constant.NewInt(v, i8) }
+	add := func(flags []constant.OverflowFlag, a, b int64) constant.Expr {
+		e := constant.NewAdd(x(a), x(b))
+		e.OverflowFlags = flags
+		return e
+	}
+	shl := func(flags []constant.OverflowFlag, a, b int64) constant.Expr {
+		e := constant.NewShl(x(a), x(b))
+		e.OverflowFlags = flags
+		return e
+	}
+	udivExact := constant.NewUDiv(x(7), x(2))
+	udivExact.Exact = true
+	lshrExact := constant.NewLShr(x(3), x(1))
+	lshrExact.Exact = true
+	vec := func(a, b int64) *constant.Vector {
+		return constant.NewVector(constant.NewInt(a, i32), constant.NewInt(b, i32))
+	}
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		// Wrapping arithmetic.
+		{want: "i8 -128", expr: add(nil, 127, 1)},
+		{want: "i8 poison", expr: add(nsw, 127, 1)},
+		{want: "i8 poison", expr: add(nuw, -1, 1)},
+		{want: "i8 0", expr: add(nsw, -1, 1)},
+		{want: "i1 false", expr: constant.NewAdd(constant.True, constant.True)},
+		{want: "i8 -1", expr: constant.NewSub(x(0), x(1))},
+		{want: "i8 16", expr: constant.NewMul(x(100), x(100))},
+		{want: "i32 42", expr: constant.NewAdd(constant.NewMul(constant.NewInt(6, i32), constant.NewInt(7, i32)), constant.NewZeroInitializer(i32))},
+		// Division.
+		{want: "i8 poison", expr: constant.NewUDiv(x(7), x(0))},
+		{want: "i8 poison", expr: udivExact},
+		{want: "i8 127", expr: constant.NewUDiv(x(-1), x(2))},
+		{want: "i8 5", expr: constant.NewURem(x(-1), x(10))},
+		{want: "i8 -3", expr: constant.NewSDiv(x(-7), x(2))},
+		{want: "i8 -1", expr: constant.NewSRem(x(-7), x(2))},
+		{want: "i8 poison", expr: constant.NewSDiv(x(-128), x(-1))},
+		{want: "i8 poison", expr: constant.NewSRem(x(1), x(0))},
+		// Shifts.
+		{want: "i8 poison", expr: shl(nil, 1, 8)},
+		{want: "i8 -128", expr: shl(nil, 1, 7)},
+		{want: "i8 poison", expr: shl(nsw, 1, 7)},
+		{want: "i8 poison", expr: shl(nuw, -128, 1)},
+		{want: "i8 15", expr: constant.NewLShr(x(-1), x(4))},
+		{want: "i8 -4", expr: constant.NewAShr(x(-16), x(2))},
+		{want: "i8 poison", expr: lshrExact},
+		// Bitwise operations.
+		{want: "i8 8", expr: constant.NewAnd(x(12), x(10))},
+		{want: "i8 14", expr: constant.NewOr(x(12), x(10))},
+		{want: "i8 -7", expr: constant.NewXor(x(-1), x(6))},
+		// Vectors.
+		{want: "<2 x i32> <i32 4, i32 6>", expr: constant.NewAdd(vec(1, 2), vec(3, 4))},
+		{want: "<2 x i32> <i32 poison, i32 2>", expr: constant.NewUDiv(vec(1, 4), vec(0, 2))},
+		{want: "<2 x i32> <i32 1, i32 2>", expr: constant.NewOr(vec(1, 2), constant.NewZeroInitializer(types.NewVector(i32, 2)))},
+		// Comparisons.
+		{want: "i1 true", expr: constant.NewICmp(constant.IntSLT, x(-1), x(0))},
+		{want: "i1 false", expr: constant.NewICmp(constant.IntULT, x(-1), x(0))},
+		{want: "i1 true", expr: constant.NewICmp(constant.IntSLT, constant.True, constant.False)},
+		{want: "i1 true", expr: constant.NewICmp(constant.IntEQ, constant.NewNull(i8ptr), constant.NewNull(i8ptr))},
+		{want: "<2 x i1> <i1 true, i1 false>", expr: constant.NewICmp(constant.IntUGE, vec(3, 1), vec(3, 4))},
+		// Select.
+		{want: "i8 1", expr: constant.NewSelect(constant.True, x(1), x(2))},
+		{want: "<2 x i32> <i32 1, i32 4>", expr: constant.NewSelect(constant.NewVector(constant.True, constant.False), vec(1, 2), vec(3, 4))},
+		// Memory.
+		{want: "i8* poison", expr: constant.NewGetElementPtr(constant.NewNull(i8ptr), constant.NewPoison(i32))},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestSimplifyFloat(t *testing.T) {
+	f := func(s string, typ types.Type) *constant.Float { return constant.NewFloatFromString(s, typ) }
+	d := func(s string) *constant.Float { return f(s, types.Double) }
+	i8ptr := types.NewPointer(types.I8)
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		// Rounding to the precision of the floating-point type.
+		{want: "double 0.30000000000000004", expr: constant.NewFAdd(d("0.1"), d("0.2"))},
+		{want: "float 0.30000001192092896", expr: constant.NewFAdd(f("0.1", types.Float), f("0.2", types.Float))},
+		{want: "half 0xH7C00", expr: constant.NewFMul(f("256.0", types.Half), f("256.0", types.Half))},
+		{want: "fp128 0xL00000000000000013FFF000000000000", expr: constant.NewFAdd(f("1.0", types.FP128), f("0xL00000000000000013F8F000000000000", types.FP128))},
+		{want: "x86_fp80 0xK3FFDAAAAAAAAAAAAAAAB", expr: constant.NewFDiv(f("1.0", types.X86_FP80), f("3.0", types.X86_FP80))},
+		{want: "double -0.0", expr: constant.NewFAdd(d("-0.0"), d("-0.0"))},
+		{want: "double 0.0", expr: constant.NewFSub(d("1.0"), d("1.0"))},
+		{want: "double 1.5", expr: constant.NewFRem(d("5.5"), d("2.0"))},
+		{want: "double -1.5", expr: constant.NewFRem(d("-5.5"), d("2.0"))},
+		// Infinity and NaN.
+		{want: "double 0x7FF0000000000000", expr: constant.NewFDiv(d("1.0"), d("0.0"))},
+		{want: "double 0xFFF0000000000000", expr: constant.NewFDiv(d("-1.0"), d("0.0"))},
+		{want: "double 0x7FF8000000000000", expr: constant.NewFDiv(d("0.0"), d("0.0"))},
+		{want: "double 0x7FF8000000000000", expr: constant.NewFSub(d("0x7FF0000000000000"), d("0x7FF0000000000000"))},
+		{want: "double 0x7FF8000000000000", expr: constant.NewFRem(d("1.0"), d("0.0"))},
+		{want: "double 0x7FFC000000000001", expr: constant.NewFMul(d("0x7FF4000000000001"), d("2.0"))},
+		{want: "float 0x7FF8000000000000", expr: constant.NewFAdd(constant.NewUndef(types.Float), f("1.0", types.Float))},
+		// Conversions.
+		{want: "float 0.10000000149011612", expr: constant.NewFPTrunc(d("0.1"), types.Float)},
+		{want: "double 0.10000000149011612", expr: constant.NewFPExt(f("0.1", types.Float), types.Double)},
+		{want: "float 0x7FFC000000000000", expr: constant.NewFPTrunc(d("0x7FF4000000000000"), types.Float)},
+		{want: "i32 -2", expr: constant.NewFPToSI(d("-2.5"), types.I32)},
+		{want: "i32 poison", expr: constant.NewFPToUI(d("-1.0"), types.I32)},
+		{want: "i8 poison", expr: constant.NewFPToUI(d("256.0"), types.I8)},
+		{want: "i8 poison", expr: constant.NewFPToSI(d("0x7FF8000000000000"), types.I8)},
+		{want: "float 255.0", expr: constant.NewUIToFP(constant.NewInt(-1, types.I8), types.Float)},
+		{want: "float -1.0", expr: constant.NewSIToFP(constant.NewInt(-1, types.I8), types.Float)},
+		{want: "float 1.0", expr: constant.NewBitCast(constant.NewInt(0x3F800000, types.I32), types.Float)},
+		{want: "i64 -4616189618054758400", expr: constant.NewBitCast(d("-1.0"), types.I64)},
+		{want: "i32 7", expr: constant.NewBitCast(constant.NewBitCast(constant.NewInt(7, types.I32), types.Float), types.I32)},
+		{want: "i8* null", expr: constant.NewIntToPtr(constant.NewInt(0, types.I64), i8ptr)},
+		{want: "i64 0", expr: constant.NewPtrToInt(constant.NewNull(i8ptr), types.I64)},
+		{want: "i8 1", expr: constant.NewTrunc(constant.NewInt(257, types.I32), types.I8)},
+		{want: "i32 255", expr: constant.NewZExt(constant.NewInt(-1, types.I8), types.I32)},
+		{want: "i32 -1", expr: constant.NewSExt(constant.True, types.I32)},
+		// Comparisons.
+		{want: "i1 true", expr: constant.NewFCmp(constant.FloatOLT, d("1.0"), d("2.0"))},
+		{want: "i1 true", expr: constant.NewFCmp(constant.FloatOEQ, d("-0.0"), d("0.0"))},
+		{want: "i1 false", expr: constant.NewFCmp(constant.FloatOEQ, d("0x7FF8000000000000"), d("0x7FF8000000000000"))},
+		{want: "i1 true", expr: constant.NewFCmp(constant.FloatUNE, d("0x7FF8000000000000"), d("1.0"))},
+		{want: "i1 true", expr: constant.NewFCmp(constant.FloatUNO, d("0x7FF8000000000000"), d("1.0"))},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestSimplifyGetElementPtr(t *testing.T) {
+	arr := types.NewArray(types.I32, 4)
+	a := ir.NewGlobalDef("a", constant.NewZeroInitializer(arr))
+	p := ir.NewGlobalDef("p", constant.NewInt(0, types.I32))
+	// %T = type { i64, [4 x i32] }
+	typ := types.NewStruct(types.I64, arr)
+	typ.SetName("T")
+	g := ir.NewGlobalDef("g", constant.NewZeroInitializer(typ))
+	v := ir.NewGlobalDef("v", constant.NewZeroInitializer(types.NewVector(types.I32, 4)))
+	i32 := func(v int64) *constant.Int { return constant.NewInt(v, types.I32) }
+	i64 := func(v int64) *constant.Int { return constant.NewInt(v, types.I64) }
+	gep := constant.NewGetElementPtr
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		// Zero-index chains.
+		{want: "[4 x i32]* @a", expr: gep(a, i64(0))},
+		{want: "i32* @p", expr: gep(p)},
+		{want: "i32* getelementptr ([4 x i32], [4 x i32]* @a, i64 0, i64 0)", expr: gep(a, i64(0), i64(0))},
+		{want: "[4 x i32]* @a", expr: gep(gep(a, i64(0)), i64(0))},
+		// Nested getelementptr expressions.
+		{want: "i32* getelementptr ([4 x i32], [4 x i32]* @a, i64 0, i64 3)", expr: gep(gep(a, i64(0), i64(1)), i64(2))},
+		{want: "i32* getelementptr (i32, i32* @p, i32 3)", expr: gep(gep(p, i32(1)), i32(2))},
+		{want: "i32* getelementptr (i32, i32* @p, i64 3)", expr: gep(gep(p, i32(1)), i64(2))},
+		{want: "i32* @p", expr: gep(gep(p, i64(1)), i64(-1))},
+		{want: "i32* getelementptr (%T, %T* @g, i64 0, i32 1, i64 2)", expr: gep(gep(g, i64(0), i32(1)), i64(0), i64(2))},
+		{want: "i32* getelementptr (%T, %T* @g, i64 1, i32 1, i64 2)", expr: gep(gep(g, i64(1)), i64(0), i32(1), i64(2))},
+		{want: "i32* getelementptr ([4 x i32], [4 x i32]* getelementptr (%T, %T* @g, i64 0, i32 1), i64 1, i64 2)", expr: gep(gep(g, i64(0), i32(1)), i64(1), i64(2))},
+		// Vector elements.
+		{want: "i32* getelementptr (<4 x i32>, <4 x i32>* @v, i64 0, i64 1)", expr: gep(v, i64(0), i64(1))},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestSimplifyVector(t *testing.T) {
+	i32 := func(v int64) *constant.Int { return constant.NewInt(v, types.I32) }
+	v := constant.NewVector(i32(1), i32(2), i32(3), i32(4))
+	w := constant.NewVector(i32(5), i32(6), i32(7), i32(8))
+	zero := constant.NewZeroInitializer(types.NewVector(types.I32, 4))
+	nxv := types.NewVector(types.I32, 4)
+	nxv.Scalable = true
+	nxzero := constant.NewZeroInitializer(nxv)
+	g := ir.NewGlobalDef("g", constant.NewInt(0, types.I32))
+	ptoi := constant.NewPtrToInt(g, types.I32)
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		// extractelement
+		{want: "i32 3", expr: constant.NewExtractElement(v, i32(2))},
+		{want: "i32 0", expr: constant.NewExtractElement(zero, i32(1))},
+		{want: "i32 poison", expr: constant.NewExtractElement(v, i32(4))},
+		{want: "i32 poison", expr: constant.NewExtractElement(v, constant.NewUndef(types.I32))},
+		{want: "i32 0", expr: constant.NewExtractElement(nxzero, i32(1))},
+		{want: "i32 extractelement (<vscale x 4 x i32> zeroinitializer, i32 7)", expr: constant.NewExtractElement(nxzero, i32(7))},
+		{want: "i32 extractelement (<4 x i32> <i32 1, i32 2, i32 3, i32 4>, i32 ptrtoint (i32* @g to i32))", expr: constant.NewExtractElement(v, ptoi)},
+		// insertelement
+		{want: "<4 x i32> <i32 1, i32 9, i32 3, i32 4>", expr: constant.NewInsertElement(v, i32(9), i32(1))},
+		{want: "<4 x i32> <i32 0, i32 0, i32 0, i32 9>", expr: constant.NewInsertElement(zero, i32(9), i32(3))},
+		{want: "<4 x i32> poison", expr: constant.NewInsertElement(v, i32(9), i32(4))},
+		{want: "<vscale x 4 x i32> insertelement (<vscale x 4 x i32> zeroinitializer, i32 9, i32 0)", expr: constant.NewInsertElement(nxzero, i32(9), i32(0))},
+		// shufflevector
+		{want: "<4 x i32> <i32 4, i32 5, i32 2, i32 1>", expr: constant.NewShuffleVector(v, w, constant.NewVector(i32(3), i32(4), i32(1), i32(0)))},
+		{want: "<2 x i32> <i32 8, i32 poison>", expr: constant.NewShuffleVector(v, w, constant.NewVector(i32(7), constant.NewUndef(types.I32)))},
+		{want: "<4 x i32> <i32 1, i32 1, i32 1, i32 1>", expr: constant.NewShuffleVector(v, zero, zero)},
+		{want: "<vscale x 4 x i32> zeroinitializer", expr: constant.NewShuffleVector(nxzero, nxzero, nxzero)},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestSimplifyAggregate(t *testing.T) {
+	i8 := func(v int64) *constant.Int { return constant.NewInt(v, types.I8) }
+	i32 := func(v int64) *constant.Int { return constant.NewInt(v, types.I32) }
+	st := types.NewStruct(types.I8, types.NewArray(types.I32, 2))
+	zero := constant.NewZeroInitializer(st)
+	s := constant.NewStruct(i8(1), constant.NewArray(i32(2), i32(3)))
+	g := ir.NewGlobalDef("g", constant.NewInt(0, types.I32))
+	ptoi := constant.NewPtrToInt(g, types.I32)
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		// extractvalue
+		{want: "i8 1", expr: constant.NewExtractValue(constant.NewStruct(i8(1)), 0)},
+		{want: "i32 3", expr: constant.NewExtractValue(s, 1, 1)},
+		{want: "[2 x i32] [i32 2, i32 3]", expr: constant.NewExtractValue(s, 1)},
+		{want: "i32 0", expr: constant.NewExtractValue(zero, 1, 0)},
+		{want: "i32 undef", expr: constant.NewExtractValue(constant.NewUndef(st), 1, 0)},
+		{want: "i32 ptrtoint (i32* @g to i32)", expr: constant.NewExtractValue(constant.NewArray(ptoi), 0)},
+		// insertvalue
+		{want: "{ i8, [2 x i32] } { i8 1, [2 x i32] [i32 2, i32 9] }", expr: constant.NewInsertValue(s, i32(9), 1, 1)},
+		{want: "{ i8, [2 x i32] } { i8 0, [2 x i32] [i32 0, i32 9] }", expr: constant.NewInsertValue(zero, i32(9), 1, 1)},
+		{want: "{ i8, [2 x i32] } { i8 7, [2 x i32] [i32 2, i32 3] }", expr: constant.NewInsertValue(s, i8(7), 0)},
+	}
+	for i, g := range golden {
+		c := g.expr.Simplify()
+		got := c.Type().String() + " " + c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestGetElementPtrOffset(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// %T = type { i8*, i64, i32 }
+	typ := types.NewStruct(types.NewPointer(types.I8), types.I64, types.I32)
+	typ.SetName("T")
+	g := ir.NewGlobalDef("g", constant.NewZeroInitializer(typ))
+	i32 := func(v int64) *constant.Int { return constant.NewInt(v, types.I32) }
+	i64 := func(v int64) *constant.Int { return constant.NewInt(v, types.I64) }
+	ptr32 := types.NewPointer(types.I8)
+	ptr32.AddrSpace = 270
+	golden := []struct {
+		want int64
+		elem types.Type
+		expr *constant.ExprGetElementPtr
+	}{
+		{want: 16, elem: types.I32, expr: constant.NewGetElementPtr(g, i64(0), i32(2))},
+		{want: 24, elem: typ, expr: constant.NewGetElementPtr(g, i64(1))},
+		{want: -16, elem: types.I64, expr: constant.NewGetElementPtr(g, i64(-1), i32(1))},
+		{want: 0, elem: typ, expr: constant.NewGetElementPtr(g)},
+		{want: 8, elem: types.I64, expr: constant.NewGetElementPtr(g, constant.NewAdd(i64(-1), i64(1)), i32(1))},
+		// Offsets wrap around to the 32-bit index size of address space 270.
+		{want: 0, elem: types.I8, expr: constant.NewGetElementPtr(constant.NewNull(ptr32), i64(1<<32))},
+	}
+	for i, g := range golden {
+		offset, elem, err := g.expr.Offset(dl)
+		if err != nil {
+			t.Errorf("i=%d; unexpected error: %v", i, err)
+			continue
+		}
+		if offset != g.want {
+			t.Errorf("i=%d; offset mismatch; expected %d, got %d", i, g.want, offset)
+		}
+		if !elem.Equal(g.elem) {
+			t.Errorf("i=%d; element type mismatch; expected %v, got %v", i, g.elem, elem)
+		}
+	}
+	index := constant.NewPtrToInt(g, types.I64)
+	if _, _, err := constant.NewGetElementPtr(g, index).Offset(dl); err == nil {
+		t.Errorf("expected error for non-constant index, got nil")
+	}
+}
+
+func TestScalableVectorExprTypes(t *testing.T) {
+	scalable := &types.VectorType{Elem: types.I32, Len: 4, Scalable: true}
+	x := constant.NewZeroInitializer(scalable)
+	golden := []struct {
+		want string
+		expr constant.Expr
+	}{
+		{want: "<vscale x 4 x i1>", expr: constant.NewICmp(constant.IntEQ, x, x)},
+		{want: "<vscale x 4 x i1>", expr: constant.NewFCmp(constant.FloatOEQ, constant.NewZeroInitializer(&types.VectorType{Elem: types.Float, Len: 4, Scalable: true}), constant.NewZeroInitializer(&types.VectorType{Elem: types.Float, Len: 4, Scalable: true}))},
+		{want: "<vscale x 4 x i32>", expr: constant.NewShuffleVector(x, constant.NewUndef(scalable), constant.NewZeroInitializer(scalable))},
+	}
+	for i, g := range golden {
+		if got := g.expr.Type().String(); got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestImage(t *testing.T) {
+	f := func(s string, typ types.Type) *constant.Float { return constant.NewFloatFromString(s, typ) }
+	i8 := func(v int64) *constant.Int { return constant.NewInt(v, types.I8) }
+	b := func(v int64) *constant.Int { return constant.NewInt(v, types.I1) }
+	chars := constant.NewArray(i8('h'), i8('i'), i8(0))
+	chars.CharArray = true
+	golden := []struct {
+		layout string
+		want   string
+		c      constant.Constant
+	}{
+		// Struct padding.
+		{layout: x86_64, want: "0100000004030201feff0000", c: constant.NewStruct(i8(1), constant.NewInt(0x01020304, types.I32), constant.NewInt(-2, types.I16))},
+		{layout: x86_64, want: "0102", c: constant.NewPackedStruct(i8(1), constant.NewInt(2, types.I8))},
+		{layout: "E", want: "01020304", c: constant.NewInt(0x01020304, types.I32)},
+		// Arrays and vectors.
+		{layout: x86_64, want: "686900", c: chars},
+		{layout: x86_64, want: "0100020000000000", c: constant.NewArray(constant.NewInt(1, types.I16), constant.NewInt(2, types.I16), constant.NewZeroInitializer(types.I16), constant.NewUndef(types.I16))},
+		{layout: x86_64, want: "0d", c: constant.NewVector(b(1), b(0), b(1), b(1))},
+		{layout: "E", want: "0b", c: constant.NewVector(b(1), b(0), b(1), b(1))},
+		{layout: x86_64, want: "0000000000000000", c: constant.NewZeroInitializer(types.NewArray(types.I16, 4))},
+		// Floating-point values.
+		{layout: x86_64, want: "000000000000f03f", c: f("1.0", types.Double)},
+		{layout: "E", want: "3ff0000000000000", c: f("1.0", types.Double)},
+		{layout: x86_64, want: "0000000000000080ff3f000000000000", c: f("1.0", types.X86_FP80)},
+		{layout: "E", want: "3ff00000000000003c90000000000000", c: f("0xM3FF00000000000003C90000000000000", types.PPC_FP128)},
+		// Folded constant expressions.
+		{layout: x86_64, want: "2a00000000000000", c: constant.NewMul(constant.NewInt(6, types.I64), constant.NewInt(7, types.I64))},
+		{layout: "E-p:32:32", want: "00000010", c: constant.NewIntToPtr(constant.NewInt(16, types.I32), types.NewPointer(types.I8))},
+		{layout: "p:32:32", want: "04000000", c: constant.NewIntToPtr(constant.NewInt(0x100000004, types.I64), types.NewPointer(types.I8))},
+		{layout: "p:32:32", want: "00000000", c: constant.NewGetElementPtr(constant.NewIntToPtr(constant.NewInt(0x1ffffffff, types.I64), types.NewPointer(types.I8)), constant.NewInt(1, types.I64))},
+		{layout: "p1:16:16", want: "4523", c: constant.NewIntToPtr(constant.NewInt(0x12345, types.I32), &types.PointerType{Elem: types.I8, AddrSpace: 1})},
+	}
+	for i, g := range golden {
+		dl, err := datalayout.Parse(g.layout)
+		if err != nil {
+			t.Errorf("i=%d; unable to parse data layout: %v", i, err)
+			continue
+		}
+		img := constant.NewImage(dl, g.c)
+		if got := hex.EncodeToString(img.Data); got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+		if len(img.Relocs) != 0 || len(img.Unfolded) != 0 {
+			t.Errorf("i=%d; unexpected relocations %v or unfolded expressions %v", i, img.Relocs, img.Unfolded)
+		}
+	}
+}
+
+func TestImageRelocs(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// %T = type { i8*, i64, i32 }
+	typ := types.NewStruct(types.NewPointer(types.I8), types.I64, types.I32)
+	typ.SetName("T")
+	g := ir.NewGlobalDef("g", constant.NewZeroInitializer(typ))
+	field := constant.NewGetElementPtr(g, constant.NewInt(0, types.I64), constant.NewInt(2, types.I32))
+	unfolded := constant.NewPtrToInt(g, types.I32)
+	c := constant.NewStruct(
+		field,
+		constant.NewPtrToInt(g, types.I64),
+		constant.NewSub(constant.NewPtrToInt(field, types.I64), constant.NewPtrToInt(g, types.I64)),
+		constant.NewBitCast(constant.NewGetElementPtr(g, constant.NewInt(1, types.I64)), types.NewPointer(types.I8)),
+		unfolded,
+	)
+	img := constant.NewImage(dl, c)
+	want := "0000000000000000" + "0000000000000000" + "1000000000000000" + "0000000000000000" + "0000000000000000"
+	if got := hex.EncodeToString(img.Data); got != want {
+		t.Errorf("data mismatch; expected %q, got %q", want, got)
+	}
+	wantRelocs := []constant.Reloc{
+		{Offset: 0, Size: 8, Sym: g, Addend: 16},
+		{Offset: 8, Size: 8, Sym: g, Addend: 0},
+		{Offset: 24, Size: 8, Sym: g, Addend: 24},
+	}
+	if !reflect.DeepEqual(img.Relocs, wantRelocs) {
+		t.Errorf("relocations mismatch; expected %v, got %v", wantRelocs, img.Relocs)
+	}
+	wantUnfolded := []constant.Unfolded{{Offset: 32, Expr: unfolded}}
+	if !reflect.DeepEqual(img.Unfolded, wantUnfolded) {
+		t.Errorf("unfolded expressions mismatch; expected %v, got %v", wantUnfolded, img.Unfolded)
+	}
+}
+
+func TestStructIdent(t *testing.T) {
+	x := constant.NewInt(1, types.I8)
+	y := constant.NewInt(2, types.I32)
+	golden := []struct {
+		want string
+		c    *constant.Struct
+	}{
+		{want: "{ i8, i32 } { i8 1, i32 2 }", c: constant.NewStruct(x, y)},
+		{want: "<{ i8, i32 }> <{ i8 1, i32 2 }>", c: constant.NewPackedStruct(x, y)},
+		{want: "<{}> <{}>", c: constant.NewPackedStruct()},
+	}
+	for i, g := range golden {
+		got := g.c.Type().String() + " " + g.c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestIntIdent(t *testing.T) {
+	i1, i8, i64, i128 := types.I1, types.I8, types.I64, types.I128
+	// 2^100
+	big100 := new(big.Int).Lsh(big.NewInt(1), 100)
+	golden := []struct {
+		want string
+		c    *constant.Int
+	}{
+		{want: "true", c: constant.NewInt(1, i1)},
+		{want: "false", c: constant.NewInt(0, i1)},
+		{want: "true", c: constant.NewInt(-1, i1)},
+		{want: "false", c: constant.NewInt(2, i1)},
+		{want: "-1", c: constant.NewInt(255, i8)},
+		{want: "-128", c: constant.NewInt(128, i8)},
+		{want: "127", c: constant.NewInt(-129, i8)},
+		{want: "-1", c: constant.NewIntFromUint64(math.MaxUint64, i64)},
+		{want: "1267650600228229401496703205376", c: constant.NewIntFromBigInt(big100, i128)},
+		{want: "-1", c: constant.NewIntFromString("340282366920938463463374607431768211455", i128)},
+		{want: "-1", c: constant.NewIntFromString("u0xFF", i8)},
+		{want: "true", c: constant.NewIntFromString("true", i1)},
+		// Values of integer constants created without constructor are wrapped on
+		// output.
+		{want: "-1", c: &constant.Int{Typ: i8, X: big.NewInt(255)}},
+	}
+	for i, g := range golden {
+		got := g.c.Ident()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestIntAccessors(t *testing.T) {
+	// 2^100
+	big100 := new(big.Int).Lsh(big.NewInt(1), 100)
+	golden := []struct {
+		c        *constant.Int
+		i64      int64
+		isInt64  bool
+		u64      uint64
+		isUint64 bool
+	}{
+		{c: constant.NewInt(-1, types.I8), i64: -1, isInt64: true, u64: 255, isUint64: true},
+		{c: constant.NewInt(1, types.I1), i64: 1, isInt64: true, u64: 1, isUint64: true},
+		{c: constant.NewInt(-1, types.I64), i64: -1, isInt64: true, u64: math.MaxUint64, isUint64: true},
+		{c: constant.NewInt(-1, types.I128), i64: -1, isInt64: true, isUint64: false},
+		{c: constant.NewIntFromUint64(math.MaxUint64, types.I128), isInt64: false, u64: math.MaxUint64, isUint64: true},
+		{c: constant.NewIntFromBigInt(big100, types.I128), isInt64: false, isUint64: false},
+		{c: constant.NewInt(math.MinInt64, types.I128), i64: math.MinInt64, isInt64: true, isUint64: false},
+		{c: constant.NewIntFromUint64(1<<63, types.I128), isInt64: false, u64: 1 << 63, isUint64: true},
+	}
+	for i, g := range golden {
+		if got := g.c.IsInt64(); got != g.isInt64 {
+			t.Errorf("i=%d; IsInt64 mismatch; expected %v, got %v", i, g.isInt64, got)
+		} else if got && g.c.Int64() != g.i64 {
+			t.Errorf("i=%d; Int64 mismatch; expected %d, got %d", i, g.i64, g.c.Int64())
+		}
+		if got := g.c.IsUint64(); got != g.isUint64 {
+			t.Errorf("i=%d; IsUint64 mismatch; expected %v, got %v", i, g.isUint64, got)
+		} else if got && g.c.Uint64() != g.u64 {
+			t.Errorf("i=%d; Uint64 mismatch; expected %d, got %d", i, g.u64, g.c.Uint64())
+		}
+	}
+	// The big.Int accessors return copies.
+	c := constant.NewInt(-2, types.I8)
+	if got, want := c.BigUint(), big.NewInt(254); got.Cmp(want) != 0 {
+		t.Errorf("BigUint mismatch; expected %v, got %v", want, got)
+	}
+	c.BigInt().SetInt64(42)
+	if got := c.Int64(); got != -2 {
+		t.Errorf("BigInt modified constant value; expected -2, got %d", got)
+	}
+}
+
+func TestFloatIdent(t *testing.T) {
+	golden := []struct {
+		in   string
+		typ  types.Type
+		want string
+	}{
+		// x86_fp80
+		{in: "0xK3FFF8000000000000000", typ: types.X86_FP80, want: "0xK3FFF8000000000000000"},
+		{in: "0xKFFFF8000000000000000", typ: types.X86_FP80, want: "0xKFFFF8000000000000000"},
+		{in: "0xK7FFFC000000000000001", typ: types.X86_FP80, want: "0xK7FFFC000000000000001"},
+		// Pseudo-denormal.
+		{in: "0xK00008000000000000000", typ: types.X86_FP80, want: "0xK00008000000000000000"},
+		{in: "1.5", typ: types.X86_FP80, want: "0xK3FFFC000000000000000"},
+		// Short literals; digits are assigned to groups from left to right.
+		{in: "0xK1", typ: types.X86_FP80, want: "0xK00010000000000000000"},
+		{in: "0xK3FFF8", typ: types.X86_FP80, want: "0xK3FFF0000000000000008"},
+		// fp128; 64 least significant bits first.
+		{in: "0xL00000000000000003FFF000000000000", typ: types.FP128, want: "0xL00000000000000003FFF000000000000"},
+		{in: "0xL00000000000000018000000000000000", typ: types.FP128, want: "0xL00000000000000018000000000000000"},
+		{in: "0xL00000000000000017FFF800000000000", typ: types.FP128, want: "0xL00000000000000017FFF800000000000"},
+		{in: "-2.0", typ: types.FP128, want: "0xL0000000000000000C000000000000000"},
+		{in: "0xL1", typ: types.FP128, want: "0xL00000000000000010000000000000000"},
+		{in: "0xL00000000000000003FFF", typ: types.FP128, want: "0xL00000000000000000000000000003FFF"},
+		// ppc_fp128; high-order double first.
+		{in: "0xM3FF00000000000000000000000000000", typ: types.PPC_FP128, want: "0xM3FF00000000000000000000000000000"},
+		{in: "0xM3FF00000000000003C90000000000000", typ: types.PPC_FP128, want: "0xM3FF00000000000003C90000000000000"},
+		// Non-canonical low-order double.
+		{in: "0xM3FF00000000000008000000000000000", typ: types.PPC_FP128, want: "0xM3FF00000000000008000000000000000"},
+		{in: "0xM7FF80000000000010000000000000001", typ: types.PPC_FP128, want: "0xM7FF80000000000010000000000000001"},
+		{in: "1.5", typ: types.PPC_FP128, want: "0xM3FF80000000000000000000000000000"},
+		{in: "0xM3FF", typ: types.PPC_FP128, want: "0xM00000000000003FF0000000000000000"},
+		// bfloat
+		{in: "0xR3F80", typ: types.BFloat, want: "0xR3F80"},
+		{in: "0xRFFC1", typ: types.BFloat, want: "0xRFFC1"},
+		// half
+		{in: "0xH3C00", typ: types.Half, want: "1.0"},
+		{in: "0xH7E01", typ: types.Half, want: "0xH7E01"},
+		{in: "0xHFC00", typ: types.Half, want: "0xHFC00"},
+		// float and double
+		{in: "0xFFF0000000000000", typ: types.Float, want: "0xFFF0000000000000"},
+		{in: "0x7FF4000020000000", typ: types.Float, want: "0x7FF4000020000000"},
+		{in: "0x7FF4000000000001", typ: types.Double, want: "0x7FF4000000000001"},
+		{in: "0x4004000000000000", typ: types.Double, want: "2.5"},
+	}
+	for _, g := range golden {
+		c := constant.NewFloatFromString(g.in, g.typ)
+		if got := c.Ident(); got != g.want {
+			t.Errorf("%v %s: expected %q, got %q", g.typ, g.in, g.want, got)
+		}
+	}
+}
+
+func TestFloatFromStringTooLong(t *testing.T) {
+	golden := []struct {
+		in  string
+		typ types.Type
+	}{
+		{in: "0xK3FFF80000000000000000", typ: types.X86_FP80},
+		{in: "0xL000000000000000000000000000000000", typ: types.FP128},
+		{in: "0xM3FF000000000000000000000000000000", typ: types.PPC_FP128},
+	}
+	for _, g := range golden {
+		func() {
+			defer func() {
+				if e := recover(); e == nil {
+					t.Errorf("%v %s: expected panic, got nil", g.typ, g.in)
+				}
+			}()
+			constant.NewFloatFromString(g.in, g.typ)
+		}()
+	}
+}
+
+func TestFloatIdentDecimal(t *testing.T) {
+	golden := []struct {
+		in   string
+		typ  types.Type
+		want string
+	}{
+		// Exact decimal representation.
+		{in: "1.5", typ: types.Float, want: "1.5"},
+		{in: "0.1", typ: types.Double, want: "0.1"},
+		{in: "3.141592653589793", typ: types.Double, want: "3.141592653589793"},
+		{in: "-0.0", typ: types.Float, want: "-0.0"},
+		{in: "-0.0", typ: types.Double, want: "-0.0"},
+		{in: "0x3FF8000000000000", typ: types.Float, want: "1.5"},
+		{in: "0xH3C00", typ: types.Half, want: "1.0"},
+		// Values are rounded to the precision of the floating-point type.
+		{in: "0.1", typ: types.Float, want: "0.10000000149011612"},
+		{in: "0.1", typ: types.Half, want: "0.0999755859375"},
+		{in: "0xH0001", typ: types.Half, want: "5.960464477539063e-08"},
+		{in: "65472.0", typ: types.Half, want: "65472.0"},
+		// Exponent notation of large and small values.
+		{in: "1e300", typ: types.Double, want: "1.0e300"},
+		{in: "1e-300", typ: types.Double, want: "1.0e-300"},
+		{in: "-2.5e21", typ: types.Double, want: "-2.5e21"},
+		{in: "100000.0", typ: types.Double, want: "100000.0"},
+		// Infinity.
+		{in: "0x7FF0000000000000", typ: types.Float, want: "0x7FF0000000000000"},
+		{in: "0xFFF0000000000000", typ: types.Double, want: "0xFFF0000000000000"},
+		{in: "0xH7C00", typ: types.Half, want: "0xH7C00"},
+		// NaN with sign and payload; signalling NaN.
+		{in: "0x7FF0000020000000", typ: types.Float, want: "0x7FF0000020000000"},
+		{in: "0xFFF4000000000000", typ: types.Float, want: "0xFFF4000000000000"},
+		{in: "0x7FF0000000000001", typ: types.Double, want: "0x7FF0000000000001"},
+		{in: "0xFFF8000000000000", typ: types.Double, want: "0xFFF8000000000000"},
+		{in: "0xH7D01", typ: types.Half, want: "0xH7D01"},
+	}
+	for _, g := range golden {
+		c := constant.NewFloatFromString(g.in, g.typ)
+		if got := c.Ident(); got != g.want {
+			t.Errorf("%v %s: expected %q, got %q", g.typ, g.in, g.want, got)
+		}
+	}
+}
+
+func TestFloatBits(t *testing.T) {
+	nan := constant.NewFloat(math.NaN(), types.Float)
+	if !nan.IsNaN() {
+		t.Errorf("expected NaN float constant")
+	}
+	if got := nan.Bits().Uint64(); got != 0x7FC00000 {
+		t.Errorf("NaN binary representation mismatch; expected 0x7FC00000, got 0x%08X", got)
+	}
+	if got := nan.Float64(); !math.IsNaN(got) {
+		t.Errorf("expected NaN float64 value, got %v", got)
+	}
+	// Values are rounded to nearest when converted to binary representation.
+	c := &constant.Float{Typ: types.Half, X: big.NewFloat(1.0001)}
+	if got := c.Bits().Uint64(); got != 0x3C00 {
+		t.Errorf("half binary representation mismatch; expected 0x3C00, got 0x%04X", got)
+	}
+	bits := new(big.Int).SetUint64(0x3FF0000000000000)
+	if got := constant.NewFloatFromBits(bits, types.Double).Ident(); got != "1.0" {
+		t.Errorf("double from binary representation mismatch; expected %q, got %q", "1.0", got)
+	}
+}
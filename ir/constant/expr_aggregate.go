@@ -0,0 +1,141 @@
+// === [ Aggregate expressions ] ===============================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#aggregate-operations
+
+package constant
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/ir/types"
+)
+
+// --- [ extractvalue ] --------------------------------------------------------
+
+// ExprExtractValue represents an extractvalue expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#extractvalue-instruction
+type ExprExtractValue struct {
+	// Type of the constant expression.
+	Typ types.Type
+	// Aggregate value.
+	X Constant
+	// Element indices.
+	Indices []int64
+}
+
+// NewExtractValue returns a new extractvalue expression based on the given
+// aggregate value and indices.
+func NewExtractValue(x Constant, indices ...int64) *ExprExtractValue {
+	typ, err := types.AggregateElemType(x.Type(), indices)
+	if err != nil {
+		panic(err)
+	}
+	return &ExprExtractValue{
+		Typ:     typ,
+		X:       x,
+		Indices: indices,
+	}
+}
+
+// Type returns the type of the constant expression.
+func (expr *ExprExtractValue) Type() types.Type {
+	return expr.Typ
+}
+
+// Ident returns the string representation of the constant expression.
+func (expr *ExprExtractValue) Ident() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "extractvalue (%s %s",
+		expr.X.Type(),
+		expr.X.Ident())
+	for _, index := range expr.Indices {
+		fmt.Fprintf(buf, ", %d", index)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*ExprExtractValue) Immutable() {}
+
+// Simplify returns a simplified version of the constant expression.
+func (expr *ExprExtractValue) Simplify() Constant {
+	x := simplify(expr.X)
+	if c, ok := foldExtractValue(expr.Typ, x, expr.Indices); ok {
+		return c
+	}
+	if x == expr.X {
+		return expr
+	}
+	e := *expr
+	e.X = x
+	return &e
+}
+
+// --- [ insertvalue ] ---------------------------------------------------------
+
+// ExprInsertValue represents an insertvalue expression.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#insertvalue-instruction
+type ExprInsertValue struct {
+	// Aggregate value.
+	X Constant
+	// Element to insert.
+	Elem Constant
+	// Element indices.
+	Indices []int64
+}
+
+// NewInsertValue returns a new insertvalue expression based on the given
+// aggregate value, element and indices.
+func NewInsertValue(x, elem Constant, indices ...int64) *ExprInsertValue {
+	return &ExprInsertValue{
+		X:       x,
+		Elem:    elem,
+		Indices: indices,
+	}
+}
+
+// Type returns the type of the constant expression.
+func (expr *ExprInsertValue) Type() types.Type {
+	return expr.X.Type()
+}
+
+// Ident returns the string representation of the constant expression.
+func (expr *ExprInsertValue) Ident() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "insertvalue (%s %s, %s %s",
+		expr.X.Type(),
+		expr.X.Ident(),
+		expr.Elem.Type(),
+		expr.Elem.Ident())
+	for _, index := range expr.Indices {
+		fmt.Fprintf(buf, ", %d", index)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*ExprInsertValue) Immutable() {}
+
+// Simplify returns a simplified version of the constant expression.
+func (expr *ExprInsertValue) Simplify() Constant {
+	x, elem := simplify(expr.X), simplify(expr.Elem)
+	if c, ok := foldInsertValue(x, elem, expr.Indices); ok {
+		return c
+	}
+	if x == expr.X && elem == expr.Elem {
+		return expr
+	}
+	e := *expr
+	e.X, e.Elem = x, elem
+	return &e
+}
@@ -8,6 +8,7 @@
 package constant
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/llir/llvm/ir/types"
@@ -22,6 +23,8 @@ import (
 type ExprAdd struct {
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // NewAdd returns a new add expression based on the given operands.
@@ -39,11 +42,17 @@ func (expr *ExprAdd) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprAdd) Ident() string {
-	return fmt.Sprintf("add (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("add")
+	for _, flag := range expr.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -52,7 +61,16 @@ func (*ExprAdd) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprAdd) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("add", x, y, expr.OverflowFlags, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ fadd ] ----------------------------------------------------------------
@@ -94,7 +112,16 @@ func (*ExprFAdd) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFAdd) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("fadd", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ sub ] -----------------------------------------------------------------
@@ -106,6 +133,8 @@ func (expr *ExprFAdd) Simplify() Constant {
 type ExprSub struct {
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // NewSub returns a new sub expression based on the given operands.
@@ -123,11 +152,17 @@ func (expr *ExprSub) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprSub) Ident() string {
-	return fmt.Sprintf("sub (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("sub")
+	for _, flag := range expr.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -136,7 +171,16 @@ func (*ExprSub) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSub) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("sub", x, y, expr.OverflowFlags, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ fsub ] ----------------------------------------------------------------
@@ -178,7 +222,16 @@ func (*ExprFSub) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFSub) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("fsub", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ mul ] -----------------------------------------------------------------
@@ -190,6 +243,8 @@ func (expr *ExprFSub) Simplify() Constant {
 type ExprMul struct {
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // NewMul returns a new mul expression based on the given operands.
@@ -207,11 +262,17 @@ func (expr *ExprMul) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprMul) Ident() string {
-	return fmt.Sprintf("mul (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("mul")
+	for _, flag := range expr.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -220,7 +281,16 @@ func (*ExprMul) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprMul) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("mul", x, y, expr.OverflowFlags, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ fmul ] ----------------------------------------------------------------
@@ -262,7 +332,16 @@ func (*ExprFMul) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFMul) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("fmul", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ udiv ] ----------------------------------------------------------------
@@ -274,6 +353,9 @@ func (expr *ExprFMul) Simplify() Constant {
 type ExprUDiv struct {
 	// Operands.
 	X, Y Constant
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
 }
 
 // NewUDiv returns a new udiv expression based on the given operands.
@@ -291,11 +373,17 @@ func (expr *ExprUDiv) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprUDiv) Ident() string {
-	return fmt.Sprintf("udiv (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("udiv")
+	if expr.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -304,7 +392,16 @@ func (*ExprUDiv) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprUDiv) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("udiv", x, y, nil, expr.Exact); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ sdiv ] ----------------------------------------------------------------
@@ -316,6 +413,9 @@ func (expr *ExprUDiv) Simplify() Constant {
 type ExprSDiv struct {
 	// Operands.
 	X, Y Constant
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
 }
 
 // NewSDiv returns a new sdiv expression based on the given operands.
@@ -333,11 +433,17 @@ func (expr *ExprSDiv) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprSDiv) Ident() string {
-	return fmt.Sprintf("sdiv (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("sdiv")
+	if expr.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -346,7 +452,16 @@ func (*ExprSDiv) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSDiv) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("sdiv", x, y, nil, expr.Exact); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ fdiv ] ----------------------------------------------------------------
@@ -388,7 +503,16 @@ func (*ExprFDiv) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFDiv) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("fdiv", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ urem ] ----------------------------------------------------------------
@@ -430,7 +554,16 @@ func (*ExprURem) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprURem) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("urem", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ srem ] ----------------------------------------------------------------
@@ -472,7 +605,16 @@ func (*ExprSRem) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSRem) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("srem", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ frem ] ----------------------------------------------------------------
@@ -514,5 +656,14 @@ func (*ExprFRem) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFRem) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("frem", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
@@ -8,6 +8,7 @@
 package constant
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/llir/llvm/ir/types"
@@ -22,6 +23,8 @@ import (
 type ExprShl struct {
 	// Operands.
 	X, Y Constant
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
 }
 
 // NewShl returns a new shl expression based on the given operands.
@@ -39,11 +42,17 @@ func (expr *ExprShl) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprShl) Ident() string {
-	return fmt.Sprintf("shl (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("shl")
+	for _, flag := range expr.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -52,7 +61,16 @@ func (*ExprShl) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprShl) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("shl", x, y, expr.OverflowFlags, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ lshr ] ----------------------------------------------------------------
@@ -64,6 +82,9 @@ func (expr *ExprShl) Simplify() Constant {
 type ExprLShr struct {
 	// Operands.
 	X, Y Constant
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
 }
 
 // NewLShr returns a new lshr expression based on the given operands.
@@ -81,11 +102,17 @@ func (expr *ExprLShr) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprLShr) Ident() string {
-	return fmt.Sprintf("lshr (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("lshr")
+	if expr.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -94,7 +121,16 @@ func (*ExprLShr) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprLShr) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("lshr", x, y, nil, expr.Exact); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ ashr ] ----------------------------------------------------------------
@@ -106,6 +142,9 @@ func (expr *ExprLShr) Simplify() Constant {
 type ExprAShr struct {
 	// Operands.
 	X, Y Constant
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
 }
 
 // NewAShr returns a new ashr expression based on the given operands.
@@ -123,11 +162,17 @@ func (expr *ExprAShr) Type() types.Type {
 
 // Ident returns the string representation of the constant expression.
 func (expr *ExprAShr) Ident() string {
-	return fmt.Sprintf("ashr (%s %s, %s %s)",
+	buf := &bytes.Buffer{}
+	buf.WriteString("ashr")
+	if expr.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " (%s %s, %s %s)",
 		expr.X.Type(),
 		expr.X.Ident(),
 		expr.Y.Type(),
 		expr.Y.Ident())
+	return buf.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
@@ -136,7 +181,16 @@ func (*ExprAShr) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprAShr) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("ashr", x, y, nil, expr.Exact); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ and ] -----------------------------------------------------------------
@@ -178,7 +232,16 @@ func (*ExprAnd) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprAnd) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("and", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ or ] ------------------------------------------------------------------
@@ -220,7 +283,16 @@ func (*ExprOr) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprOr) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("or", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // --- [ xor ] -----------------------------------------------------------------
@@ -262,5 +334,14 @@ func (*ExprXor) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprXor) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldBinary("xor", x, y, nil, false); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
@@ -53,7 +53,16 @@ func (*ExprTrunc) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprTrunc) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("trunc", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ zext ] ----------------------------------------------------------------
@@ -96,7 +105,16 @@ func (*ExprZExt) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprZExt) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("zext", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ sext ] ----------------------------------------------------------------
@@ -139,7 +157,16 @@ func (*ExprSExt) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSExt) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("sext", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ fptrunc ] -------------------------------------------------------------
@@ -182,7 +209,16 @@ func (*ExprFPTrunc) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFPTrunc) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("fptrunc", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ fpext ] ---------------------------------------------------------------
@@ -225,7 +261,16 @@ func (*ExprFPExt) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFPExt) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("fpext", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ fptoui ] --------------------------------------------------------------
@@ -268,7 +313,16 @@ func (*ExprFPToUI) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFPToUI) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("fptoui", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ fptosi ] --------------------------------------------------------------
@@ -311,7 +365,16 @@ func (*ExprFPToSI) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFPToSI) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("fptosi", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ uitofp ] --------------------------------------------------------------
@@ -354,7 +417,16 @@ func (*ExprUIToFP) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprUIToFP) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("uitofp", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ sitofp ] --------------------------------------------------------------
@@ -397,7 +469,16 @@ func (*ExprSIToFP) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSIToFP) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("sitofp", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ ptrtoint ] ------------------------------------------------------------
@@ -440,7 +521,16 @@ func (*ExprPtrToInt) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprPtrToInt) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("ptrtoint", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ inttoptr ] ------------------------------------------------------------
@@ -483,7 +573,16 @@ func (*ExprIntToPtr) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprIntToPtr) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("inttoptr", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ bitcast ] -------------------------------------------------------------
@@ -526,7 +625,16 @@ func (*ExprBitCast) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprBitCast) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("bitcast", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
 
 // --- [ addrspacecast ] -------------------------------------------------------
@@ -569,5 +677,14 @@ func (*ExprAddrSpaceCast) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprAddrSpaceCast) Simplify() Constant {
-	panic("not yet implemented")
+	from := simplify(expr.From)
+	if c, ok := foldConversion("addrspacecast", from, expr.To); ok {
+		return c
+	}
+	if from == expr.From {
+		return expr
+	}
+	e := *expr
+	e.From = from
+	return &e
 }
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/llir/llvm/ir/datalayout"
 	"github.com/llir/llvm/ir/types"
 )
 
@@ -46,21 +47,25 @@ func NewGetElementPtr(src Constant, indices ...Constant) *ExprGetElementPtr {
 			// ref: http://llvm.org/docs/GetElementPtr.html#why-is-the-extra-0-index-required
 			continue
 		}
-		switch t := e.(type) {
-		case *types.PointerType:
+		if types.IsPointer(e) {
 			// ref: http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep
 			panic("unable to index into element of pointer type; for more information, see http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep")
-		case *types.ArrayType:
-			e = t.Elem
-		case *types.StructType:
-			idx, ok := index.(*Int)
+		}
+		// Struct fields are indexed by constant integers; array and vector
+		// elements may be indexed by arbitrary integer values.
+		var idx int64
+		if types.IsStruct(e) {
+			x, ok := index.(*Int)
 			if !ok {
 				panic(fmt.Errorf("invalid index type for structure element; expected *constant.Int, got %T", index))
 			}
-			e = t.Fields[idx.Int64()]
-		default:
-			panic(fmt.Errorf("support for indexing element type %T not yet implemented", e))
+			idx = x.Int64()
+		}
+		t, err := types.ElemType(e, idx)
+		if err != nil {
+			panic(err)
 		}
+		e = t
 	}
 	typ := types.NewPointer(e)
 	return &ExprGetElementPtr{
@@ -98,5 +103,70 @@ func (*ExprGetElementPtr) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprGetElementPtr) Simplify() Constant {
-	panic("not yet implemented")
+	src := simplify(expr.Src)
+	if isPoison(src) {
+		return NewPoison(expr.Typ)
+	}
+	changed := src != expr.Src
+	indices := make([]Constant, len(expr.Indices))
+	for i, index := range expr.Indices {
+		indices[i] = simplify(index)
+		if isPoison(indices[i]) {
+			return NewPoison(expr.Typ)
+		}
+		if indices[i] != index {
+			changed = true
+		}
+	}
+	e := expr
+	if changed {
+		e = &ExprGetElementPtr{
+			Typ:     expr.Typ,
+			Elem:    expr.Elem,
+			Src:     src,
+			Indices: indices,
+		}
+	}
+	if c, ok := foldGetElementPtr(e); ok {
+		return c
+	}
+	return e
+}
+
+// Offset returns the offset in bytes, relative to the source address, of the
+// address computed by the getelementptr expression under the given data
+// layout, and the type of the addressed element; e.g. 16 and i32 for
+// `getelementptr ({ i64, i64, i32 }, { i64, i64, i32 }* @g, i64 0, i32 2)`.
+func (expr *ExprGetElementPtr) Offset(dl *datalayout.DataLayout) (int64, types.Type, error) {
+	return GEPOffset(dl, expr.Src.Type(), expr.Elem, expr.Indices)
+}
+
+// GEPOffset returns the offset in bytes, relative to the source address, of the
+// address computed by a getelementptr with the given source address type,
+// source element type and indices under the given data layout, and the type of
+// the addressed element. The offset wraps around to the index size of the
+// address space of the source address. An error is returned if any index is
+// not a scalar integer constant.
+func GEPOffset(dl *datalayout.DataLayout, srcType, elem types.Type, indices []Constant) (int64, types.Type, error) {
+	t, ok := srcType.(*types.PointerType)
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid source address type; expected *types.PointerType, got %T", srcType)
+	}
+	is := make([]int64, len(indices))
+	for i, index := range indices {
+		x, ok := indexValue(simplify(index))
+		if !ok {
+			return 0, nil, fmt.Errorf("unable to compute offset of non-constant getelementptr index `%v %v`", index.Type(), index.Ident())
+		}
+		is[i] = x
+	}
+	offset, e, err := dl.IndexedOffset(elem, is)
+	if err != nil {
+		return 0, nil, err
+	}
+	if size := dl.IndexSize(t.AddrSpace); size < 64 {
+		shift := uint(64 - size)
+		offset = offset << shift >> shift
+	}
+	return offset, e, nil
 }
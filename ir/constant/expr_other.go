@@ -31,7 +31,9 @@ type ExprICmp struct {
 func NewICmp(cond IntPred, x, y Constant) *ExprICmp {
 	var typ types.Type = types.I1
 	if t, ok := x.Type().(*types.VectorType); ok {
-		typ = types.NewVector(types.I1, t.Len)
+		vt := types.NewVector(types.I1, t.Len)
+		vt.Scalable = t.Scalable
+		typ = vt
 	}
 	return &ExprICmp{
 		Typ:  typ,
@@ -62,7 +64,19 @@ func (*ExprICmp) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprICmp) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	cmp := func(x, y Constant) (bool, bool) {
+		return compareInts(expr.Cond, x, y)
+	}
+	if c, ok := foldCmp(expr.Typ, x, y, cmp); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // IntPred represents the set of condition codes of the icmp expression.
@@ -123,7 +137,9 @@ type ExprFCmp struct {
 func NewFCmp(cond FloatPred, x, y Constant) *ExprFCmp {
 	var typ types.Type = types.I1
 	if t, ok := x.Type().(*types.VectorType); ok {
-		typ = types.NewVector(types.I1, t.Len)
+		vt := types.NewVector(types.I1, t.Len)
+		vt.Scalable = t.Scalable
+		typ = vt
 	}
 	return &ExprFCmp{
 		Typ:  typ,
@@ -154,7 +170,19 @@ func (*ExprFCmp) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprFCmp) Simplify() Constant {
-	panic("not yet implemented")
+	x, y := simplify(expr.X), simplify(expr.Y)
+	cmp := func(x, y Constant) (bool, bool) {
+		return compareFloats(expr.Cond, x, y)
+	}
+	if c, ok := foldCmp(expr.Typ, x, y, cmp); ok {
+		return c
+	}
+	if x == expr.X && y == expr.Y {
+		return expr
+	}
+	e := *expr
+	e.X, e.Y = x, y
+	return &e
 }
 
 // FloatPred represents the set of condition codes of the fcmp expression.
@@ -252,5 +280,12 @@ func (*ExprSelect) Immutable() {}
 
 // Simplify returns a simplified version of the constant expression.
 func (expr *ExprSelect) Simplify() Constant {
-	panic("not yet implemented")
+	cond, x, y := simplify(expr.Cond), simplify(expr.X), simplify(expr.Y)
+	if c, ok := foldSelect(cond, x, y); ok {
+		return c
+	}
+	if cond == expr.Cond && x == expr.X && y == expr.Y {
+		return expr
+	}
+	return NewSelect(cond, x, y)
 }
@@ -0,0 +1,28 @@
+package constant
+
+import "fmt"
+
+// OverflowFlag represents the set of overflow flags of add, sub, mul and shl
+// expressions.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#add-instruction
+type OverflowFlag int
+
+// Overflow flags.
+const (
+	OverflowNUW OverflowFlag = iota + 1 // nuw: no unsigned wrap
+	OverflowNSW                         // nsw: no signed wrap
+)
+
+// String returns the LLVM syntax representation of the overflow flag.
+func (flag OverflowFlag) String() string {
+	m := map[OverflowFlag]string{
+		OverflowNUW: "nuw",
+		OverflowNSW: "nsw",
+	}
+	if s, ok := m[flag]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown overflow flag %d>", int(flag))
+}
@@ -7,10 +7,12 @@ package constant
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 	"strings"
 
-	"github.com/llir/llvm/internal/floats"
+	"github.com/llir/llvm/floats"
 	"github.com/llir/llvm/ir/types"
 )
 
@@ -20,8 +22,17 @@ import (
 type Float struct {
 	// Floating-point type.
 	Typ *types.FloatType
-	// Floating-point value.
+	// Floating-point value; zero if NaN.
 	X *big.Float
+	// Binary representation of values that cannot be recovered from X alone;
+	// i.e. NaN values (with sign and payload), and values with a non-canonical
+	// encoding (e.g. ppc_fp128 with a non-canonical low-order double). Raw is
+	// nil for all other values.
+	//
+	// The binary representation is that of a bitcast to an integer type of the
+	// same bit width; for ppc_fp128 the high-order double is stored in the 64
+	// least significant bits.
+	Raw *big.Int
 }
 
 // NewFloat returns a new floating-point constant based on the given
@@ -31,9 +42,35 @@ func NewFloat(x float64, typ types.Type) *Float {
 	if !ok {
 		panic(fmt.Errorf("invalid floating-point constant type; expected *types.FloatType, got %T", typ))
 	}
+	if math.IsNaN(x) {
+		return NewFloatFromBits(nanFromFloat64(math.Float64bits(x), t.Kind), t)
+	}
 	return &Float{Typ: t, X: big.NewFloat(x)}
 }
 
+// NewFloatFromBits returns a new floating-point constant based on the given
+// binary representation and type. The binary representation is that of a
+// bitcast to an integer type of the same bit width (see Float.Raw).
+func NewFloatFromBits(bits *big.Int, typ types.Type) *Float {
+	t, ok := typ.(*types.FloatType)
+	if !ok {
+		panic(fmt.Errorf("invalid floating-point constant type; expected *types.FloatType, got %T", typ))
+	}
+	c := &Float{Typ: t}
+	x, nan := floatValue(bits, t.Kind)
+	if nan {
+		c.X = &big.Float{}
+		c.Raw = new(big.Int).Set(bits)
+		return c
+	}
+	c.X = x
+	// Preserve non-canonical encodings.
+	if floatBits(x, t.Kind).Cmp(bits) != 0 {
+		c.Raw = new(big.Int).Set(bits)
+	}
+	return c
+}
+
 // NewFloatFromString returns a new floating-point constant based on the given
 // floating-point string and type.
 func NewFloatFromString(s string, typ types.Type) *Float {
@@ -42,63 +79,61 @@ func NewFloatFromString(s string, typ types.Type) *Float {
 	if !ok {
 		panic(fmt.Errorf("invalid floating-point constant type; expected *types.FloatType, got %T", typ))
 	}
-	c := &Float{Typ: t}
 
 	// Parse floating-point literal in hexadecimal format.
 	switch {
 	case strings.HasPrefix(s, "0xK"):
 		//   HexFP80Constant   0xK[0-9A-Fa-f]+    // 20 hex digits
-
-		// TODO: Implement support for the 0xK floating-point representation format.
-		c.X = &big.Float{}
-		c.X.SetString("0.0") // TODO: Remove placehold zero value.
-		//panic(fmt.Errorf("support for floating-point constant 0xK representation not yet implemented; unable to parse floating-point constant %q", s))
-		s = s[len("0xK"):]
-		return c
+		//
+		// Sign and exponent, followed by integer part and fraction.
+		groups := hexGroups(s, len("0xK"), 4, 16)
+		return NewFloatFromBits(bigUint128(groups[0], groups[1]), t)
 	case strings.HasPrefix(s, "0xL"):
 		//   HexFP128Constant  0xL[0-9A-Fa-f]+    // 32 hex digits
-
-		// TODO: Implement support for the 0xL floating-point representation format.
-		c.X = &big.Float{}
-		c.X.SetString("0.0") // TODO: Remove placehold zero value.
-		//panic(fmt.Errorf("support for floating-point constant 0xL representation not yet implemented; unable to parse floating-point constant %q", s))
-		s = s[len("0xL"):]
-		return c
+		//
+		// The 64 least significant bits precede the 64 most significant bits.
+		groups := hexGroups(s, len("0xL"), 16, 16)
+		return NewFloatFromBits(bigUint128(groups[1], groups[0]), t)
 	case strings.HasPrefix(s, "0xM"):
 		//   HexPPC128Constant 0xM[0-9A-Fa-f]+    // 32 hex digits
-
-		// TODO: Implement support for the 0xM floating-point representation format.
-		c.X = &big.Float{}
-		c.X.SetString("0.0") // TODO: Remove placehold zero value.
-		//panic(fmt.Errorf("support for floating-point constant 0xM representation not yet implemented; unable to parse floating-point constant %q", s))
-		s = s[len("0xM"):]
-		return c
+		//
+		// High-order double, followed by low-order double.
+		groups := hexGroups(s, len("0xM"), 16, 16)
+		return NewFloatFromBits(bigUint128(groups[1], groups[0]), t)
 	case strings.HasPrefix(s, "0xH"):
 		//   HexHalfConstant   0xH[0-9A-Fa-f]+    // 4 hex digits
-
-		str := s[len("0xH"):]
-		x := floats.NewFloat16FromString(str)
-		c.X = big.NewFloat(x.Float64())
-		return c
+		x := floats.NewFloat16FromString(s[len("0xH"):])
+		return NewFloatFromBits(new(big.Int).SetUint64(uint64(x.Bits())), t)
+	case strings.HasPrefix(s, "0xR"):
+		//   HexBFloatConstant 0xR[0-9A-Fa-f]+    // 4 hex digits
+		x := floats.NewBFloat16FromString(s[len("0xR"):])
+		return NewFloatFromBits(new(big.Int).SetUint64(uint64(x.Bits())), t)
 	case strings.HasPrefix(s, "0x"):
 		//   HexFPConstant     0x[0-9A-Fa-f]+     // 16 hex digits
-
-		// TODO: Implement support for the 0x floating-point representation format.
-		c.X = &big.Float{}
-		c.X.SetString("0.0") // TODO: Remove placehold zero value.
-		//panic(fmt.Errorf("support for floating-point constant 0x representation not yet implemented; unable to parse floating-point constant %q", s))
-		s = s[len("0x"):]
-		return c
+		//
+		// Binary representation of a double, which is converted to the
+		// floating-point type of the constant.
+		bits, ok := new(big.Int).SetString(s[len("0x"):], 16)
+		if !ok || bits.BitLen() > 64 {
+			panic(fmt.Errorf("unable to parse floating-point constant %q", s))
+		}
+		if t.Kind == types.FloatKindIEEE_64 {
+			return NewFloatFromBits(bits, t)
+		}
+		return NewFloat(math.Float64frombits(bits.Uint64()), t)
 	}
 
 	// Parse floating-point literal.
 	//
 	//   FPConstant        [-+]?[0-9]+[.][0-9]*([eE][-+]?[0-9]+)?
-	c.X = &big.Float{}
-	if _, ok := c.X.SetString(s); !ok {
-		panic(fmt.Errorf("unable to parse floating-point constant %q", s))
+	//
+	// The value is rounded to the nearest value of the floating-point type, ties to
+	// even.
+	x, _, err := floats.ParseFloat(floatKind(t.Kind), s, big.ToNearestEven)
+	if err != nil {
+		panic(fmt.Errorf("unable to parse floating-point constant %q; %v", s, err))
 	}
-	return c
+	return NewFloatFromBits(x.Bits(), t)
 }
 
 // Type returns the type of the constant.
@@ -114,51 +149,66 @@ func (c *Float) Ident() string {
 	switch kind {
 	case types.FloatKindIEEE_128:
 		// The IEEE 128-bit format is represented by 0xL followed by 32
-		// hexadecimal digits.
-
-		// TODO: Implement.
-		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+		// hexadecimal digits; the 64 least significant bits followed by the 64
+		// most significant bits.
+		a, b := splitUint128(c.Bits())
+		return fmt.Sprintf("0xL%016X%016X", b, a)
 	case types.FloatKindDoubleExtended_80:
 		// The 80-bit format used by x86 is represented as 0xK followed by 20
 		// hexadecimal digits.
-
-		// TODO: Implement.
-		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+		return fmt.Sprintf("0xK%020X", c.Bits())
 	case types.FloatKindDoubleDouble_128:
 		// The 128-bit format used by PowerPC (two adjacent doubles) is
-		// represented by 0xM followed by 32 hexadecimal digits.
-
-		// TODO: Implement.
-		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+		// represented by 0xM followed by 32 hexadecimal digits; the high-order
+		// double followed by the low-order double.
+		lo, hi := splitUint128(c.Bits())
+		return fmt.Sprintf("0xM%016X%016X", hi, lo)
+	case types.FloatKindBrain_16:
+		// The bfloat 16-bit format is represented by 0xR followed by 4
+		// hexadecimal digits.
+		return fmt.Sprintf("0xR%04X", c.Bits())
 	}
 
-	// TODO: Handle NaN, special values (e.g. Pi). Print those in hexadecimal
-	// representation.
-
-	// Use hexadecimal representation for +Inf and -Inf.
-	printHex := c.X.IsInf()
-	if printHex {
-		switch kind {
-		case types.FloatKindIEEE_16:
-			// TODO: Implement.
-			x, _ := c.X.Float64()
-			f16, _ := floats.NewFloat16FromFloat64(x)
-			return "0xH" + f16.String()
-		case types.FloatKindIEEE_32:
-			// TODO: Implement.
-			panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
-		case types.FloatKindIEEE_64:
-			// TODO: Implement.
-			panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
-		default:
-			panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	// Use decimal representation if it represents the value exactly when
+	// parsed as a double, as is done by LLVM. This holds for every finite
+	// value; use hexadecimal representation for NaN, +Inf and -Inf.
+	if !c.IsNaN() && !c.X.IsInf() {
+		return c.decimal()
+	}
+	bits := c.Bits()
+	switch kind {
+	case types.FloatKindIEEE_16:
+		return fmt.Sprintf("0xH%04X", bits)
+	case types.FloatKindIEEE_32:
+		// Single precision values are represented by the binary representation
+		// of the equivalent double.
+		if c.IsNaN() {
+			return fmt.Sprintf("0x%016X", nanToFloat64(bits, kind))
 		}
+		x := float64(math.Float32frombits(uint32(bits.Uint64())))
+		return fmt.Sprintf("0x%016X", math.Float64bits(x))
+	case types.FloatKindIEEE_64:
+		return fmt.Sprintf("0x%016X", bits)
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
 	}
+}
+
+// decimal returns the decimal representation of the finite half, float or
+// double constant.
+func (c *Float) decimal() string {
+	// Use the shortest decimal representation which uniquely identifies the
+	// value as a double, which is exact as every half and float value is
+	// representable as a double. Note, the value of X is rounded to the
+	// precision of the floating-point type (e.g. 0.1 in single precision is
+	// represented by 0.10000000149011612).
+	x, _ := floatValue(c.Bits(), c.Typ.Kind)
+	f, _ := x.Float64()
+	s := strconv.FormatFloat(f, 'g', -1, 64)
 
 	// Insert decimal point if not present.
 	//    3e4 -> 3.0e4
 	//    42  -> 42.0
-	s := c.X.Text('f', -1)
 	if !strings.ContainsRune(s, '.') {
 		if pos := strings.IndexByte(s, 'e'); pos != -1 {
 			s = s[:pos] + ".0" + s[pos:]
@@ -177,7 +227,199 @@ func (c *Float) Ident() string {
 func (*Float) Immutable() {}
 
 // Float64 returns the float64 representation of the floating-point constant.
+// The sign and the most significant bits of the payload of NaN values are
+// preserved.
 func (c *Float) Float64() float64 {
+	if c.IsNaN() {
+		return math.Float64frombits(nanToFloat64(c.Raw, c.Typ.Kind))
+	}
 	x, _ := c.X.Float64()
 	return x
 }
+
+// IsNaN reports whether the floating-point constant is Not-a-Number.
+func (c *Float) IsNaN() bool {
+	if c.Raw == nil {
+		return false
+	}
+	_, nan := floatValue(c.Raw, c.Typ.Kind)
+	return nan
+}
+
+// Bits returns the binary representation of the floating-point constant, as
+// that of a bitcast to an integer type of the same bit width (see Float.Raw).
+// Values of X not representable by the floating-point type are rounded to
+// nearest, ties to even.
+func (c *Float) Bits() *big.Int {
+	if c.Raw != nil {
+		return new(big.Int).Set(c.Raw)
+	}
+	return floatBits(c.X, c.Typ.Kind)
+}
+
+// ### [ Helper functions ] ####################################################
+
+// floatValue returns the exact value of the given binary representation of a
+// floating-point value of the specified kind, and a bool indicating whether
+// the binary representation is NaN.
+func floatValue(bits *big.Int, kind types.FloatKind) (x *big.Float, nan bool) {
+	f := floats.NewFromBits(floatKind(kind), bits)
+	if f.IsNaN() {
+		return nil, true
+	}
+	return f.BigFloat(), false
+}
+
+// floatBits returns the binary representation of the floating-point value of
+// the specified kind nearest to x, rounding ties to even.
+func floatBits(x *big.Float, kind types.FloatKind) *big.Int {
+	f, _ := floats.NewFromBigFloat(floatKind(kind), x, big.ToNearestEven)
+	return f.Bits()
+}
+
+// floatKind returns the floating-point kind of the floats package
+// corresponding to the given floating-point kind.
+func floatKind(kind types.FloatKind) floats.Kind {
+	switch kind {
+	case types.FloatKindIEEE_16:
+		return floats.KindFloat16
+	case types.FloatKindBrain_16:
+		return floats.KindBFloat16
+	case types.FloatKindIEEE_32:
+		return floats.KindFloat32
+	case types.FloatKindIEEE_64:
+		return floats.KindFloat64
+	case types.FloatKindIEEE_128:
+		return floats.KindFloat128
+	case types.FloatKindDoubleExtended_80:
+		return floats.KindFloat80
+	case types.FloatKindDoubleDouble_128:
+		return floats.KindDoubleDouble
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+}
+
+// softFloat returns the software floating-point representation of the
+// floating-point constant.
+func (c *Float) softFloat() floats.Float {
+	return floats.NewFromBits(floatKind(c.Typ.Kind), c.Bits())
+}
+
+// nanFromFloat64 returns the binary representation of a NaN value of the
+// specified floating-point kind, with the sign and the most significant bits of
+// the payload of the given binary representation of a NaN double.
+func nanFromFloat64(x uint64, kind types.FloatKind) *big.Int {
+	sign := x >> 63
+	payload := x & (1<<52 - 1)
+	// NaN values require a non-zero fraction; use the quiet bit if the
+	// truncated payload is zero.
+	trunc := func(frac, quiet uint64) uint64 {
+		if frac == 0 {
+			return quiet
+		}
+		return frac
+	}
+	var bits uint64
+	switch kind {
+	case types.FloatKindIEEE_16:
+		bits = sign<<15 | 0x7C00 | trunc(payload>>42, 0x200)
+	case types.FloatKindBrain_16:
+		bits = sign<<15 | 0x7F80 | trunc(payload>>45, 0x40)
+	case types.FloatKindIEEE_32:
+		bits = sign<<31 | 0x7F800000 | trunc(payload>>29, 0x400000)
+	case types.FloatKindIEEE_64, types.FloatKindDoubleDouble_128:
+		// The low-order double of ppc_fp128 is zero.
+		bits = x
+	case types.FloatKindIEEE_128:
+		return bigUint128(sign<<63|0x7FFF<<48|payload>>4, payload<<60)
+	case types.FloatKindDoubleExtended_80:
+		// Integer part is set.
+		return bigUint128(sign<<15|0x7FFF, 1<<63|payload<<11)
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+	return new(big.Int).SetUint64(bits)
+}
+
+// nanToFloat64 returns the binary representation of a NaN double, with the
+// sign and the most significant bits of the payload of the given binary
+// representation of a NaN value of the specified floating-point kind.
+func nanToFloat64(bits *big.Int, kind types.FloatKind) uint64 {
+	var sign, payload uint64
+	switch kind {
+	case types.FloatKindIEEE_16:
+		x := bits.Uint64()
+		sign, payload = x>>15, (x&0x3FF)<<42
+	case types.FloatKindBrain_16:
+		x := bits.Uint64()
+		sign, payload = x>>15, (x&0x7F)<<45
+	case types.FloatKindIEEE_32:
+		x := bits.Uint64()
+		sign, payload = x>>31, (x&0x7FFFFF)<<29
+	case types.FloatKindIEEE_64:
+		return bits.Uint64()
+	case types.FloatKindIEEE_128:
+		a, b := splitUint128(bits)
+		sign, payload = a>>63, (a&(1<<48-1))<<4|b>>60
+	case types.FloatKindDoubleExtended_80:
+		se, m := splitUint128(bits)
+		sign, payload = se>>15&1, (m&(1<<63-1))>>11
+	case types.FloatKindDoubleDouble_128:
+		// High-order double.
+		_, hi := splitUint128(bits)
+		return hi
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+	if payload == 0 {
+		// Use the quiet bit if the truncated payload is zero.
+		payload = 1 << 51
+	}
+	return sign<<63 | 0x7FF<<52 | payload
+}
+
+// hexGroups splits the hexadecimal digits following the prefix of the given
+// floating-point literal into consecutive groups of at most the given number of
+// digits, and returns the value of each group. As in LLVM, digits are assigned
+// to groups from left to right, and groups for which no digits remain are zero;
+// e.g. 0xL1 has the groups 1 and 0.
+//
+// hexGroups panics if more digits are given than fit in the groups.
+func hexGroups(s string, prefixLen int, sizes ...int) []uint64 {
+	digits := s[prefixLen:]
+	groups := make([]uint64, len(sizes))
+	for i, size := range sizes {
+		n := size
+		if len(digits) < n {
+			n = len(digits)
+		}
+		if n > 0 {
+			x, err := strconv.ParseUint(digits[:n], 16, 64)
+			if err != nil {
+				panic(fmt.Errorf("unable to parse floating-point constant %q; %v", s, err))
+			}
+			groups[i] = x
+		}
+		digits = digits[n:]
+	}
+	if len(digits) > 0 {
+		panic(fmt.Errorf("unable to parse floating-point constant %q; too many hexadecimal digits", s))
+	}
+	return groups
+}
+
+// bigUint128 returns the 128-bit integer with the given most and least
+// significant 64 bits.
+func bigUint128(a, b uint64) *big.Int {
+	x := new(big.Int).SetUint64(a)
+	x.Lsh(x, 64)
+	return x.Or(x, new(big.Int).SetUint64(b))
+}
+
+// splitUint128 returns the most and least significant 64 bits of the given
+// 128-bit integer.
+func splitUint128(x *big.Int) (a, b uint64) {
+	lo := new(big.Int).And(x, new(big.Int).SetUint64(math.MaxUint64))
+	return new(big.Int).Rsh(x, 64).Uint64(), lo.Uint64()
+}
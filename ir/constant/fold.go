@@ -0,0 +1,941 @@
+// === [ Constant folding ] ====================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#constant-expressions
+//    http://llvm.org/docs/LangRef.html#poison-values
+
+package constant
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/llir/llvm/floats"
+	"github.com/llir/llvm/ir/types"
+)
+
+// simplify returns a simplified version of the given constant if it is a
+// constant expression, and the constant itself otherwise.
+func simplify(c Constant) Constant {
+	if expr, ok := c.(Expr); ok {
+		return expr.Simplify()
+	}
+	return c
+}
+
+// --- [ Binary and bitwise operations ] ---------------------------------------
+
+// foldBinary folds the given binary or bitwise operation of simplified operands.
+// Vector operations are folded element-wise. The boolean return value reports
+// whether the operation was folded.
+func foldBinary(op string, x, y Constant, overflowFlags []OverflowFlag, exact bool) (Constant, bool) {
+	if c, ok := simplifyUndefBinary(op, x, y); ok {
+		return c, true
+	}
+	if xs, ok := vectorElems(x); ok {
+		ys, ok := vectorElems(y)
+		if !ok {
+			return nil, false
+		}
+		elems := make([]Constant, len(xs))
+		for i := range xs {
+			elem, ok := foldBinary(op, xs[i], ys[i], overflowFlags, exact)
+			if !ok {
+				return nil, false
+			}
+			elems[i] = elem
+		}
+		return NewVector(elems...), true
+	}
+	if x, ok := intOperand(x); ok {
+		y, ok := intOperand(y)
+		if !ok {
+			return nil, false
+		}
+		var nuw, nsw bool
+		for _, flag := range overflowFlags {
+			switch flag {
+			case OverflowNUW:
+				nuw = true
+			case OverflowNSW:
+				nsw = true
+			}
+		}
+		return foldIntBinary(op, x, y, nuw, nsw, exact), true
+	}
+	if x, ok := floatOperand(x); ok {
+		y, ok := floatOperand(y)
+		if !ok {
+			return nil, false
+		}
+		return foldFloatBinary(op, x, y), true
+	}
+	return nil, false
+}
+
+// foldIntBinary folds the given binary or bitwise operation of integer
+// operands. The result is a poison value if the operation overflows according
+// to the nuw and nsw overflow flags, if the exact flag is violated, on division
+// by zero, on signed division overflow, or if the shift amount is not less than
+// the bit width.
+func foldIntBinary(op string, x, y *Int, nuw, nsw, exact bool) Constant {
+	typ := x.Typ
+	size := typ.Size
+	ux, uy := x.BigUint(), y.BigUint()
+	sx, sy := sintValue(x), sintValue(y)
+	z := new(big.Int)
+	switch op {
+	case "add":
+		if nuw && !fitsUint(z.Add(ux, uy), size) || nsw && !fitsInt(z.Add(sx, sy), size) {
+			return NewPoison(typ)
+		}
+		z.Add(sx, sy)
+	case "sub":
+		if nuw && !fitsUint(z.Sub(ux, uy), size) || nsw && !fitsInt(z.Sub(sx, sy), size) {
+			return NewPoison(typ)
+		}
+		z.Sub(sx, sy)
+	case "mul":
+		if nuw && !fitsUint(z.Mul(ux, uy), size) || nsw && !fitsInt(z.Mul(sx, sy), size) {
+			return NewPoison(typ)
+		}
+		z.Mul(sx, sy)
+	case "udiv", "urem":
+		if uy.Sign() == 0 {
+			return NewPoison(typ)
+		}
+		q, r := z.QuoRem(ux, uy, new(big.Int))
+		if exact && r.Sign() != 0 {
+			return NewPoison(typ)
+		}
+		if op == "urem" {
+			z = r
+		} else {
+			z = q
+		}
+	case "sdiv", "srem":
+		if sy.Sign() == 0 {
+			return NewPoison(typ)
+		}
+		// QuoRem implements truncated division, as is used by LLVM.
+		q, r := z.QuoRem(sx, sy, new(big.Int))
+		if !fitsInt(q, size) || exact && r.Sign() != 0 {
+			// Signed division overflow; e.g. -128 / -1 for i8.
+			return NewPoison(typ)
+		}
+		if op == "srem" {
+			z = r
+		} else {
+			z = q
+		}
+	case "shl", "lshr", "ashr":
+		if uy.Cmp(big.NewInt(int64(size))) >= 0 {
+			return NewPoison(typ)
+		}
+		n := uint(uy.Uint64())
+		switch op {
+		case "shl":
+			if nuw && !fitsUint(z.Lsh(ux, n), size) || nsw && !fitsInt(z.Lsh(sx, n), size) {
+				return NewPoison(typ)
+			}
+			z.Lsh(ux, n)
+		case "lshr":
+			z.Rsh(ux, n)
+		case "ashr":
+			z.Rsh(sx, n)
+		}
+		// Any bits shifted out violate the exact flag.
+		if exact && new(big.Int).And(ux, lowBits(n)).Sign() != 0 {
+			return NewPoison(typ)
+		}
+	case "and":
+		z.And(ux, uy)
+	case "or":
+		z.Or(ux, uy)
+	case "xor":
+		z.Xor(ux, uy)
+	default:
+		panic(fmt.Errorf("support for integer operation %q not yet implemented", op))
+	}
+	return NewIntFromBigInt(z, typ)
+}
+
+// foldFloatBinary folds the given binary operation of floating-point operands,
+// rounding the result to nearest, ties to even. NaN operands are propagated as
+// quiet NaN, and invalid operations (e.g. inf - inf or 0 / 0) yield the default
+// quiet NaN.
+func foldFloatBinary(op string, x, y *Float) Constant {
+	a, b := x.softFloat(), y.softFloat()
+	var z floats.Float
+	switch op {
+	case "fadd":
+		z, _ = a.Add(b, big.ToNearestEven)
+	case "fsub":
+		z, _ = a.Sub(b, big.ToNearestEven)
+	case "fmul":
+		z, _ = a.Mul(b, big.ToNearestEven)
+	case "fdiv":
+		z, _ = a.Div(b, big.ToNearestEven)
+	case "frem":
+		z, _ = a.Rem(b)
+	default:
+		panic(fmt.Errorf("support for floating-point operation %q not yet implemented", op))
+	}
+	return NewFloatFromBits(z.Bits(), x.Typ)
+}
+
+// --- [ Conversion operations ] -----------------------------------------------
+
+// foldConversion folds the given conversion operation of a simplified operand.
+// Vector conversions are folded element-wise. The boolean return value reports
+// whether the operation was folded.
+func foldConversion(op string, from Constant, to types.Type) (Constant, bool) {
+	if c, ok := simplifyUndefConversion(op, from, to); ok {
+		return c, true
+	}
+	switch op {
+	case "bitcast":
+		return foldBitCast(from, to)
+	case "addrspacecast":
+		// The null pointer of one address space is not necessarily represented
+		// by the null pointer of another; leave unsimplified.
+		return nil, false
+	}
+	// Null values convert to null values; e.g. inttoptr (i64 0 to i8*) -> null.
+	if isNullValue(from) {
+		return zeroValue(to), true
+	}
+	if elems, ok := vectorElems(from); ok {
+		t, ok := to.(*types.VectorType)
+		if !ok {
+			return nil, false
+		}
+		return foldElems(elems, func(elem Constant) (Constant, bool) {
+			return foldConversion(op, elem, t.Elem)
+		})
+	}
+	switch from := from.(type) {
+	case *Int:
+		switch op {
+		case "trunc", "zext":
+			return NewIntFromBigInt(from.BigUint(), to), true
+		case "sext":
+			return NewIntFromBigInt(sintValue(from), to), true
+		case "uitofp", "sitofp":
+			t := to.(*types.FloatType)
+			x := from.BigUint()
+			if op == "sitofp" {
+				x = sintValue(from)
+			}
+			z, _ := floats.NewFromInt(floatKind(t.Kind), x, big.ToNearestEven)
+			return NewFloatFromBits(z.Bits(), t), true
+		}
+	case *Float:
+		switch op {
+		case "fptrunc", "fpext":
+			t := to.(*types.FloatType)
+			z, _ := from.softFloat().Convert(floatKind(t.Kind), big.ToNearestEven)
+			return NewFloatFromBits(z.Bits(), t), true
+		case "fptoui", "fptosi":
+			t := to.(*types.IntType)
+			// NaN, infinity and values out of range of the integer type yield
+			// poison.
+			z, status := from.softFloat().Int(big.ToZero)
+			if status&floats.Invalid != 0 {
+				return NewPoison(t), true
+			}
+			if op == "fptoui" && !fitsUint(z, t.Size) || op == "fptosi" && !fitsInt(z, t.Size) {
+				return NewPoison(t), true
+			}
+			return NewIntFromBigInt(z, t), true
+		}
+	}
+	return nil, false
+}
+
+// foldBitCast folds the bitcast of a simplified operand to the given type. The
+// boolean return value reports whether the operation was folded.
+func foldBitCast(from Constant, to types.Type) (Constant, bool) {
+	if from.Type().Equal(to) {
+		return from, true
+	}
+	// bitcast (bitcast X to T1) to T2 -> bitcast X to T2
+	if inner, ok := from.(*ExprBitCast); ok {
+		return NewBitCast(inner.From, to).Simplify(), true
+	}
+	if isNullValue(from) && !types.IsMMX(to) {
+		return zeroValue(to), true
+	}
+	if elems, ok := vectorElems(from); ok {
+		t, ok := to.(*types.VectorType)
+		if !ok || t.Len != int64(len(elems)) {
+			// Changing the number of vector elements depends on the
+			// endianness of the target; leave unsimplified.
+			return nil, false
+		}
+		return foldElems(elems, func(elem Constant) (Constant, bool) {
+			return foldBitCast(elem, t.Elem)
+		})
+	}
+	// Reinterpret the bits of integer and floating-point values of equal bit
+	// width.
+	switch from := from.(type) {
+	case *Int:
+		if t, ok := to.(*types.FloatType); ok && floatKind(t.Kind).Size() == from.Typ.Size {
+			return NewFloatFromBits(from.BigUint(), t), true
+		}
+	case *Float:
+		if t, ok := to.(*types.IntType); ok && floatKind(from.Typ.Kind).Size() == t.Size {
+			return NewIntFromBigInt(from.Bits(), t), true
+		}
+	}
+	return nil, false
+}
+
+// --- [ Comparison and select operations ] ------------------------------------
+
+// foldCmp folds the comparison of simplified operands using the given
+// comparison function, which reports the result of the comparison and whether
+// the operands could be compared. Vector comparisons are folded element-wise.
+// The boolean return value reports whether the operation was folded.
+func foldCmp(typ types.Type, x, y Constant, cmp func(x, y Constant) (result, ok bool)) (Constant, bool) {
+	if c, ok := simplifyUndefCmp(typ, x, y); ok {
+		return c, true
+	}
+	if xs, ok := vectorElems(x); ok {
+		ys, ok := vectorElems(y)
+		if !ok {
+			return nil, false
+		}
+		elems := make([]Constant, len(xs))
+		for i := range xs {
+			elem, ok := foldCmp(types.I1, xs[i], ys[i], cmp)
+			if !ok {
+				return nil, false
+			}
+			elems[i] = elem
+		}
+		return NewVector(elems...), true
+	}
+	result, ok := cmp(x, y)
+	if !ok {
+		return nil, false
+	}
+	if result {
+		return True, true
+	}
+	return False, true
+}
+
+// compareInts compares the given integer or pointer operands using the
+// specified integer condition code. The boolean return value reports whether
+// the operands could be compared.
+func compareInts(cond IntPred, x, y Constant) (result, ok bool) {
+	xi, xok := intOperand(x)
+	yi, yok := intOperand(y)
+	if !xok || !yok {
+		// Pointers are only known to be equal if both are null, or if both
+		// refer to the same global variable or function.
+		if cond != IntEQ && cond != IntNE {
+			return false, false
+		}
+		_, xExpr := x.(Expr)
+		_, yExpr := y.(Expr)
+		switch {
+		case isNullValue(x) && isNullValue(y), x == y && !xExpr && !yExpr:
+			return cond == IntEQ, true
+		}
+		return false, false
+	}
+	var c int
+	switch cond {
+	case IntEQ, IntNE, IntUGT, IntUGE, IntULT, IntULE:
+		c = xi.BigUint().Cmp(yi.BigUint())
+	case IntSGT, IntSGE, IntSLT, IntSLE:
+		c = sintValue(xi).Cmp(sintValue(yi))
+	default:
+		panic(fmt.Errorf("support for integer condition code %v not yet implemented", cond))
+	}
+	switch cond {
+	case IntEQ:
+		return c == 0, true
+	case IntNE:
+		return c != 0, true
+	case IntUGT, IntSGT:
+		return c > 0, true
+	case IntUGE, IntSGE:
+		return c >= 0, true
+	case IntULT, IntSLT:
+		return c < 0, true
+	default: // IntULE, IntSLE
+		return c <= 0, true
+	}
+}
+
+// compareFloats compares the given floating-point operands using the specified
+// floating-point condition code. The boolean return value reports whether the
+// operands could be compared.
+func compareFloats(cond FloatPred, x, y Constant) (result, ok bool) {
+	switch cond {
+	case FloatFalse:
+		return false, true
+	case FloatTrue:
+		return true, true
+	}
+	xf, xok := floatOperand(x)
+	yf, yok := floatOperand(y)
+	if !xok || !yok {
+		return false, false
+	}
+	ord := xf.softFloat().Compare(yf.softFloat())
+	if ord == floats.Unordered {
+		switch cond {
+		case FloatUEQ, FloatUGT, FloatUGE, FloatULT, FloatULE, FloatUNE, FloatUNO:
+			return true, true
+		}
+		return false, true
+	}
+	switch cond {
+	case FloatOEQ, FloatUEQ:
+		return ord == floats.Equal, true
+	case FloatOGT, FloatUGT:
+		return ord == floats.Greater, true
+	case FloatOGE, FloatUGE:
+		return ord != floats.Less, true
+	case FloatOLT, FloatULT:
+		return ord == floats.Less, true
+	case FloatOLE, FloatULE:
+		return ord != floats.Greater, true
+	case FloatONE, FloatUNE:
+		return ord != floats.Equal, true
+	case FloatORD:
+		return true, true
+	case FloatUNO:
+		return false, true
+	default:
+		panic(fmt.Errorf("support for floating-point condition code %v not yet implemented", cond))
+	}
+}
+
+// foldSelect folds the select operation of a simplified condition and
+// operands. Vector conditions select element-wise. The boolean return value
+// reports whether the operation was folded.
+func foldSelect(cond, x, y Constant) (Constant, bool) {
+	if c, ok := simplifyUndefSelect(cond, x, y); ok {
+		return c, true
+	}
+	if c, ok := intOperand(cond); ok {
+		if c.X.Sign() != 0 {
+			return x, true
+		}
+		return y, true
+	}
+	conds, ok := vectorElems(cond)
+	if !ok {
+		return nil, false
+	}
+	xs, xok := vectorElems(x)
+	ys, yok := vectorElems(y)
+	if !xok || !yok {
+		return nil, false
+	}
+	elems := make([]Constant, len(conds))
+	for i := range conds {
+		elem, ok := foldSelect(conds[i], xs[i], ys[i])
+		if !ok {
+			return nil, false
+		}
+		elems[i] = elem
+	}
+	return NewVector(elems...), true
+}
+
+// --- [ Vector and aggregate operations ] -------------------------------------
+
+// foldExtractElement folds the extractelement operation of a simplified vector
+// and index, where typ is the element type of the vector. The boolean return
+// value reports whether the operation was folded.
+func foldExtractElement(typ types.Type, x, index Constant) (Constant, bool) {
+	if isPoison(x) || isUndef(index) || isPoison(index) {
+		return NewPoison(typ), true
+	}
+	i, ok := elemIndex(x.Type(), index)
+	if !ok {
+		return nil, false
+	}
+	if i < 0 {
+		return NewPoison(typ), true
+	}
+	switch x := x.(type) {
+	case *Vector:
+		return x.Elems[i], true
+	case *ZeroInitializer:
+		return zeroValue(typ), true
+	case *Undef:
+		return NewUndef(typ), true
+	}
+	return nil, false
+}
+
+// foldInsertElement folds the insertelement operation of a simplified vector,
+// element and index. The boolean return value reports whether the operation was
+// folded.
+func foldInsertElement(x, elem, index Constant) (Constant, bool) {
+	if isUndef(index) || isPoison(index) {
+		return NewPoison(x.Type()), true
+	}
+	i, ok := elemIndex(x.Type(), index)
+	if !ok {
+		return nil, false
+	}
+	if i < 0 {
+		return NewPoison(x.Type()), true
+	}
+	elems, ok := expandVector(x)
+	if !ok {
+		return nil, false
+	}
+	elems[i] = elem
+	return NewVector(elems...), true
+}
+
+// foldShuffleVector folds the shufflevector operation of simplified vectors and
+// shuffle mask, where typ is the result type. Undefined and out of bounds mask
+// elements select poison values. The boolean return value reports whether the
+// operation was folded.
+func foldShuffleVector(typ *types.VectorType, x, y, mask Constant) (Constant, bool) {
+	if isUndef(mask) || isPoison(mask) {
+		return NewPoison(typ), true
+	}
+	if typ.Scalable {
+		// The only shuffle mask of scalable vectors is zeroinitializer; i.e. a
+		// splat of the first element.
+		if _, ok := mask.(*ZeroInitializer); !ok {
+			return nil, false
+		}
+		switch x.(type) {
+		case *ZeroInitializer:
+			return NewZeroInitializer(typ), true
+		case *Undef:
+			return NewUndef(typ), true
+		case *Poison:
+			return NewPoison(typ), true
+		}
+		return nil, false
+	}
+	indices, ok := vectorElems(mask)
+	if !ok {
+		return nil, false
+	}
+	xs, xok := expandVector(x)
+	ys, yok := expandVector(y)
+	if !xok || !yok {
+		return nil, false
+	}
+	n := int64(len(xs))
+	elems := make([]Constant, len(indices))
+	for i, index := range indices {
+		if isUndef(index) || isPoison(index) {
+			elems[i] = NewPoison(typ.Elem)
+			continue
+		}
+		m, ok := intOperand(index)
+		if !ok {
+			return nil, false
+		}
+		switch j := m.BigUint(); {
+		case j.Cmp(big.NewInt(n)) < 0:
+			elems[i] = xs[j.Int64()]
+		case j.Cmp(big.NewInt(2*n)) < 0:
+			elems[i] = ys[j.Int64()-n]
+		default:
+			elems[i] = NewPoison(typ.Elem)
+		}
+	}
+	return NewVector(elems...), true
+}
+
+// foldExtractValue folds the extractvalue operation of a simplified aggregate
+// value and indices, where typ is the type of the extracted element. The
+// boolean return value reports whether the operation was folded.
+func foldExtractValue(typ types.Type, x Constant, indices []int64) (Constant, bool) {
+	c := x
+	for _, index := range indices {
+		switch c.(type) {
+		case *ZeroInitializer:
+			return zeroValue(typ), true
+		case *Undef:
+			return NewUndef(typ), true
+		case *Poison:
+			return NewPoison(typ), true
+		}
+		elems, ok := aggregateElems(c)
+		if !ok || index < 0 || index >= int64(len(elems)) {
+			return nil, false
+		}
+		c = elems[index]
+	}
+	return simplify(c), true
+}
+
+// foldInsertValue folds the insertvalue operation of a simplified aggregate
+// value, element and indices. The boolean return value reports whether the
+// operation was folded.
+func foldInsertValue(x, elem Constant, indices []int64) (Constant, bool) {
+	if len(indices) == 0 {
+		return elem, true
+	}
+	elems, ok := aggregateElems(x)
+	index := indices[0]
+	if !ok || index < 0 || index >= int64(len(elems)) {
+		return nil, false
+	}
+	e, ok := foldInsertValue(elems[index], elem, indices[1:])
+	if !ok {
+		return nil, false
+	}
+	elems[index] = e
+	switch t := x.Type().(type) {
+	case *types.StructType:
+		return &Struct{Typ: t, Fields: elems}, true
+	case *types.ArrayType:
+		return &Array{Typ: t, Elems: elems}, true
+	}
+	return nil, false
+}
+
+// --- [ Memory operations ] ---------------------------------------------------
+
+// foldGetElementPtr canonicalizes the getelementptr expression of simplified
+// operands. Nested getelementptr expressions are merged into one, and
+// expressions with all-zero indices which retain the type of the source address
+// are replaced by the source address. The boolean return value reports whether
+// the expression was changed.
+func foldGetElementPtr(expr *ExprGetElementPtr) (Constant, bool) {
+	changed := false
+	if inner, ok := expr.Src.(*ExprGetElementPtr); ok {
+		if indices, ok := mergeIndices(inner, expr.Indices); ok {
+			expr = &ExprGetElementPtr{
+				Typ:     expr.Typ,
+				Elem:    inner.Elem,
+				Src:     inner.Src,
+				Indices: indices,
+			}
+			changed = true
+		}
+	}
+	if expr.Typ.Equal(expr.Src.Type()) && allZeroIndices(expr.Indices) {
+		return expr.Src, true
+	}
+	return expr, changed
+}
+
+// mergeIndices returns the indices of a single getelementptr expression
+// equivalent to indexing the address computed by inner with the given indices.
+// The boolean return value reports whether the indices could be merged.
+func mergeIndices(inner *ExprGetElementPtr, indices []Constant) ([]Constant, bool) {
+	n := len(inner.Indices)
+	if n == 0 || len(indices) == 0 {
+		merged := append([]Constant{}, inner.Indices...)
+		return append(merged, indices...), true
+	}
+	var merged []Constant
+	if isZeroIndex(indices[0]) {
+		merged = append(merged, inner.Indices...)
+	} else {
+		// The first index steps over values of the element type addressed by
+		// inner, which is equivalent to stepping the last index of inner only if
+		// it steps over the elements of an array, or is itself a first index.
+		if n > 1 {
+			t, ok := gepIndexedType(inner.Elem, inner.Indices[1:n-1])
+			if !ok || !types.IsArray(t) {
+				return nil, false
+			}
+		}
+		sum, ok := addIndices(inner.Indices[n-1], indices[0])
+		if !ok {
+			return nil, false
+		}
+		merged = append(merged, inner.Indices[:n-1]...)
+		merged = append(merged, sum)
+	}
+	return append(merged, indices[1:]...), true
+}
+
+// gepIndexedType returns the type indexed by the given getelementptr indices
+// into the element type elem, ignoring the first index. The boolean return
+// value reports whether the type could be determined.
+func gepIndexedType(elem types.Type, indices []Constant) (types.Type, bool) {
+	t := elem
+	for _, index := range indices {
+		i, ok := indexValue(index)
+		if !ok && types.IsStruct(t) {
+			return nil, false
+		}
+		e, err := types.ElemType(t, i)
+		if err != nil {
+			return nil, false
+		}
+		t = e
+	}
+	return t, true
+}
+
+// addIndices returns the sum of the given getelementptr indices. The sum has
+// the type of the indices if they agree and the sum fits, and i64 otherwise.
+// The boolean return value reports whether the indices could be added.
+func addIndices(x, y Constant) (Constant, bool) {
+	a, aok := intOperand(x)
+	b, bok := intOperand(y)
+	if !aok || !bok {
+		return nil, false
+	}
+	sum := new(big.Int).Add(sintValue(a), sintValue(b))
+	if a.Typ.Equal(b.Typ) && fitsInt(sum, a.Typ.Size) {
+		return NewIntFromBigInt(sum, a.Typ), true
+	}
+	if fitsInt(sum, 64) {
+		return NewIntFromBigInt(sum, types.I64), true
+	}
+	return nil, false
+}
+
+// ### [ Helper functions ] ####################################################
+
+// intOperand returns the integer constant of the given operand. The boolean
+// return value reports whether the operand is an integer constant or the zero
+// value of an integer type.
+func intOperand(c Constant) (*Int, bool) {
+	switch c := c.(type) {
+	case *Int:
+		return c, true
+	case *ZeroInitializer:
+		if t, ok := c.Typ.(*types.IntType); ok {
+			return NewInt(0, t), true
+		}
+	}
+	return nil, false
+}
+
+// floatOperand returns the floating-point constant of the given operand. The
+// boolean return value reports whether the operand is a floating-point constant
+// or the zero value of a floating-point type.
+func floatOperand(c Constant) (*Float, bool) {
+	switch c := c.(type) {
+	case *Float:
+		return c, true
+	case *ZeroInitializer:
+		if t, ok := c.Typ.(*types.FloatType); ok {
+			return NewFloat(0, t), true
+		}
+	}
+	return nil, false
+}
+
+// vectorElems returns the elements of the given operand. The boolean return
+// value reports whether the operand is a vector constant or the zero value of a
+// fixed-length vector type.
+func vectorElems(c Constant) ([]Constant, bool) {
+	switch c := c.(type) {
+	case *Vector:
+		return c.Elems, true
+	case *ZeroInitializer:
+		if t, ok := c.Typ.(*types.VectorType); ok && !t.Scalable {
+			elems := make([]Constant, t.Len)
+			for i := range elems {
+				elems[i] = zeroValue(t.Elem)
+			}
+			return elems, true
+		}
+	}
+	return nil, false
+}
+
+// expandVector returns a copy of the elements of the given fixed-length vector
+// operand. The boolean return value reports whether the operand is a vector
+// constant, or the zero, undefined or poison value of a fixed-length vector
+// type.
+func expandVector(c Constant) ([]Constant, bool) {
+	if elems, ok := vectorElems(c); ok {
+		return append([]Constant{}, elems...), true
+	}
+	t, ok := c.Type().(*types.VectorType)
+	if !ok || t.Scalable {
+		return nil, false
+	}
+	var elem Constant
+	switch c.(type) {
+	case *Undef:
+		elem = NewUndef(t.Elem)
+	case *Poison:
+		elem = NewPoison(t.Elem)
+	default:
+		return nil, false
+	}
+	elems := make([]Constant, t.Len)
+	for i := range elems {
+		elems[i] = elem
+	}
+	return elems, true
+}
+
+// aggregateElems returns a copy of the elements of the given aggregate operand.
+// The boolean return value reports whether the operand is an array or struct
+// constant, or the zero, undefined or poison value of an array or struct type.
+func aggregateElems(c Constant) ([]Constant, bool) {
+	switch c := c.(type) {
+	case *Array:
+		return append([]Constant{}, c.Elems...), true
+	case *Struct:
+		return append([]Constant{}, c.Fields...), true
+	}
+	var elemTypes []types.Type
+	switch t := c.Type().(type) {
+	case *types.ArrayType:
+		elemTypes = make([]types.Type, t.Len)
+		for i := range elemTypes {
+			elemTypes[i] = t.Elem
+		}
+	case *types.StructType:
+		elemTypes = t.Fields
+	default:
+		return nil, false
+	}
+	elems := make([]Constant, len(elemTypes))
+	for i, elemType := range elemTypes {
+		switch c.(type) {
+		case *ZeroInitializer:
+			elems[i] = zeroValue(elemType)
+		case *Undef:
+			elems[i] = NewUndef(elemType)
+		case *Poison:
+			elems[i] = NewPoison(elemType)
+		default:
+			return nil, false
+		}
+	}
+	return elems, true
+}
+
+// elemIndex returns the element index specified by the given index operand into
+// a vector of type t; or -1 if the index is out of bounds. The boolean return
+// value reports whether the index is an integer constant and, for scalable
+// vectors, whether it is known to be in bounds.
+func elemIndex(t types.Type, index Constant) (int64, bool) {
+	vt, ok := t.(*types.VectorType)
+	if !ok {
+		return 0, false
+	}
+	x, ok := intOperand(index)
+	if !ok {
+		return 0, false
+	}
+	i := x.BigUint()
+	if i.Cmp(big.NewInt(vt.Len)) >= 0 {
+		if vt.Scalable {
+			// The number of elements of scalable vectors is unknown.
+			return 0, false
+		}
+		return -1, true
+	}
+	return i.Int64(), true
+}
+
+// foldElems folds each of the given vector elements using f. The boolean
+// return value reports whether every element was folded.
+func foldElems(elems []Constant, f func(elem Constant) (Constant, bool)) (Constant, bool) {
+	folded := make([]Constant, len(elems))
+	for i, elem := range elems {
+		c, ok := f(elem)
+		if !ok {
+			return nil, false
+		}
+		folded[i] = c
+	}
+	return NewVector(folded...), true
+}
+
+// isNullValue reports whether the given constant is the null value of its
+// type; i.e. integer zero, floating-point positive zero, the null pointer or
+// the zero initializer.
+func isNullValue(c Constant) bool {
+	switch c := c.(type) {
+	case *Int:
+		return c.X.Sign() == 0
+	case *Float:
+		return !c.IsNaN() && c.Raw == nil && c.X.Sign() == 0 && !c.X.Signbit()
+	case *Null, *ZeroInitializer:
+		return true
+	}
+	return false
+}
+
+// indexValue returns the signed value of the given getelementptr index. The
+// boolean return value reports whether the index is a scalar integer constant
+// representable as an int64.
+func indexValue(index Constant) (int64, bool) {
+	x, ok := intOperand(index)
+	if !ok {
+		return 0, false
+	}
+	v := sintValue(x)
+	if !fitsInt(v, 64) {
+		return 0, false
+	}
+	return v.Int64(), true
+}
+
+// isZeroIndex reports whether the given getelementptr index is a scalar zero
+// integer constant.
+func isZeroIndex(index Constant) bool {
+	x, ok := intOperand(index)
+	return ok && x.X.Sign() == 0
+}
+
+// allZeroIndices reports whether all the given getelementptr indices are
+// scalar zero integer constants.
+func allZeroIndices(indices []Constant) bool {
+	for _, index := range indices {
+		if !isZeroIndex(index) {
+			return false
+		}
+	}
+	return true
+}
+
+// sintValue returns the value of the integer constant, interpreted as a signed
+// integer (e.g. -1 for `i1 true`). A new big.Int is returned.
+func sintValue(c *Int) *big.Int {
+	x := c.BigInt()
+	if c.Typ.Size == 1 && x.Sign() != 0 {
+		return x.SetInt64(-1)
+	}
+	return x
+}
+
+// fitsUint reports whether x is in the unsigned range of the given bit width.
+func fitsUint(x *big.Int, size int) bool {
+	return x.Sign() >= 0 && x.BitLen() <= size
+}
+
+// fitsInt reports whether x is in the signed range of the given bit width.
+func fitsInt(x *big.Int, size int) bool {
+	if x.Sign() >= 0 {
+		return x.BitLen() < size
+	}
+	// -2^(size-1) <= x
+	y := new(big.Int).Add(x, big.NewInt(1))
+	return y.BitLen() < size
+}
+
+// lowBits returns a bit mask of the n least significant bits.
+func lowBits(n uint) *big.Int {
+	m := new(big.Int).Lsh(big.NewInt(1), n)
+	return m.Sub(m, big.NewInt(1))
+}
+
+// defaultNaN returns the default quiet NaN of the given floating-point type, as
+// produced by invalid operations.
+func defaultNaN(typ *types.FloatType) *Float {
+	return NewFloatFromBits(floats.NewNaN(floatKind(typ.Kind)).Bits(), typ)
+}
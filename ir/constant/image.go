@@ -0,0 +1,283 @@
+// === [ Byte images ] =========================================================
+
+package constant
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/llir/llvm/ir/datalayout"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Image is the byte image of a constant, as laid out in memory under a data
+// layout; e.g. the contents of a global variable with the constant as
+// initializer.
+type Image struct {
+	// Byte representation of the constant, including padding. Relocated fields
+	// and fields holding unfolded constant expressions are zero.
+	Data []byte
+	// Relocations of pointer-valued fields.
+	Relocs []Reloc
+	// Fields holding constant expressions which could not be folded.
+	Unfolded []Unfolded
+}
+
+// Reloc is a relocation of a field in the byte image of a constant, which holds
+// the address of a global value plus an addend; i.e. `@sym + addend`.
+type Reloc struct {
+	// Offset in bytes of the field.
+	Offset int64
+	// Size in bytes of the field.
+	Size int64
+	// Global value; global variable, alias, indirect function or function.
+	Sym Constant
+	// Offset in bytes added to the address of the global value.
+	Addend int64
+}
+
+// Unfolded is a field in the byte image of a constant, which holds a constant
+// expression that could not be folded.
+type Unfolded struct {
+	// Offset in bytes of the field.
+	Offset int64
+	// Constant expression of the field; or block address.
+	Expr Constant
+}
+
+// NewImage returns the byte image of the given constant under the given data
+// layout. The size of the image is the allocation size of the type of the
+// constant, and the values of the image are encoded in the byte order of the
+// data layout.
+//
+// Constant expressions are folded to their values, or to relocations if they
+// evaluate to the address of a global value plus a constant offset. Constant
+// expressions which cannot be folded are reported in the Unfolded fields of the
+// image.
+//
+// NewImage panics if the type of the constant is unsized or a scalable vector
+// type, as its size is not known at compile time.
+func NewImage(dl *datalayout.DataLayout, c Constant) *Image {
+	if types.IsScalableVector(c.Type()) {
+		panic(fmt.Errorf("unable to compute byte image of constant of scalable vector type %v", c.Type()))
+	}
+	img := &Image{Data: make([]byte, dl.AllocSize(c.Type()))}
+	img.encode(dl, 0, c)
+	return img
+}
+
+// encode encodes the given constant into the byte image at the given offset.
+func (img *Image) encode(dl *datalayout.DataLayout, offset int64, c Constant) {
+	switch c := c.(type) {
+	case *Int:
+		img.putInt(dl, offset, c.BigUint(), dl.StoreSize(c.Typ))
+	case *Float:
+		bits := c.Bits()
+		if c.Typ.Kind == types.FloatKindDoubleDouble_128 {
+			// The high-order double precedes the low-order double in memory,
+			// regardless of byte order.
+			hi := new(big.Int).And(bits, lowBits(64))
+			lo := new(big.Int).Rsh(bits, 64)
+			img.putInt(dl, offset, hi, 8)
+			img.putInt(dl, offset+8, lo, 8)
+			return
+		}
+		img.putInt(dl, offset, bits, dl.StoreSize(c.Typ))
+	case *Null, *ZeroInitializer, *Undef, *Poison:
+		// Zero bytes; undefined values are encoded as zero.
+	case *Array:
+		size := dl.AllocSize(c.Typ.Elem)
+		for i, elem := range c.Elems {
+			img.encode(dl, offset+int64(i)*size, elem)
+		}
+	case *Struct:
+		layout := dl.StructLayout(c.Typ)
+		for i, field := range c.Fields {
+			img.encode(dl, offset+layout.Offsets[i], field)
+		}
+	case *Vector:
+		img.encodeVector(dl, offset, c)
+	case *BlockAddress:
+		img.Unfolded = append(img.Unfolded, Unfolded{Offset: offset, Expr: c})
+	case Expr:
+		img.encodeExpr(dl, offset, c)
+	default:
+		if isGlobal(c) {
+			img.putAddress(dl, offset, dl.StoreSize(c.Type()), c, 0)
+			return
+		}
+		panic(fmt.Errorf("support for constant %T not yet implemented", c))
+	}
+}
+
+// encodeVector encodes the given vector constant into the byte image at the
+// given offset. Vector elements are tightly packed; elements of sub-byte size
+// are packed bit by bit, with the first element in the least significant bits
+// on little-endian targets and in the most significant bits on big-endian
+// targets.
+func (img *Image) encodeVector(dl *datalayout.DataLayout, offset int64, c *Vector) {
+	bits := dl.TypeSize(c.Typ.Elem)
+	if bits%8 == 0 {
+		for i, elem := range c.Elems {
+			img.encode(dl, offset+int64(i)*bits/8, elem)
+		}
+		return
+	}
+	x := new(big.Int)
+	for i, elem := range c.Elems {
+		elem = simplify(elem)
+		if isUndef(elem) || isPoison(elem) {
+			continue
+		}
+		e, ok := intOperand(elem)
+		if !ok {
+			img.Unfolded = append(img.Unfolded, Unfolded{Offset: offset, Expr: c.Elems[i]})
+			continue
+		}
+		pos := int64(i)
+		if dl.BigEndian {
+			pos = int64(len(c.Elems)-1) - pos
+		}
+		x.Or(x, new(big.Int).Lsh(e.BigUint(), uint(pos*bits)))
+	}
+	img.putInt(dl, offset, x, dl.StoreSize(c.Typ))
+}
+
+// encodeExpr encodes the given constant expression into the byte image at the
+// given offset.
+func (img *Image) encodeExpr(dl *datalayout.DataLayout, offset int64, expr Expr) {
+	c := simplify(expr)
+	if _, ok := c.(Expr); !ok {
+		img.encode(dl, offset, c)
+		return
+	}
+	sym, addend, ok := resolveAddress(dl, c)
+	if !ok {
+		img.Unfolded = append(img.Unfolded, Unfolded{Offset: offset, Expr: expr})
+		return
+	}
+	img.putAddress(dl, offset, dl.StoreSize(c.Type()), sym, addend)
+}
+
+// putAddress stores the address of the given global value plus addend into the
+// field of the given size at the given offset. A relocation is recorded if sym
+// is non-nil, and the addend is stored otherwise.
+func (img *Image) putAddress(dl *datalayout.DataLayout, offset, size int64, sym Constant, addend int64) {
+	if sym != nil {
+		reloc := Reloc{Offset: offset, Size: size, Sym: sym, Addend: addend}
+		img.Relocs = append(img.Relocs, reloc)
+		return
+	}
+	x := new(big.Int).And(big.NewInt(addend), lowBits(uint(size*8)))
+	img.putInt(dl, offset, x, size)
+}
+
+// putInt stores the given unsigned integer into the field of the given size at
+// the given offset, in the byte order of the data layout.
+func (img *Image) putInt(dl *datalayout.DataLayout, offset int64, x *big.Int, size int64) {
+	buf := img.Data[offset : offset+size]
+	// Store x in big-endian byte order, zero-padded to the size of the field.
+	for i := range buf {
+		buf[i] = 0
+	}
+	b := x.Bytes()
+	if len(b) > len(buf) {
+		panic(fmt.Errorf("integer %v does not fit in field of %d bytes", x, size))
+	}
+	copy(buf[len(buf)-len(b):], b)
+	if !dl.BigEndian {
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+	}
+}
+
+// ### [ Helper functions ] ####################################################
+
+// resolveAddress resolves the given simplified constant to the address of a
+// global value plus an addend; or to an absolute address if sym is nil. The
+// boolean return value reports whether the constant could be resolved.
+func resolveAddress(dl *datalayout.DataLayout, c Constant) (sym Constant, addend int64, ok bool) {
+	switch c := c.(type) {
+	case *Null:
+		return nil, 0, true
+	case *Int:
+		x := sintValue(c)
+		if !fitsInt(x, 64) {
+			return nil, 0, false
+		}
+		return nil, x.Int64(), true
+	case *ExprGetElementPtr:
+		sym, addend, ok := resolveAddress(dl, c.Src)
+		if !ok {
+			return nil, 0, false
+		}
+		offset, _, err := c.Offset(dl)
+		if err != nil {
+			return nil, 0, false
+		}
+		return sym, addend + offset, true
+	case *ExprBitCast:
+		return resolveAddress(dl, c.From)
+	case *ExprAddrSpaceCast:
+		return resolveAddress(dl, c.From)
+	case *ExprIntToPtr:
+		if x, ok := c.From.(*Int); ok {
+			// Integers are zero-extended or truncated to the pointer size of the
+			// address space.
+			v := new(big.Int).And(x.BigUint(), lowBits(uint(dl.TypeSize(c.To))))
+			if !fitsUint(v, 64) {
+				return nil, 0, false
+			}
+			return nil, int64(v.Uint64()), true
+		}
+		// Vectors of addresses, including scalable vectors, are not resolved.
+		if types.IsVector(c.To) || dl.TypeSize(c.From.Type()) != dl.TypeSize(c.To) {
+			return nil, 0, false
+		}
+		return resolveAddress(dl, c.From)
+	case *ExprPtrToInt:
+		if types.IsVector(c.To) || dl.TypeSize(c.From.Type()) != dl.TypeSize(c.To) {
+			return nil, 0, false
+		}
+		return resolveAddress(dl, c.From)
+	case *ExprAdd:
+		xsym, x, xok := resolveAddress(dl, c.X)
+		ysym, y, yok := resolveAddress(dl, c.Y)
+		if !xok || !yok || xsym != nil && ysym != nil {
+			return nil, 0, false
+		}
+		if xsym == nil {
+			xsym = ysym
+		}
+		return xsym, x + y, true
+	case *ExprSub:
+		xsym, x, xok := resolveAddress(dl, c.X)
+		ysym, y, yok := resolveAddress(dl, c.Y)
+		if !xok || !yok {
+			return nil, 0, false
+		}
+		switch {
+		case ysym == nil:
+			return xsym, x - y, true
+		case ysym == xsym:
+			// Difference of addresses relative to the same global value.
+			return nil, x - y, true
+		default:
+			return nil, 0, false
+		}
+	default:
+		if isGlobal(c) {
+			return c, 0, true
+		}
+		return nil, 0, false
+	}
+}
+
+// isGlobal reports whether the given constant is a global value; i.e. a global
+// variable, alias, indirect function or function.
+func isGlobal(c Constant) bool {
+	_, ok := c.(value.Named)
+	return ok && types.IsPointer(c.Type())
+}
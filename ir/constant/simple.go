@@ -16,38 +16,52 @@ import (
 // --- [ integer ] -------------------------------------------------------------
 
 // Int represents an integer constant.
+//
+// Integer constants are of arbitrary precision, and wrap around according to
+// the bit width of their type.
 type Int struct {
 	// Integer type.
 	Typ *types.IntType
-	// Integer value.
+	// Integer value; in the signed range of the integer type (e.g. -128 to 127
+	// for i8), or 0 (false) and 1 (true) for i1.
 	X *big.Int
 }
 
 // NewInt returns a new integer constant based on the given integer value and
-// type.
+// type. The value is wrapped around to the bit width of the type.
 func NewInt(x int64, typ types.Type) *Int {
+	return NewIntFromBigInt(big.NewInt(x), typ)
+}
+
+// NewIntFromUint64 returns a new integer constant based on the given unsigned
+// integer value and type. The value is wrapped around to the bit width of the
+// type.
+func NewIntFromUint64(x uint64, typ types.Type) *Int {
+	return NewIntFromBigInt(new(big.Int).SetUint64(x), typ)
+}
+
+// NewIntFromBigInt returns a new integer constant based on the given integer
+// value and type. The value is copied, and wrapped around to the bit width of
+// the type.
+func NewIntFromBigInt(x *big.Int, typ types.Type) *Int {
 	t, ok := typ.(*types.IntType)
 	if !ok {
 		panic(fmt.Errorf("invalid integer constant type; expected *types.IntType, got %T", typ))
 	}
-	return &Int{Typ: t, X: big.NewInt(x)}
+	return &Int{Typ: t, X: wrapInt(x, t.Size)}
 }
 
 // NewIntFromString returns a new integer constant based on the given integer
-// string and type.
+// string and type. The value is wrapped around to the bit width of the type.
 func NewIntFromString(s string, typ types.Type) *Int {
 	// Parse boolean integer constants.
-	c := NewInt(0, typ)
-	if types.IsBool(c.Typ) {
+	if types.IsBool(typ) {
 		switch s {
-		case "0", "false":
-			c.X.SetInt64(0)
-		case "1", "true":
-			c.X.SetInt64(1)
-		default:
-			panic(fmt.Errorf("invalid integer constant %q for type i1", s))
+		case "false":
+			return NewInt(0, typ)
+		case "true":
+			return NewInt(1, typ)
 		}
-		return c
 	} else if s == "true" || s == "false" {
 		panic(fmt.Errorf("invalid integer constant %q for type %s", s, typ))
 	}
@@ -65,10 +79,11 @@ func NewIntFromString(s string, typ types.Type) *Int {
 		s = s[len("0x"):]
 		base = 16
 	}
-	if _, ok := c.X.SetString(s, base); !ok {
+	x, ok := new(big.Int).SetString(s, base)
+	if !ok {
 		panic(fmt.Errorf("unable to parse constant %q of type %s", s, typ))
 	}
-	return c
+	return NewIntFromBigInt(x, typ)
 }
 
 // Type returns the type of the constant.
@@ -78,24 +93,54 @@ func (c *Int) Type() types.Type {
 
 // Ident returns the string representation of the constant.
 func (c *Int) Ident() string {
+	x := wrapInt(c.X, c.Typ.Size)
 	if c.Typ.Size == 1 {
-		switch c.Int64() {
-		case 0:
+		if x.Sign() == 0 {
 			return "false"
-		case 1:
-			return "true"
 		}
+		return "true"
 	}
-	return c.X.String()
+	return x.String()
 }
 
 // Immutable ensures that only constants can be assigned to the
 // constant.Constant interface.
 func (*Int) Immutable() {}
 
-// Int64 returns the int64 representation of the integer constant.
+// Int64 returns the int64 representation of the integer constant. The result
+// is undefined if the value does not fit in an int64; see IsInt64.
 func (c *Int) Int64() int64 {
-	return c.X.Int64()
+	return wrapInt(c.X, c.Typ.Size).Int64()
+}
+
+// IsInt64 reports whether the value of the integer constant may be represented
+// as an int64.
+func (c *Int) IsInt64() bool {
+	return fitsInt(wrapInt(c.X, c.Typ.Size), 64)
+}
+
+// Uint64 returns the uint64 representation of the integer constant,
+// interpreted as an unsigned integer (e.g. 255 for `i8 -1`). The result is
+// undefined if the value does not fit in a uint64; see IsUint64.
+func (c *Int) Uint64() uint64 {
+	return uintValue(c.X, c.Typ.Size).Uint64()
+}
+
+// IsUint64 reports whether the value of the integer constant, interpreted as an
+// unsigned integer, may be represented as a uint64.
+func (c *Int) IsUint64() bool {
+	return fitsUint(uintValue(c.X, c.Typ.Size), 64)
+}
+
+// BigInt returns a copy of the value of the integer constant.
+func (c *Int) BigInt() *big.Int {
+	return wrapInt(c.X, c.Typ.Size)
+}
+
+// BigUint returns a copy of the value of the integer constant, interpreted as
+// an unsigned integer.
+func (c *Int) BigUint() *big.Int {
+	return uintValue(c.X, c.Typ.Size)
 }
 
 // --- [ null pointer ] --------------------------------------------------------
@@ -128,3 +173,63 @@ func (c *Null) Ident() string {
 // Immutable ensures that only constants can be assigned to the
 // constant.Constant interface.
 func (*Null) Immutable() {}
+
+// --- [ none token ] ----------------------------------------------------------
+
+// NoneToken represents a none token constant.
+type NoneToken struct {
+	// Token type.
+	Typ *types.TokenType
+}
+
+// NewNoneToken returns a new none token constant based on the given token type.
+func NewNoneToken(typ types.Type) *NoneToken {
+	t, ok := typ.(*types.TokenType)
+	if !ok {
+		panic(fmt.Errorf("invalid none token constant type; expected *types.TokenType, got %T", typ))
+	}
+	return &NoneToken{Typ: t}
+}
+
+// Type returns the type of the constant.
+func (c *NoneToken) Type() types.Type {
+	return c.Typ
+}
+
+// Ident returns the string representation of the constant.
+func (c *NoneToken) Ident() string {
+	return "none"
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*NoneToken) Immutable() {}
+
+// ### [ Helper functions ] ####################################################
+
+// wrapInt returns x wrapped around to the given bit width; in the signed range
+// of the width, or 0 and 1 for a bit width of one. A new big.Int is returned.
+func wrapInt(x *big.Int, size int) *big.Int {
+	if size < 1 {
+		// Invalid bit width; reported by sem.
+		return new(big.Int).Set(x)
+	}
+	y := uintValue(x, size)
+	if size > 1 && y.Bit(size-1) == 1 {
+		// Negative value in two's complement representation.
+		y.Sub(y, new(big.Int).Lsh(big.NewInt(1), uint(size)))
+	}
+	return y
+}
+
+// uintValue returns x wrapped around to the given bit width, interpreted as an
+// unsigned integer. A new big.Int is returned.
+func uintValue(x *big.Int, size int) *big.Int {
+	if size < 1 {
+		// Invalid bit width; reported by sem.
+		return new(big.Int).Set(x)
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(size))
+	// Mod uses Euclidean modulus, so the result is always non-negative.
+	return new(big.Int).Mod(x, mod)
+}
@@ -0,0 +1,243 @@
+// === [ Undefined values ] ====================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#undefined-values
+//    http://llvm.org/docs/LangRef.html#poison-values
+
+package constant
+
+import (
+	"github.com/llir/llvm/ir/types"
+)
+
+// --- [ undef ] ---------------------------------------------------------------
+
+// Undef represents an undefined value constant; i.e. an unspecified bit pattern
+// of the given type.
+type Undef struct {
+	// Constant type.
+	Typ types.Type
+}
+
+// NewUndef returns a new undefined value constant based on the given type.
+func NewUndef(typ types.Type) *Undef {
+	return &Undef{Typ: typ}
+}
+
+// Type returns the type of the constant.
+func (c *Undef) Type() types.Type {
+	return c.Typ
+}
+
+// Ident returns the string representation of the constant.
+func (c *Undef) Ident() string {
+	return "undef"
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*Undef) Immutable() {}
+
+// --- [ poison ] --------------------------------------------------------------
+
+// Poison represents a poison value constant; i.e. the result of an erroneous
+// operation, which propagates through dependent operations.
+type Poison struct {
+	// Constant type.
+	Typ types.Type
+}
+
+// NewPoison returns a new poison value constant based on the given type.
+func NewPoison(typ types.Type) *Poison {
+	return &Poison{Typ: typ}
+}
+
+// Type returns the type of the constant.
+func (c *Poison) Type() types.Type {
+	return c.Typ
+}
+
+// Ident returns the string representation of the constant.
+func (c *Poison) Ident() string {
+	return "poison"
+}
+
+// Immutable ensures that only constants can be assigned to the
+// constant.Constant interface.
+func (*Poison) Immutable() {}
+
+// ### [ Helper functions ] ####################################################
+
+// isUndef reports whether the given constant is an undefined value.
+func isUndef(c Constant) bool {
+	_, ok := c.(*Undef)
+	return ok
+}
+
+// isPoison reports whether the given constant is a poison value.
+func isPoison(c Constant) bool {
+	_, ok := c.(*Poison)
+	return ok
+}
+
+// simplifyUndefBinary simplifies the given binary or bitwise operation if any
+// of its operands is an undefined or poison value. The boolean return value
+// reports whether the operation was simplified.
+//
+// The folding rules follow those of LLVM; a poison operand always yields
+// poison, and an undefined operand is replaced by the value which yields the
+// most defined result.
+func simplifyUndefBinary(op string, x, y Constant) (Constant, bool) {
+	typ := x.Type()
+	if isPoison(x) || isPoison(y) {
+		return NewPoison(typ), true
+	}
+	xUndef, yUndef := isUndef(x), isUndef(y)
+	if !xUndef && !yUndef {
+		return nil, false
+	}
+	switch op {
+	case "add", "sub":
+		// undef + X -> undef
+		return NewUndef(typ), true
+	case "xor":
+		// undef ^ undef -> 0
+		if xUndef && yUndef {
+			return zeroValue(typ), true
+		}
+		// undef ^ X -> undef
+		return NewUndef(typ), true
+	case "mul", "and":
+		// undef & undef -> undef
+		if xUndef && yUndef {
+			return NewUndef(typ), true
+		}
+		// undef & X -> 0
+		return zeroValue(typ), true
+	case "or":
+		// undef | undef -> undef
+		if xUndef && yUndef {
+			return NewUndef(typ), true
+		}
+		// undef | X -> -1
+		return allOnesValue(typ)
+	case "udiv", "sdiv", "urem", "srem", "shl", "lshr", "ashr":
+		// X / undef -> poison
+		// X << undef -> poison
+		if yUndef {
+			return NewPoison(typ), true
+		}
+		// undef / X -> 0
+		// undef << X -> 0
+		return zeroValue(typ), true
+	case "fadd", "fsub", "fmul", "fdiv", "frem":
+		// undef + undef -> undef
+		if xUndef && yUndef {
+			return NewUndef(typ), true
+		}
+		// undef + X -> NaN
+		return nanValue(typ)
+	}
+	return nil, false
+}
+
+// simplifyUndefConversion simplifies the given conversion operation if its
+// operand is an undefined or poison value. The boolean return value reports
+// whether the operation was simplified.
+func simplifyUndefConversion(op string, from Constant, to types.Type) (Constant, bool) {
+	switch {
+	case isPoison(from):
+		return NewPoison(to), true
+	case isUndef(from):
+		switch op {
+		case "zext", "sext":
+			// The extended bits of zext and sext are fully determined by the
+			// undefined bits; fold to 0.
+			return zeroValue(to), true
+		}
+		return NewUndef(to), true
+	}
+	return nil, false
+}
+
+// simplifyUndefCmp simplifies the given comparison operation if any of its
+// operands is an undefined or poison value. The boolean return value reports
+// whether the operation was simplified.
+func simplifyUndefCmp(typ types.Type, x, y Constant) (Constant, bool) {
+	switch {
+	case isPoison(x) || isPoison(y):
+		return NewPoison(typ), true
+	case isUndef(x) || isUndef(y):
+		return NewUndef(typ), true
+	}
+	return nil, false
+}
+
+// simplifyUndefSelect simplifies the given select operation if its condition
+// or any of its operands is an undefined or poison value. The boolean return
+// value reports whether the operation was simplified.
+func simplifyUndefSelect(cond, x, y Constant) (Constant, bool) {
+	switch {
+	case isPoison(cond):
+		return NewPoison(x.Type()), true
+	case isUndef(cond):
+		// Select the undefined operand if present, as it may take any value.
+		if isUndef(x) {
+			return x, true
+		}
+		return y, true
+	case isPoison(x), isUndef(x) && isUndef(y):
+		return y, true
+	case isPoison(y):
+		return x, true
+	}
+	return nil, false
+}
+
+// zeroValue returns the zero value of the given type.
+func zeroValue(typ types.Type) Constant {
+	switch t := typ.(type) {
+	case *types.IntType:
+		return NewInt(0, t)
+	case *types.FloatType:
+		return NewFloat(0, t)
+	case *types.PointerType:
+		return NewNull(t)
+	}
+	return NewZeroInitializer(typ)
+}
+
+// nanValue returns the default quiet NaN value of the given type. The boolean
+// return value reports whether the value is representable; i.e. whether the
+// given type is a floating-point type or a fixed-length vector thereof.
+func nanValue(typ types.Type) (Constant, bool) {
+	switch t := typ.(type) {
+	case *types.FloatType:
+		return defaultNaN(t), true
+	case *types.VectorType:
+		elem, ok := t.Elem.(*types.FloatType)
+		if !ok || t.Scalable {
+			return nil, false
+		}
+		elems := make([]Constant, t.Len)
+		for i := range elems {
+			elems[i] = defaultNaN(elem)
+		}
+		return NewVector(elems...), true
+	}
+	return nil, false
+}
+
+// allOnesValue returns the integer value with all bits set of the given type.
+// The boolean return value reports whether the value is representable; i.e.
+// whether the given type is an integer type.
+func allOnesValue(typ types.Type) (Constant, bool) {
+	t, ok := typ.(*types.IntType)
+	if !ok {
+		return nil, false
+	}
+	if types.IsBool(t) {
+		return NewInt(1, t), true
+	}
+	return NewInt(-1, t), true
+}
@@ -0,0 +1,386 @@
+// === [ Data layout ] =========================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#data-layout
+
+// Package datalayout implements parsing of LLVM IR target data layout strings,
+// and computes the size, alignment and field offsets of types under a given
+// data layout.
+package datalayout
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DataLayout specifies how data is to be laid out in memory on the target.
+//
+// Unless otherwise stated, sizes are specified in bits and alignments are
+// specified in bytes.
+type DataLayout struct {
+	// Big-endian byte order; little-endian otherwise.
+	BigEndian bool
+	// Natural alignment of the stack; or 0 if unspecified.
+	StackAlign int64
+	// Address space of functions.
+	ProgramAddrSpace int64
+	// Address space of allocas.
+	AllocaAddrSpace int64
+	// Default address space of global variables.
+	GlobalsAddrSpace int64
+	// Alignment of function pointers; or 0 if unspecified.
+	FuncPtrAlign int64
+	// Alignment of function pointers is a multiple of the function alignment;
+	// independent of the function alignment otherwise.
+	FuncPtrAlignMultiple bool
+	// Name mangling style (e, l, m, o, x, w or a); or 0 if unspecified.
+	Mangling byte
+	// Native integer widths of the target CPU.
+	NativeIntWidths []int64
+	// Address spaces of non-integral pointers.
+	NonIntegralAddrSpaces []int64
+	// Alignment of integer types, sorted by size.
+	Ints []AlignSpec
+	// Alignment of floating-point types, sorted by size.
+	Floats []AlignSpec
+	// Alignment of vector types, sorted by size.
+	Vectors []AlignSpec
+	// Alignment of aggregate types.
+	Aggregate AlignSpec
+	// Size and alignment of pointer types, sorted by address space.
+	Pointers []PointerSpec
+}
+
+// AlignSpec specifies the alignment of types of a given size.
+type AlignSpec struct {
+	// Size in bits.
+	Size int64
+	// ABI alignment in bytes.
+	ABIAlign int64
+	// Preferred alignment in bytes.
+	PrefAlign int64
+}
+
+// PointerSpec specifies the size and alignment of pointer types in a given
+// address space.
+type PointerSpec struct {
+	// Address space.
+	AddrSpace int64
+	// Size in bits.
+	Size int64
+	// ABI alignment in bytes.
+	ABIAlign int64
+	// Preferred alignment in bytes.
+	PrefAlign int64
+	// Size in bits of indices used for address calculation.
+	IndexSize int64
+}
+
+// Default returns the default data layout, as used by LLVM when no data layout
+// has been specified.
+func Default() *DataLayout {
+	return &DataLayout{
+		Ints: []AlignSpec{
+			{Size: 1, ABIAlign: 1, PrefAlign: 1},
+			{Size: 8, ABIAlign: 1, PrefAlign: 1},
+			{Size: 16, ABIAlign: 2, PrefAlign: 2},
+			{Size: 32, ABIAlign: 4, PrefAlign: 4},
+			{Size: 64, ABIAlign: 4, PrefAlign: 8},
+		},
+		Floats: []AlignSpec{
+			{Size: 16, ABIAlign: 2, PrefAlign: 2},
+			{Size: 32, ABIAlign: 4, PrefAlign: 4},
+			{Size: 64, ABIAlign: 8, PrefAlign: 8},
+			{Size: 128, ABIAlign: 16, PrefAlign: 16},
+		},
+		Vectors: []AlignSpec{
+			{Size: 64, ABIAlign: 8, PrefAlign: 8},
+			{Size: 128, ABIAlign: 16, PrefAlign: 16},
+		},
+		Aggregate: AlignSpec{ABIAlign: 0, PrefAlign: 8},
+		Pointers: []PointerSpec{
+			{AddrSpace: 0, Size: 64, ABIAlign: 8, PrefAlign: 8, IndexSize: 64},
+		},
+	}
+}
+
+// Parse parses the given LLVM IR data layout string (e.g. "e-m:e-i64:64-n8:16:32:64-S128").
+// Specifications not present in s retain their default values.
+func Parse(s string) (*DataLayout, error) {
+	dl := Default()
+	if len(s) == 0 {
+		return dl, nil
+	}
+	for _, spec := range strings.Split(s, "-") {
+		if err := dl.parseSpec(spec); err != nil {
+			return nil, fmt.Errorf("invalid data layout specification %q; %v", spec, err)
+		}
+	}
+	return dl, nil
+}
+
+// parseSpec parses the given data layout specification.
+func (dl *DataLayout) parseSpec(spec string) error {
+	if len(spec) == 0 {
+		return fmt.Errorf("empty specification")
+	}
+	kind, rest := spec[0], spec[1:]
+	switch kind {
+	case 'e', 'E':
+		if len(rest) != 0 {
+			return fmt.Errorf("unexpected trailing characters %q", rest)
+		}
+		dl.BigEndian = kind == 'E'
+	case 'S':
+		// S0 specifies that the natural stack alignment is unspecified.
+		align, err := parseAlign(rest, true)
+		if err != nil {
+			return err
+		}
+		dl.StackAlign = align
+	case 'P', 'A', 'G':
+		as, err := parseInt(rest)
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case 'P':
+			dl.ProgramAddrSpace = as
+		case 'A':
+			dl.AllocaAddrSpace = as
+		case 'G':
+			dl.GlobalsAddrSpace = as
+		}
+	case 'F':
+		if len(rest) == 0 {
+			return fmt.Errorf("missing function pointer alignment type")
+		}
+		switch rest[0] {
+		case 'i':
+			dl.FuncPtrAlignMultiple = false
+		case 'n':
+			dl.FuncPtrAlignMultiple = true
+		default:
+			return fmt.Errorf("unknown function pointer alignment type %q", rest[0])
+		}
+		align, err := parseAlign(rest[1:], false)
+		if err != nil {
+			return err
+		}
+		dl.FuncPtrAlign = align
+	case 'm':
+		if len(rest) != 2 || rest[0] != ':' {
+			return fmt.Errorf("expected mangling specification of form m:<mangling>")
+		}
+		switch rest[1] {
+		case 'e', 'l', 'm', 'o', 'x', 'w', 'a':
+			dl.Mangling = rest[1]
+		default:
+			return fmt.Errorf("unknown mangling style %q", rest[1])
+		}
+	case 'n':
+		if strings.HasPrefix(rest, "i:") {
+			// Address spaces of non-integral pointers.
+			ass, err := parseInts(strings.Split(rest[len("i:"):], ":"))
+			if err != nil {
+				return err
+			}
+			for _, as := range ass {
+				if as == 0 {
+					return fmt.Errorf("address space 0 cannot be non-integral")
+				}
+			}
+			dl.NonIntegralAddrSpaces = ass
+			break
+		}
+		widths, err := parseInts(strings.Split(rest, ":"))
+		if err != nil {
+			return err
+		}
+		dl.NativeIntWidths = widths
+	case 'p':
+		return dl.parsePointerSpec(rest)
+	case 'i', 'f', 'v', 'a':
+		return dl.parseAlignSpec(kind, rest)
+	default:
+		return fmt.Errorf("unknown specification kind %q", kind)
+	}
+	return nil
+}
+
+// parsePointerSpec parses the given pointer specification, excluding the
+// leading 'p'; of the form [n]:<size>:<abi>[:<pref>][:<idx>].
+func (dl *DataLayout) parsePointerSpec(s string) error {
+	parts := strings.Split(s, ":")
+	if len(parts) < 3 || len(parts) > 5 {
+		return fmt.Errorf("expected pointer specification of form p[n]:<size>:<abi>[:<pref>][:<idx>]")
+	}
+	var as int64
+	if len(parts[0]) > 0 {
+		var err error
+		if as, err = parseInt(parts[0]); err != nil {
+			return err
+		}
+	}
+	size, err := parseInt(parts[1])
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return fmt.Errorf("invalid pointer size 0")
+	}
+	abi, pref, err := parseAlignPair(parts[2:], false)
+	if err != nil {
+		return err
+	}
+	idx := size
+	if len(parts) == 5 {
+		if idx, err = parseInt(parts[4]); err != nil {
+			return err
+		}
+		if idx > size {
+			return fmt.Errorf("index size %d larger than pointer size %d", idx, size)
+		}
+	}
+	ptr := PointerSpec{AddrSpace: as, Size: size, ABIAlign: abi, PrefAlign: pref, IndexSize: idx}
+	i := sort.Search(len(dl.Pointers), func(i int) bool {
+		return dl.Pointers[i].AddrSpace >= as
+	})
+	if i < len(dl.Pointers) && dl.Pointers[i].AddrSpace == as {
+		dl.Pointers[i] = ptr
+		return nil
+	}
+	dl.Pointers = append(dl.Pointers, PointerSpec{})
+	copy(dl.Pointers[i+1:], dl.Pointers[i:])
+	dl.Pointers[i] = ptr
+	return nil
+}
+
+// parseAlignSpec parses the given integer, floating-point, vector or aggregate
+// alignment specification, excluding the leading kind; of the form
+// <size>:<abi>[:<pref>].
+func (dl *DataLayout) parseAlignSpec(kind byte, s string) error {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return fmt.Errorf("expected alignment specification of form %c<size>:<abi>[:<pref>]", kind)
+	}
+	var size int64
+	if len(parts[0]) > 0 {
+		var err error
+		if size, err = parseInt(parts[0]); err != nil {
+			return err
+		}
+	}
+	abi, pref, err := parseAlignPair(parts[1:], kind == 'a')
+	if err != nil {
+		return err
+	}
+	spec := AlignSpec{Size: size, ABIAlign: abi, PrefAlign: pref}
+	switch kind {
+	case 'i':
+		if size == 0 {
+			return fmt.Errorf("invalid integer size 0")
+		}
+		if size == 8 && abi != 1 {
+			return fmt.Errorf("i8 must be 8-bit aligned")
+		}
+		dl.Ints = setAlignSpec(dl.Ints, spec)
+	case 'f':
+		if size == 0 {
+			return fmt.Errorf("invalid floating-point size 0")
+		}
+		dl.Floats = setAlignSpec(dl.Floats, spec)
+	case 'v':
+		if size == 0 {
+			return fmt.Errorf("invalid vector size 0")
+		}
+		dl.Vectors = setAlignSpec(dl.Vectors, spec)
+	case 'a':
+		if size != 0 {
+			return fmt.Errorf("invalid aggregate size %d; expected 0", size)
+		}
+		dl.Aggregate = spec
+	}
+	return nil
+}
+
+// ### [ Helper functions ] ####################################################
+
+// setAlignSpec sets the alignment specification of the given size, keeping
+// specs sorted by size.
+func setAlignSpec(specs []AlignSpec, spec AlignSpec) []AlignSpec {
+	i := sort.Search(len(specs), func(i int) bool {
+		return specs[i].Size >= spec.Size
+	})
+	if i < len(specs) && specs[i].Size == spec.Size {
+		specs[i] = spec
+		return specs
+	}
+	specs = append(specs, AlignSpec{})
+	copy(specs[i+1:], specs[i:])
+	specs[i] = spec
+	return specs
+}
+
+// parseAlignPair parses the given ABI and optional preferred alignment, and
+// returns them in bytes. The preferred alignment defaults to the ABI alignment.
+func parseAlignPair(parts []string, allowZero bool) (abi, pref int64, err error) {
+	if abi, err = parseAlign(parts[0], allowZero); err != nil {
+		return 0, 0, err
+	}
+	pref = abi
+	if len(parts) > 1 {
+		if pref, err = parseAlign(parts[1], allowZero); err != nil {
+			return 0, 0, err
+		}
+		if pref < abi {
+			return 0, 0, fmt.Errorf("preferred alignment %d bits smaller than ABI alignment %d bits", pref*8, abi*8)
+		}
+	}
+	return abi, pref, nil
+}
+
+// parseAlign parses the given alignment in bits, and returns it in bytes. The
+// alignment must be a power of two multiple of the byte size.
+func parseAlign(s string, allowZero bool) (int64, error) {
+	bits, err := parseInt(s)
+	if err != nil {
+		return 0, err
+	}
+	if bits == 0 && allowZero {
+		return 0, nil
+	}
+	if bits%8 != 0 || !isPowerOfTwo(bits/8) {
+		return 0, fmt.Errorf("invalid alignment %d bits; expected a power of two multiple of 8", bits)
+	}
+	return bits / 8, nil
+}
+
+// parseInts parses the given list of non-negative integers.
+func parseInts(ss []string) ([]int64, error) {
+	var xs []int64
+	for _, s := range ss {
+		x, err := parseInt(s)
+		if err != nil {
+			return nil, err
+		}
+		xs = append(xs, x)
+	}
+	return xs, nil
+}
+
+// parseInt parses the given non-negative integer.
+func parseInt(s string) (int64, error) {
+	x, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %q", s)
+	}
+	return int64(x), nil
+}
+
+// isPowerOfTwo reports whether x is a power of two.
+func isPowerOfTwo(x int64) bool {
+	return x > 0 && x&(x-1) == 0
+}
@@ -0,0 +1,198 @@
+package datalayout_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/llir/llvm/ir/datalayout"
+	"github.com/llir/llvm/ir/types"
+)
+
+// x86_64 data layout of Linux targets.
+const x86_64 = "e-m:e-p270:32:32-p271:32:32-p272:64:64-i64:64-f80:128-n8:16:32:64-S128"
+
+func TestParse(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dl.BigEndian {
+		t.Errorf("expected little-endian byte order")
+	}
+	if dl.Mangling != 'e' {
+		t.Errorf("mangling mismatch; expected %q, got %q", 'e', dl.Mangling)
+	}
+	if dl.StackAlign != 16 {
+		t.Errorf("stack alignment mismatch; expected 16, got %d", dl.StackAlign)
+	}
+	if want := []int64{8, 16, 32, 64}; !reflect.DeepEqual(dl.NativeIntWidths, want) {
+		t.Errorf("native integer widths mismatch; expected %v, got %v", want, dl.NativeIntWidths)
+	}
+	if got := dl.PointerSize(270); got != 32 {
+		t.Errorf("pointer size mismatch; expected 32, got %d", got)
+	}
+	if got := dl.PointerSize(1); got != 64 {
+		t.Errorf("pointer size mismatch; expected 64, got %d", got)
+	}
+}
+
+func TestParseStackAlign(t *testing.T) {
+	golden := []struct {
+		s    string
+		want int64
+	}{
+		{s: "S0", want: 0},
+		{s: "S64", want: 8},
+		{s: "e-S128", want: 16},
+	}
+	for _, g := range golden {
+		dl, err := datalayout.Parse(g.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", g.s, err)
+			continue
+		}
+		if dl.StackAlign != g.want {
+			t.Errorf("%q: stack alignment mismatch; expected %d, got %d", g.s, g.want, dl.StackAlign)
+		}
+	}
+	if _, err := datalayout.Parse("S12"); err == nil {
+		t.Errorf("%q: expected error, got nil", "S12")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	golden := []string{
+		"x",
+		"e-i8:16",
+		"i32:12",
+		"i32:64:32",
+		"p:64",
+		"p:32:32:32:64",
+		"m:q",
+		"ni:0",
+		"a8:64",
+	}
+	for _, s := range golden {
+		if _, err := datalayout.Parse(s); err == nil {
+			t.Errorf("%q: expected error, got nil", s)
+		}
+	}
+}
+
+func TestSizeAndAlign(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := []struct {
+		typ                 types.Type
+		size, store, alloc  int64
+		abiAlign, prefAlign int64
+	}{
+		{typ: types.I1, size: 1, store: 1, alloc: 1, abiAlign: 1, prefAlign: 1},
+		{typ: types.I32, size: 32, store: 4, alloc: 4, abiAlign: 4, prefAlign: 4},
+		{typ: types.I64, size: 64, store: 8, alloc: 8, abiAlign: 8, prefAlign: 8},
+		{typ: types.NewInt(24), size: 24, store: 3, alloc: 4, abiAlign: 4, prefAlign: 4},
+		{typ: types.NewInt(128), size: 128, store: 16, alloc: 16, abiAlign: 8, prefAlign: 8},
+		{typ: types.Double, size: 64, store: 8, alloc: 8, abiAlign: 8, prefAlign: 8},
+		{typ: types.X86_FP80, size: 80, store: 10, alloc: 16, abiAlign: 16, prefAlign: 16},
+		{typ: types.NewPointer(types.I8), size: 64, store: 8, alloc: 8, abiAlign: 8, prefAlign: 8},
+		{typ: types.NewVector(types.I32, 3), size: 96, store: 12, alloc: 16, abiAlign: 16, prefAlign: 16},
+		{typ: types.X86_MMX, size: 64, store: 8, alloc: 8, abiAlign: 8, prefAlign: 8},
+		{typ: types.NewArray(types.X86_FP80, 3), size: 384, store: 48, alloc: 48, abiAlign: 16, prefAlign: 16},
+		{typ: types.NewStruct(types.I8, types.I32, types.I64), size: 128, store: 16, alloc: 16, abiAlign: 8, prefAlign: 8},
+		{typ: types.NewStruct(), size: 0, store: 0, alloc: 0, abiAlign: 1, prefAlign: 8},
+		{typ: &types.StructType{Fields: []types.Type{types.I8, types.I32}, Packed: true}, size: 40, store: 5, alloc: 5, abiAlign: 1, prefAlign: 8},
+	}
+	for _, g := range golden {
+		if got := dl.TypeSize(g.typ); got != g.size {
+			t.Errorf("%v: size mismatch; expected %d, got %d", g.typ, g.size, got)
+		}
+		if got := dl.StoreSize(g.typ); got != g.store {
+			t.Errorf("%v: store size mismatch; expected %d, got %d", g.typ, g.store, got)
+		}
+		if got := dl.AllocSize(g.typ); got != g.alloc {
+			t.Errorf("%v: alloc size mismatch; expected %d, got %d", g.typ, g.alloc, got)
+		}
+		if got := dl.ABIAlign(g.typ); got != g.abiAlign {
+			t.Errorf("%v: ABI alignment mismatch; expected %d, got %d", g.typ, g.abiAlign, got)
+		}
+		if got := dl.PrefAlign(g.typ); got != g.prefAlign {
+			t.Errorf("%v: preferred alignment mismatch; expected %d, got %d", g.typ, g.prefAlign, got)
+		}
+	}
+}
+
+func TestDefaultAlign(t *testing.T) {
+	dl := datalayout.Default()
+	if got := dl.ABIAlign(types.I64); got != 4 {
+		t.Errorf("i64 ABI alignment mismatch; expected 4, got %d", got)
+	}
+	if got := dl.PrefAlign(types.I64); got != 8 {
+		t.Errorf("i64 preferred alignment mismatch; expected 8, got %d", got)
+	}
+}
+
+func TestFieldsLayout(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := []types.Type{types.I8, types.I32, types.I16, types.I64}
+	golden := []struct {
+		packed bool
+		want   *datalayout.StructLayout
+	}{
+		{packed: false, want: &datalayout.StructLayout{Size: 24, Align: 8, Offsets: []int64{0, 4, 8, 16}}},
+		{packed: true, want: &datalayout.StructLayout{Size: 15, Align: 1, Offsets: []int64{0, 1, 5, 7}}},
+	}
+	for _, g := range golden {
+		got := dl.FieldsLayout(fields, g.packed)
+		if !reflect.DeepEqual(got, g.want) {
+			t.Errorf("packed %v: layout mismatch; expected %+v, got %+v", g.packed, g.want, got)
+		}
+	}
+	layout := dl.FieldsLayout(fields, false)
+	for offset, want := range map[int64]int{0: 0, 3: 0, 4: 1, 10: 2, 16: 3, 24: -1} {
+		if got := layout.FieldIndex(offset); got != want {
+			t.Errorf("offset %d: field index mismatch; expected %d, got %d", offset, want, got)
+		}
+	}
+}
+
+func TestIndexedOffset(t *testing.T) {
+	dl, err := datalayout.Parse(x86_64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// %T = type { i8, [4 x { i16, i32 }] }
+	inner := types.NewStruct(types.I16, types.I32)
+	typ := types.NewStruct(types.I8, types.NewArray(inner, 4))
+	offset, elem, err := dl.IndexedOffset(typ, []int64{1, 1, 2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 36 (sizeof %T) + 4 (field 1) + 2*8 (element 2) + 4 (field 1)
+	if offset != 60 {
+		t.Errorf("offset mismatch; expected 60, got %d", offset)
+	}
+	if !elem.Equal(types.I32) {
+		t.Errorf("element type mismatch; expected %v, got %v", types.I32, elem)
+	}
+	if _, _, err := dl.IndexedOffset(typ, []int64{0, 2}); err == nil {
+		t.Errorf("expected error for out of bounds struct field index, got nil")
+	}
+	if _, _, err := dl.IndexedOffset(types.I32, []int64{0, 0}); err == nil {
+		t.Errorf("expected error for indexing into non-aggregate type, got nil")
+	}
+	scalable := &types.VectorType{Elem: types.I32, Len: 4, Scalable: true}
+	if offset, _, err := dl.IndexedOffset(scalable, []int64{0}); err != nil || offset != 0 {
+		t.Errorf("expected offset 0 of scalable vector type, got %d (err: %v)", offset, err)
+	}
+	if _, _, err := dl.IndexedOffset(scalable, []int64{1}); err == nil {
+		t.Errorf("expected error for stepping over scalable vector type, got nil")
+	}
+	if _, _, err := dl.IndexedOffset(scalable, []int64{0, 1}); err == nil {
+		t.Errorf("expected error for indexing into scalable vector type, got nil")
+	}
+}
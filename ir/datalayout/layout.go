@@ -0,0 +1,329 @@
+package datalayout
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir/types"
+)
+
+// --- [ Type sizes ] ----------------------------------------------------------
+
+// TypeSize returns the size in bits of the given type; i.e. the minimum number
+// of bits required to hold a value of the type.
+//
+// TypeSize panics if the given type is unsized or a scalable vector type.
+func (dl *DataLayout) TypeSize(t types.Type) int64 {
+	switch t := t.(type) {
+	case *types.IntType:
+		return int64(t.Size)
+	case *types.FloatType:
+		return floatSize(t.Kind)
+	case *types.PointerType:
+		return dl.PointerSize(t.AddrSpace)
+	case *types.LabelType:
+		return dl.PointerSize(0)
+	case *types.VectorType:
+		if t.Scalable {
+			panic(fmt.Errorf("unable to compute size of scalable vector type %v", t))
+		}
+		return t.Len * dl.TypeSize(t.Elem)
+	case *types.MMXType:
+		return 64
+	case *types.ArrayType:
+		return t.Len * dl.AllocSize(t.Elem) * 8
+	case *types.StructType:
+		return dl.StructLayout(t).Size * 8
+	default:
+		panic(fmt.Errorf("unable to compute size of unsized type %T", t))
+	}
+}
+
+// StoreSize returns the size in bytes of the given type; i.e. the maximum
+// number of bytes which may be overwritten by storing a value of the type.
+//
+// StoreSize panics if the given type is unsized.
+func (dl *DataLayout) StoreSize(t types.Type) int64 {
+	return (dl.TypeSize(t) + 7) / 8
+}
+
+// AllocSize returns the size in bytes of the given type, including alignment
+// padding; i.e. the offset in bytes between successive values of the type in
+// an array.
+//
+// AllocSize panics if the given type is unsized.
+func (dl *DataLayout) AllocSize(t types.Type) int64 {
+	return alignTo(dl.StoreSize(t), dl.ABIAlign(t))
+}
+
+// PointerSize returns the size in bits of pointers in the given address space.
+func (dl *DataLayout) PointerSize(addrSpace int64) int64 {
+	return dl.pointerSpec(addrSpace).Size
+}
+
+// IndexSize returns the size in bits of indices used for address calculation
+// of pointers in the given address space.
+func (dl *DataLayout) IndexSize(addrSpace int64) int64 {
+	return dl.pointerSpec(addrSpace).IndexSize
+}
+
+// --- [ Type alignments ] -----------------------------------------------------
+
+// ABIAlign returns the minimum alignment in bytes of the given type, as
+// required by the ABI.
+//
+// ABIAlign panics if the given type is unsized.
+func (dl *DataLayout) ABIAlign(t types.Type) int64 {
+	return dl.align(t, true)
+}
+
+// PrefAlign returns the preferred alignment in bytes of the given type.
+//
+// PrefAlign panics if the given type is unsized.
+func (dl *DataLayout) PrefAlign(t types.Type) int64 {
+	return dl.align(t, false)
+}
+
+// align returns the ABI or preferred alignment in bytes of the given type.
+func (dl *DataLayout) align(t types.Type, abi bool) int64 {
+	pick := func(spec AlignSpec) int64 {
+		if abi {
+			return spec.ABIAlign
+		}
+		return spec.PrefAlign
+	}
+	switch t := t.(type) {
+	case *types.IntType:
+		// Use the alignment of the next larger integer type if no exact match is
+		// present, or the largest integer type if none is larger.
+		if len(dl.Ints) == 0 {
+			return naturalAlign(dl.StoreSize(t))
+		}
+		for _, spec := range dl.Ints {
+			if spec.Size >= int64(t.Size) {
+				return pick(spec)
+			}
+		}
+		return pick(dl.Ints[len(dl.Ints)-1])
+	case *types.FloatType:
+		if spec, ok := findAlignSpec(dl.Floats, floatSize(t.Kind)); ok {
+			return pick(spec)
+		}
+		return naturalAlign(dl.StoreSize(t))
+	case *types.VectorType, *types.MMXType:
+		if spec, ok := findAlignSpec(dl.Vectors, dl.TypeSize(t)); ok {
+			return pick(spec)
+		}
+		// Vector types are naturally aligned by default.
+		return naturalAlign(dl.StoreSize(t))
+	case *types.PointerType:
+		spec := dl.pointerSpec(t.AddrSpace)
+		if abi {
+			return spec.ABIAlign
+		}
+		return spec.PrefAlign
+	case *types.LabelType:
+		spec := dl.pointerSpec(0)
+		if abi {
+			return spec.ABIAlign
+		}
+		return spec.PrefAlign
+	case *types.ArrayType:
+		return dl.align(t.Elem, abi)
+	case *types.StructType:
+		// Packed struct types always have an ABI alignment of one byte.
+		if t.Packed && abi {
+			return 1
+		}
+		layout := dl.StructLayout(t)
+		return max(pick(dl.Aggregate), layout.Align)
+	default:
+		panic(fmt.Errorf("unable to compute alignment of unsized type %T", t))
+	}
+}
+
+// pointerSpec returns the pointer specification of the given address space,
+// falling back to that of address space 0.
+func (dl *DataLayout) pointerSpec(addrSpace int64) PointerSpec {
+	var def PointerSpec
+	for _, spec := range dl.Pointers {
+		if spec.AddrSpace == addrSpace {
+			return spec
+		}
+		if spec.AddrSpace == 0 {
+			def = spec
+		}
+	}
+	if def.Size == 0 {
+		return Default().Pointers[0]
+	}
+	return def
+}
+
+// --- [ Struct layouts ] ------------------------------------------------------
+
+// A StructLayout specifies the layout in memory of a struct type.
+type StructLayout struct {
+	// Size in bytes, including tail padding.
+	Size int64
+	// Alignment in bytes.
+	Align int64
+	// Offset in bytes of each field.
+	Offsets []int64
+}
+
+// StructLayout returns the layout in memory of the given struct type.
+//
+// StructLayout panics if the given struct type is opaque.
+func (dl *DataLayout) StructLayout(t *types.StructType) *StructLayout {
+	if t.Opaque {
+		panic(fmt.Errorf("unable to compute layout of opaque struct type %q", t.Name))
+	}
+	return dl.FieldsLayout(t.Fields, t.Packed)
+}
+
+// FieldsLayout returns the layout in memory of a struct type with the given
+// fields. Fields of packed structs have no alignment padding and the struct has
+// an alignment of one byte.
+func (dl *DataLayout) FieldsLayout(fields []types.Type, packed bool) *StructLayout {
+	layout := &StructLayout{
+		Align:   1,
+		Offsets: make([]int64, len(fields)),
+	}
+	for i, field := range fields {
+		var align int64 = 1
+		if !packed {
+			align = dl.ABIAlign(field)
+		}
+		// Add padding to align the field.
+		layout.Size = alignTo(layout.Size, align)
+		layout.Align = max(layout.Align, align)
+		layout.Offsets[i] = layout.Size
+		layout.Size += dl.AllocSize(field)
+	}
+	// Add tail padding to align successive structs in arrays.
+	layout.Size = alignTo(layout.Size, layout.Align)
+	return layout
+}
+
+// FieldIndex returns the index of the field containing the given byte offset,
+// or -1 if the offset is outside of the struct.
+func (l *StructLayout) FieldIndex(offset int64) int {
+	if offset < 0 || offset >= l.Size {
+		return -1
+	}
+	index := -1
+	for i, off := range l.Offsets {
+		if off > offset {
+			break
+		}
+		index = i
+	}
+	return index
+}
+
+// --- [ Element offsets ] -----------------------------------------------------
+
+// IndexedOffset returns the offset in bytes, relative to the base pointer, of
+// the element addressed by the given constant getelementptr indices, and the
+// type of the addressed element. The first index steps over values of the
+// given element type, and subsequent indices step into aggregates.
+func (dl *DataLayout) IndexedOffset(elemType types.Type, indices []int64) (int64, types.Type, error) {
+	if len(indices) == 0 {
+		return 0, elemType, nil
+	}
+	offset, err := dl.scaledOffset(elemType, indices[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	t := elemType
+	for _, index := range indices[1:] {
+		if types.IsScalableVector(t) {
+			return 0, nil, fmt.Errorf("unable to index into scalable vector type %v", t)
+		}
+		elem, err := types.ElemType(t, index)
+		if err != nil {
+			return 0, nil, err
+		}
+		if st, ok := t.(*types.StructType); ok {
+			offset += dl.StructLayout(st).Offsets[index]
+		} else {
+			off, err := dl.scaledOffset(elem, index)
+			if err != nil {
+				return 0, nil, err
+			}
+			offset += off
+		}
+		t = elem
+	}
+	return offset, t, nil
+}
+
+// scaledOffset returns the offset in bytes of the given number of successive
+// values of type t. An error is returned if the size of t is not known at
+// compile time; i.e. for scalable vector types.
+func (dl *DataLayout) scaledOffset(t types.Type, index int64) (int64, error) {
+	if index == 0 {
+		return 0, nil
+	}
+	if types.IsScalableVector(t) {
+		return 0, fmt.Errorf("unable to compute offset of %d values of scalable vector type %v", index, t)
+	}
+	return index * dl.AllocSize(t), nil
+}
+
+// ### [ Helper functions ] ####################################################
+
+// floatSize returns the size in bits of the given floating-point kind.
+func floatSize(kind types.FloatKind) int64 {
+	switch kind {
+	case types.FloatKindIEEE_16, types.FloatKindBrain_16:
+		return 16
+	case types.FloatKindIEEE_32:
+		return 32
+	case types.FloatKindIEEE_64:
+		return 64
+	case types.FloatKindDoubleExtended_80:
+		return 80
+	case types.FloatKindIEEE_128, types.FloatKindDoubleDouble_128:
+		return 128
+	default:
+		panic(fmt.Errorf("support for floating-point kind %v not yet implemented", kind))
+	}
+}
+
+// findAlignSpec returns the alignment specification of the given size. The
+// boolean return value reports whether such a specification was present.
+func findAlignSpec(specs []AlignSpec, size int64) (AlignSpec, bool) {
+	for _, spec := range specs {
+		if spec.Size == size {
+			return spec, true
+		}
+	}
+	return AlignSpec{}, false
+}
+
+// naturalAlign returns the natural alignment of a value of the given size in
+// bytes; i.e. the smallest power of two greater than or equal to size.
+func naturalAlign(size int64) int64 {
+	align := int64(1)
+	for align < size {
+		align <<= 1
+	}
+	return align
+}
+
+// alignTo rounds up the given value to the nearest multiple of align.
+func alignTo(x, align int64) int64 {
+	if align <= 1 {
+		return x
+	}
+	return (x + align - 1) / align * align
+}
+
+// max returns the larger of x or y.
+func max(x, y int64) int64 {
+	if x > y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,61 @@
+// === [ Debug information ] ===================================================
+//
+// References:
+//    http://llvm.org/docs/SourceLevelDebugging.html
+
+package ir
+
+import (
+	"github.com/llir/llvm/ir/metadata"
+)
+
+// Subprogram returns the subprogram debug information attached to the function
+// through its !dbg metadata attachment; or nil if not present.
+func (f *Function) Subprogram() *metadata.DISubprogram {
+	sp, _ := findAttachment(f.Metadata, "dbg").(*metadata.DISubprogram)
+	return sp
+}
+
+// SourceLine returns the source filename and line of the function, as recorded
+// by its subprogram debug information. The boolean return value reports
+// whether the function has subprogram debug information.
+func (f *Function) SourceLine() (filename string, line int64, ok bool) {
+	sp := f.Subprogram()
+	if sp == nil {
+		return "", 0, false
+	}
+	if sp.File != nil {
+		filename = sp.File.Filename
+	}
+	return filename, sp.Line, true
+}
+
+// DebugLoc returns the source location debug information attached to the given
+// instruction through its !dbg metadata attachment; or nil if not present.
+func DebugLoc(inst Instruction) *metadata.DILocation {
+	loc, _ := findAttachment(inst.GetMetadata(), "dbg").(*metadata.DILocation)
+	return loc
+}
+
+// InlinedAtChain returns the inlined-at chain of the given instruction; i.e.
+// the source location of the instruction followed by the call site locations
+// into which it was inlined, from innermost to outermost. The chain is empty if
+// the instruction has no source location debug information.
+func InlinedAtChain(inst Instruction) []*metadata.DILocation {
+	loc := DebugLoc(inst)
+	if loc == nil {
+		return nil
+	}
+	return loc.InlinedAtChain()
+}
+
+// findAttachment returns the metadata node of the given metadata kind name in
+// the list of metadata attachments; or nil if not present.
+func findAttachment(mds []*metadata.Attachment, name string) metadata.Node {
+	for _, md := range mds {
+		if md.Name == name {
+			return md.Node
+		}
+	}
+	return nil
+}
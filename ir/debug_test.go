@@ -0,0 +1,52 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/types"
+)
+
+func TestFunctionSourceLine(t *testing.T) {
+	f := ir.NewFunction("f", types.Void)
+	if _, _, ok := f.SourceLine(); ok {
+		t.Errorf("expected no source line for function without debug information")
+	}
+	file := metadata.NewDIFile("foo.c", "/tmp")
+	sp := metadata.NewDISubprogram("f", file, 42)
+	f.Metadata = append(f.Metadata, metadata.NewAttachment("dbg", sp))
+	if got := f.Subprogram(); got != sp {
+		t.Errorf("subprogram mismatch; expected %v, got %v", sp, got)
+	}
+	filename, line, ok := f.SourceLine()
+	if !ok || filename != "foo.c" || line != 42 {
+		t.Errorf("source line mismatch; expected (%q, %d, true), got (%q, %d, %v)", "foo.c", 42, filename, line, ok)
+	}
+}
+
+func TestDebugLoc(t *testing.T) {
+	f := ir.NewFunction("f", types.I32)
+	block := f.NewBlock("")
+	one := constant.NewInt(1, types.I32)
+	inst := block.NewAdd(one, one)
+	if loc := ir.DebugLoc(inst); loc != nil {
+		t.Errorf("expected no source location, got %v", loc)
+	}
+	if chain := ir.InlinedAtChain(inst); len(chain) != 0 {
+		t.Errorf("expected empty inlined-at chain, got %v", chain)
+	}
+	sp := metadata.NewDISubprogram("f", nil, 1)
+	callSite := metadata.NewDILocation(10, 5, sp)
+	loc := metadata.NewDILocation(2, 3, sp)
+	loc.InlinedAt = callSite
+	inst.Metadata = append(inst.Metadata, metadata.NewAttachment("dbg", loc))
+	if got := ir.DebugLoc(inst); got != loc {
+		t.Errorf("source location mismatch; expected %v, got %v", loc, got)
+	}
+	chain := ir.InlinedAtChain(inst)
+	if len(chain) != 2 || chain[0] != loc || chain[1] != callSite {
+		t.Errorf("inlined-at chain mismatch; expected [%v %v], got %v", loc, callSite, chain)
+	}
+}
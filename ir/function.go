@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -24,6 +26,9 @@ import (
 //
 // Functions may be referenced from terminators (e.g. call), and are thus
 // considered LLVM IR values of function type.
+//
+// Contrary to global variables, functions have no thread-local storage model,
+// as LLVM does not permit thread-local functions.
 type Function struct {
 	// Parent module of the function.
 	Parent *Module
@@ -33,6 +38,32 @@ type Function struct {
 	Typ *types.PointerType
 	// Function type.
 	Sig *types.FuncType
+	// Personality function used for exception handling; or nil if not present.
+	Personality constant.Constant
+	// Linkage type of the function.
+	Linkage Linkage
+	// Runtime preemption specifier of the function.
+	Preemption Preemption
+	// Visibility style of the function.
+	Visibility Visibility
+	// DLL storage class of the function.
+	DLLStorageClass DLLStorageClass
+	// Unnamed address specifier of the function.
+	UnnamedAddr UnnamedAddr
+	// Return value attributes of the function.
+	ReturnAttrs []types.ParamAttribute
+	// Function attributes of the function.
+	FuncAttrs []FuncAttribute
+	// Section name of the function; or empty if not present.
+	Section string
+	// Partition name of the function; or empty if not present.
+	Partition string
+	// Comdat of the function; or nil if not present.
+	Comdat *Comdat
+	// Alignment in bytes of the function; or 0 if not present.
+	Align int
+	// Metadata attachments of the function.
+	Metadata []*metadata.Attachment
 	// Basic blocks of the function; or nil if defined externally.
 	Blocks []*BasicBlock
 }
@@ -81,6 +112,21 @@ func (f *Function) String() string {
 
 	// Function signature.
 	sig := &bytes.Buffer{}
+	if f.Linkage != LinkageExternal {
+		fmt.Fprintf(sig, "%s ", f.Linkage)
+	}
+	if f.Preemption != PreemptionDSOPreemptable {
+		fmt.Fprintf(sig, "%s ", f.Preemption)
+	}
+	if f.Visibility != VisibilityDefault {
+		fmt.Fprintf(sig, "%s ", f.Visibility)
+	}
+	if f.DLLStorageClass != DLLStorageNone {
+		fmt.Fprintf(sig, "%s ", f.DLLStorageClass)
+	}
+	for _, attr := range f.ReturnAttrs {
+		fmt.Fprintf(sig, "%s ", attr)
+	}
 	fmt.Fprintf(sig, "%s %s(",
 		f.Sig.Ret,
 		f.Ident())
@@ -89,14 +135,14 @@ func (f *Function) String() string {
 		if i != 0 {
 			sig.WriteString(", ")
 		}
+		sig.WriteString(param.Type().String())
+		for _, attr := range param.Attrs {
+			fmt.Fprintf(sig, " %s", attr)
+		}
 		// Use same output format as Clang. Don't output local ID for unnamed
 		// function parameters.
 		if len(param.Name) > 0 && !isLocalID(param.Name) {
-			fmt.Fprintf(sig, "%s %s",
-				param.Type(),
-				param.Ident())
-		} else {
-			sig.WriteString(param.Type().String())
+			fmt.Fprintf(sig, " %s", param.Ident())
 		}
 	}
 	if f.Sig.Variadic {
@@ -106,11 +152,44 @@ func (f *Function) String() string {
 		sig.WriteString("...")
 	}
 	sig.WriteString(")")
+	if f.UnnamedAddr != UnnamedAddrNone {
+		fmt.Fprintf(sig, " %s", f.UnnamedAddr)
+	}
+	for _, attr := range f.FuncAttrs {
+		fmt.Fprintf(sig, " %s", attr)
+	}
+	if len(f.Section) > 0 {
+		fmt.Fprintf(sig, " section \"%s\"", enc.Escape(f.Section))
+	}
+	if len(f.Partition) > 0 {
+		fmt.Fprintf(sig, " partition \"%s\"", enc.Escape(f.Partition))
+	}
+	if f.Comdat != nil {
+		if f.Comdat.Name == f.Name {
+			sig.WriteString(" comdat")
+		} else {
+			fmt.Fprintf(sig, " comdat(%s)", f.Comdat.Ident())
+		}
+	}
+	if f.Align != 0 {
+		fmt.Fprintf(sig, " align %d", f.Align)
+	}
+	if f.Personality != nil {
+		fmt.Fprintf(sig, " personality %s %s",
+			f.Personality.Type(),
+			f.Personality.Ident())
+	}
+
+	// Metadata attachments.
+	md := &bytes.Buffer{}
+	for _, attachment := range f.Metadata {
+		fmt.Fprintf(md, " %s", attachment)
+	}
 
 	// Function definition.
 	if len(f.Blocks) > 0 {
 		buf := &bytes.Buffer{}
-		fmt.Fprintf(buf, "define %s {\n", sig)
+		fmt.Fprintf(buf, "define %s%s {\n", sig, md)
 		for _, block := range f.Blocks {
 			fmt.Fprintln(buf, block)
 		}
@@ -119,7 +198,7 @@ func (f *Function) String() string {
 	}
 
 	// External function declaration.
-	return fmt.Sprintf("declare %s", sig)
+	return fmt.Sprintf("declare%s %s", md, sig)
 }
 
 // Params returns the parameters of the function.
@@ -202,6 +281,11 @@ func assignIDs(f *Function) {
 			// Assign local IDs to unnamed local variables.
 			setName(n)
 		}
+		// Assign local IDs to unnamed local variables produced by terminators
+		// (e.g. invoke).
+		if n, ok := block.Term.(value.Named); ok && !n.Type().Equal(types.Void) {
+			setName(n)
+		}
 	}
 }
 
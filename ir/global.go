@@ -6,10 +6,12 @@
 package ir
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 )
 
@@ -33,6 +35,29 @@ type Global struct {
 	Init constant.Constant
 	// Immutability of the global variable.
 	IsConst bool
+	// Linkage type of the global variable.
+	Linkage Linkage
+	// Runtime preemption specifier of the global variable.
+	Preemption Preemption
+	// Visibility style of the global variable.
+	Visibility Visibility
+	// DLL storage class of the global variable.
+	DLLStorageClass DLLStorageClass
+	// Thread-local storage model of the global variable; or TLSNone if not
+	// thread-local.
+	ThreadLocal ThreadLocalMode
+	// Unnamed address specifier of the global variable.
+	UnnamedAddr UnnamedAddr
+	// Section name of the global variable; or empty if not present.
+	Section string
+	// Partition name of the global variable; or empty if not present.
+	Partition string
+	// Comdat of the global variable; or nil if not present.
+	Comdat *Comdat
+	// Alignment in bytes of the global variable; or 0 if not present.
+	Align int
+	// Metadata attachments of the global variable.
+	Metadata []*metadata.Attachment
 }
 
 // NewGlobalDecl returns a new external global variable declaration based on the
@@ -85,21 +110,64 @@ func (*Global) Immutable() {}
 
 // String returns the LLVM syntax representation of the global variable.
 func (global *Global) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s =", global.Ident())
+	switch {
+	case global.Init == nil && global.Linkage == LinkageExternal:
+		// External global variable declaration.
+		buf.WriteString(" external")
+	case global.Linkage != LinkageExternal:
+		fmt.Fprintf(buf, " %s", global.Linkage)
+	}
+	if global.Preemption != PreemptionDSOPreemptable {
+		fmt.Fprintf(buf, " %s", global.Preemption)
+	}
+	if global.Visibility != VisibilityDefault {
+		fmt.Fprintf(buf, " %s", global.Visibility)
+	}
+	if global.DLLStorageClass != DLLStorageNone {
+		fmt.Fprintf(buf, " %s", global.DLLStorageClass)
+	}
+	if global.ThreadLocal != TLSNone {
+		fmt.Fprintf(buf, " %s", global.ThreadLocal)
+	}
+	if global.UnnamedAddr != UnnamedAddrNone {
+		fmt.Fprintf(buf, " %s", global.UnnamedAddr)
+	}
 	imm := "global"
 	if global.IsConst {
 		imm = "constant"
 	}
 	if global.Init != nil {
 		// Global variable definition.
-		return fmt.Sprintf("%s = %s %s %s",
-			global.Ident(),
+		fmt.Fprintf(buf, " %s %s %s",
 			imm,
 			global.Init.Type(),
 			global.Init.Ident())
+	} else {
+		// External global variable declaration.
+		fmt.Fprintf(buf, " %s %s",
+			imm,
+			global.Content)
+	}
+	if len(global.Section) > 0 {
+		fmt.Fprintf(buf, ", section \"%s\"", enc.Escape(global.Section))
+	}
+	if len(global.Partition) > 0 {
+		fmt.Fprintf(buf, ", partition \"%s\"", enc.Escape(global.Partition))
+	}
+	if global.Comdat != nil {
+		if global.Comdat.Name == global.Name {
+			buf.WriteString(", comdat")
+		} else {
+			fmt.Fprintf(buf, ", comdat(%s)", global.Comdat.Ident())
+		}
+	}
+	if global.Align != 0 {
+		fmt.Fprintf(buf, ", align %d", global.Align)
+	}
+	for _, md := range global.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
 	}
-	// External global variable declaration.
-	return fmt.Sprintf("%s = external %s %s",
-		global.Ident(),
-		imm,
-		global.Content)
+	return buf.String()
 }
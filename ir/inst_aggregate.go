@@ -0,0 +1,188 @@
+// === [ Aggregate instructions ] ==============================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#aggregate-operations
+
+package ir
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// --- [ extractvalue ] --------------------------------------------------------
+
+// InstExtractValue represents an extractvalue instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#extractvalue-instruction
+type InstExtractValue struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Type of the instruction.
+	Typ types.Type
+	// Aggregate value.
+	X value.Value
+	// Element indices.
+	Indices []int64
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewExtractValue returns a new extractvalue instruction based on the given
+// aggregate value and indices.
+func NewExtractValue(x value.Value, indices ...int64) *InstExtractValue {
+	typ, err := types.AggregateElemType(x.Type(), indices)
+	if err != nil {
+		panic(err)
+	}
+	return &InstExtractValue{
+		Typ:     typ,
+		X:       x,
+		Indices: indices,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstExtractValue) Type() types.Type {
+	return inst.Typ
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstExtractValue) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstExtractValue) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstExtractValue) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstExtractValue) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = extractvalue %s %s",
+		inst.Ident(),
+		inst.X.Type(),
+		inst.X.Ident())
+	for _, index := range inst.Indices {
+		fmt.Fprintf(buf, ", %d", index)
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstExtractValue) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstExtractValue) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstExtractValue) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// --- [ insertvalue ] ---------------------------------------------------------
+
+// InstInsertValue represents an insertvalue instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#insertvalue-instruction
+type InstInsertValue struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Aggregate value.
+	X value.Value
+	// Element to insert.
+	Elem value.Value
+	// Element indices.
+	Indices []int64
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewInsertValue returns a new insertvalue instruction based on the given
+// aggregate value, element and indices.
+func NewInsertValue(x, elem value.Value, indices ...int64) *InstInsertValue {
+	return &InstInsertValue{
+		X:       x,
+		Elem:    elem,
+		Indices: indices,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstInsertValue) Type() types.Type {
+	return inst.X.Type()
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstInsertValue) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstInsertValue) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstInsertValue) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstInsertValue) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = insertvalue %s %s, %s %s",
+		inst.Ident(),
+		inst.X.Type(),
+		inst.X.Ident(),
+		inst.Elem.Type(),
+		inst.Elem.Ident())
+	for _, index := range inst.Indices {
+		fmt.Fprintf(buf, ", %d", index)
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstInsertValue) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstInsertValue) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstInsertValue) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
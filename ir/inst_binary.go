@@ -8,9 +8,11 @@
 package ir
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -28,6 +30,10 @@ type InstAdd struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewAdd returns a new add instruction based on the given operands.
@@ -61,11 +67,19 @@ func (inst *InstAdd) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstAdd) String() string {
-	return fmt.Sprintf("%s = add %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = add", inst.Ident())
+	for _, flag := range inst.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -78,6 +92,11 @@ func (inst *InstAdd) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAdd) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fadd ] ----------------------------------------------------------------
 
 // InstFAdd represents a floating-point addition instruction.
@@ -91,6 +110,10 @@ type InstFAdd struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFAdd returns a new fadd instruction based on the given operands.
@@ -124,11 +147,19 @@ func (inst *InstFAdd) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFAdd) String() string {
-	return fmt.Sprintf("%s = fadd %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = fadd", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -141,6 +172,11 @@ func (inst *InstFAdd) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFAdd) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ sub ] -----------------------------------------------------------------
 
 // InstSub represents a subtraction instruction.
@@ -154,6 +190,10 @@ type InstSub struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSub returns a new sub instruction based on the given operands.
@@ -187,11 +227,19 @@ func (inst *InstSub) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSub) String() string {
-	return fmt.Sprintf("%s = sub %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = sub", inst.Ident())
+	for _, flag := range inst.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -204,6 +252,11 @@ func (inst *InstSub) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSub) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fsub ] ----------------------------------------------------------------
 
 // InstFSub represents a floating-point subtraction instruction.
@@ -217,6 +270,10 @@ type InstFSub struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFSub returns a new fsub instruction based on the given operands.
@@ -250,11 +307,19 @@ func (inst *InstFSub) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFSub) String() string {
-	return fmt.Sprintf("%s = fsub %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = fsub", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -267,6 +332,11 @@ func (inst *InstFSub) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFSub) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ mul ] -----------------------------------------------------------------
 
 // InstMul represents a multiplication instruction.
@@ -280,6 +350,10 @@ type InstMul struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewMul returns a new mul instruction based on the given operands.
@@ -313,11 +387,19 @@ func (inst *InstMul) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstMul) String() string {
-	return fmt.Sprintf("%s = mul %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = mul", inst.Ident())
+	for _, flag := range inst.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -330,6 +412,11 @@ func (inst *InstMul) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstMul) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fmul ] ----------------------------------------------------------------
 
 // InstFMul represents a floating-point multiplication instruction.
@@ -343,6 +430,10 @@ type InstFMul struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFMul returns a new fmul instruction based on the given operands.
@@ -376,11 +467,19 @@ func (inst *InstFMul) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFMul) String() string {
-	return fmt.Sprintf("%s = fmul %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = fmul", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -393,6 +492,11 @@ func (inst *InstFMul) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFMul) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ udiv ] ----------------------------------------------------------------
 
 // InstUDiv represents an unsigned division instruction.
@@ -406,6 +510,11 @@ type InstUDiv struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewUDiv returns a new udiv instruction based on the given operands.
@@ -439,11 +548,19 @@ func (inst *InstUDiv) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstUDiv) String() string {
-	return fmt.Sprintf("%s = udiv %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = udiv", inst.Ident())
+	if inst.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -456,6 +573,11 @@ func (inst *InstUDiv) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstUDiv) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ sdiv ] ----------------------------------------------------------------
 
 // InstSDiv represents a signed division instruction.
@@ -469,6 +591,11 @@ type InstSDiv struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSDiv returns a new sdiv instruction based on the given operands.
@@ -502,11 +629,19 @@ func (inst *InstSDiv) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSDiv) String() string {
-	return fmt.Sprintf("%s = sdiv %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = sdiv", inst.Ident())
+	if inst.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -519,6 +654,11 @@ func (inst *InstSDiv) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSDiv) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fdiv ] ----------------------------------------------------------------
 
 // InstFDiv represents a floating-point division instruction.
@@ -532,6 +672,10 @@ type InstFDiv struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFDiv returns a new fdiv instruction based on the given operands.
@@ -565,11 +709,19 @@ func (inst *InstFDiv) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFDiv) String() string {
-	return fmt.Sprintf("%s = fdiv %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = fdiv", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -582,6 +734,11 @@ func (inst *InstFDiv) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFDiv) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ urem ] ----------------------------------------------------------------
 
 // InstURem represents an unsigned remainder instruction.
@@ -595,6 +752,8 @@ type InstURem struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewURem returns a new urem instruction based on the given operands.
@@ -628,11 +787,12 @@ func (inst *InstURem) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstURem) String() string {
-	return fmt.Sprintf("%s = urem %s %s, %s",
+	return fmt.Sprintf("%s = urem %s %s, %s%s",
 		inst.Ident(),
 		inst.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -645,6 +805,11 @@ func (inst *InstURem) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstURem) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ srem ] ----------------------------------------------------------------
 
 // InstSRem represents a signed remainder instruction.
@@ -658,6 +823,8 @@ type InstSRem struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSRem returns a new srem instruction based on the given operands.
@@ -691,11 +858,12 @@ func (inst *InstSRem) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSRem) String() string {
-	return fmt.Sprintf("%s = srem %s %s, %s",
+	return fmt.Sprintf("%s = srem %s %s, %s%s",
 		inst.Ident(),
 		inst.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -708,6 +876,11 @@ func (inst *InstSRem) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSRem) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ frem ] ----------------------------------------------------------------
 
 // InstFRem represents a floating-point remainder instruction.
@@ -721,6 +894,10 @@ type InstFRem struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFRem returns a new frem instruction based on the given operands.
@@ -754,11 +931,19 @@ func (inst *InstFRem) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFRem) String() string {
-	return fmt.Sprintf("%s = frem %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = frem", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -770,3 +955,8 @@ func (inst *InstFRem) GetParent() *BasicBlock {
 func (inst *InstFRem) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFRem) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
@@ -8,9 +8,11 @@
 package ir
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -28,6 +30,10 @@ type InstShl struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Overflow flags.
+	OverflowFlags []OverflowFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewShl returns a new shl instruction based on the given operands.
@@ -61,11 +67,19 @@ func (inst *InstShl) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstShl) String() string {
-	return fmt.Sprintf("%s = shl %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = shl", inst.Ident())
+	for _, flag := range inst.OverflowFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -78,6 +92,11 @@ func (inst *InstShl) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstShl) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ lshr ] ----------------------------------------------------------------
 
 // InstLShr represents a logical shift right instruction.
@@ -91,6 +110,11 @@ type InstLShr struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewLShr returns a new lshr instruction based on the given operands.
@@ -124,11 +148,19 @@ func (inst *InstLShr) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstLShr) String() string {
-	return fmt.Sprintf("%s = lshr %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = lshr", inst.Ident())
+	if inst.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -141,6 +173,11 @@ func (inst *InstLShr) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstLShr) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ ashr ] ----------------------------------------------------------------
 
 // InstAShr represents an arithmetic shift right instruction.
@@ -154,6 +191,11 @@ type InstAShr struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Exact flag; the result is a poison value if any bits are shifted out or
+	// if the division has a remainder.
+	Exact bool
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewAShr returns a new ashr instruction based on the given operands.
@@ -187,11 +229,19 @@ func (inst *InstAShr) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstAShr) String() string {
-	return fmt.Sprintf("%s = ashr %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = ashr", inst.Ident())
+	if inst.Exact {
+		buf.WriteString(" exact")
+	}
+	fmt.Fprintf(buf, " %s %s, %s",
 		inst.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -204,6 +254,11 @@ func (inst *InstAShr) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAShr) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ and ] -----------------------------------------------------------------
 
 // InstAnd represents an AND instruction.
@@ -217,6 +272,8 @@ type InstAnd struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewAnd returns a new and instruction based on the given operands.
@@ -250,11 +307,12 @@ func (inst *InstAnd) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstAnd) String() string {
-	return fmt.Sprintf("%s = and %s %s, %s",
+	return fmt.Sprintf("%s = and %s %s, %s%s",
 		inst.Ident(),
 		inst.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -267,6 +325,11 @@ func (inst *InstAnd) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAnd) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ or ] ------------------------------------------------------------------
 
 // InstOr represents an OR instruction.
@@ -280,6 +343,8 @@ type InstOr struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewOr returns a new or instruction based on the given operands.
@@ -313,11 +378,12 @@ func (inst *InstOr) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstOr) String() string {
-	return fmt.Sprintf("%s = or %s %s, %s",
+	return fmt.Sprintf("%s = or %s %s, %s%s",
 		inst.Ident(),
 		inst.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -330,6 +396,11 @@ func (inst *InstOr) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstOr) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ xor ] -----------------------------------------------------------------
 
 // InstXor represents an exclusive-OR instruction.
@@ -343,6 +414,8 @@ type InstXor struct {
 	Name string
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewXor returns a new xor instruction based on the given operands.
@@ -376,11 +449,12 @@ func (inst *InstXor) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstXor) String() string {
-	return fmt.Sprintf("%s = xor %s %s, %s",
+	return fmt.Sprintf("%s = xor %s %s, %s%s",
 		inst.Ident(),
 		inst.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -392,3 +466,8 @@ func (inst *InstXor) GetParent() *BasicBlock {
 func (inst *InstXor) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstXor) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
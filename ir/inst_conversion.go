@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -30,6 +31,8 @@ type InstTrunc struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewTrunc returns a new trunc instruction based on the given source value and target type.
@@ -63,11 +66,12 @@ func (inst *InstTrunc) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstTrunc) String() string {
-	return fmt.Sprintf("%s = trunc %s %s to %s",
+	return fmt.Sprintf("%s = trunc %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -80,6 +84,11 @@ func (inst *InstTrunc) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstTrunc) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ zext ] ----------------------------------------------------------------
 
 // InstZExt represents a zero extension instruction.
@@ -95,6 +104,8 @@ type InstZExt struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewZExt returns a new zext instruction based on the given source value and target type.
@@ -128,11 +139,12 @@ func (inst *InstZExt) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstZExt) String() string {
-	return fmt.Sprintf("%s = zext %s %s to %s",
+	return fmt.Sprintf("%s = zext %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -145,6 +157,11 @@ func (inst *InstZExt) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstZExt) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ sext ] ----------------------------------------------------------------
 
 // InstSExt represents a sign extension instruction.
@@ -160,6 +177,8 @@ type InstSExt struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSExt returns a new sext instruction based on the given source value and target type.
@@ -193,11 +212,12 @@ func (inst *InstSExt) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSExt) String() string {
-	return fmt.Sprintf("%s = sext %s %s to %s",
+	return fmt.Sprintf("%s = sext %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -210,6 +230,11 @@ func (inst *InstSExt) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSExt) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fptrunc ] -------------------------------------------------------------
 
 // InstFPTrunc represents a floating-point truncation instruction.
@@ -225,6 +250,8 @@ type InstFPTrunc struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFPTrunc returns a new fptrunc instruction based on the given source value and target type.
@@ -258,11 +285,12 @@ func (inst *InstFPTrunc) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFPTrunc) String() string {
-	return fmt.Sprintf("%s = fptrunc %s %s to %s",
+	return fmt.Sprintf("%s = fptrunc %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -275,6 +303,11 @@ func (inst *InstFPTrunc) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFPTrunc) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fpext ] ---------------------------------------------------------------
 
 // InstFPExt represents a floating-point extension instruction.
@@ -290,6 +323,8 @@ type InstFPExt struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFPExt returns a new fpext instruction based on the given source value and target type.
@@ -323,11 +358,12 @@ func (inst *InstFPExt) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFPExt) String() string {
-	return fmt.Sprintf("%s = fpext %s %s to %s",
+	return fmt.Sprintf("%s = fpext %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -340,6 +376,11 @@ func (inst *InstFPExt) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFPExt) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fptoui ] --------------------------------------------------------------
 
 // InstFPToUI represents a floating-point to unsigned integer conversion instruction.
@@ -355,6 +396,8 @@ type InstFPToUI struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFPToUI returns a new fptoui instruction based on the given source value and target type.
@@ -388,11 +431,12 @@ func (inst *InstFPToUI) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFPToUI) String() string {
-	return fmt.Sprintf("%s = fptoui %s %s to %s",
+	return fmt.Sprintf("%s = fptoui %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -405,6 +449,11 @@ func (inst *InstFPToUI) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFPToUI) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fptosi ] --------------------------------------------------------------
 
 // InstFPToSI represents a floating-point to signed integer conversion instruction.
@@ -420,6 +469,8 @@ type InstFPToSI struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFPToSI returns a new fptosi instruction based on the given source value and target type.
@@ -453,11 +504,12 @@ func (inst *InstFPToSI) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFPToSI) String() string {
-	return fmt.Sprintf("%s = fptosi %s %s to %s",
+	return fmt.Sprintf("%s = fptosi %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -470,6 +522,11 @@ func (inst *InstFPToSI) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFPToSI) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ uitofp ] --------------------------------------------------------------
 
 // InstUIToFP represents an unsigned integer to floating-point conversion instruction.
@@ -485,6 +542,8 @@ type InstUIToFP struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewUIToFP returns a new uitofp instruction based on the given source value and target type.
@@ -518,11 +577,12 @@ func (inst *InstUIToFP) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstUIToFP) String() string {
-	return fmt.Sprintf("%s = uitofp %s %s to %s",
+	return fmt.Sprintf("%s = uitofp %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -535,6 +595,11 @@ func (inst *InstUIToFP) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstUIToFP) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ sitofp ] --------------------------------------------------------------
 
 // InstSIToFP represents a signed integer to floating-point conversion instruction.
@@ -550,6 +615,8 @@ type InstSIToFP struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSIToFP returns a new sitofp instruction based on the given source value and target type.
@@ -583,11 +650,12 @@ func (inst *InstSIToFP) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSIToFP) String() string {
-	return fmt.Sprintf("%s = sitofp %s %s to %s",
+	return fmt.Sprintf("%s = sitofp %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -600,6 +668,11 @@ func (inst *InstSIToFP) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSIToFP) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ ptrtoint ] ------------------------------------------------------------
 
 // InstPtrToInt represents a pointer to integer conversion instruction.
@@ -615,6 +688,8 @@ type InstPtrToInt struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewPtrToInt returns a new ptrtoint instruction based on the given source value and target type.
@@ -648,11 +723,12 @@ func (inst *InstPtrToInt) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstPtrToInt) String() string {
-	return fmt.Sprintf("%s = ptrtoint %s %s to %s",
+	return fmt.Sprintf("%s = ptrtoint %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -665,6 +741,11 @@ func (inst *InstPtrToInt) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstPtrToInt) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ inttoptr ] ------------------------------------------------------------
 
 // InstIntToPtr represents an integer to pointer conversion instruction.
@@ -680,6 +761,8 @@ type InstIntToPtr struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewIntToPtr returns a new inttoptr instruction based on the given source value and target type.
@@ -713,11 +796,12 @@ func (inst *InstIntToPtr) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstIntToPtr) String() string {
-	return fmt.Sprintf("%s = inttoptr %s %s to %s",
+	return fmt.Sprintf("%s = inttoptr %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -730,6 +814,11 @@ func (inst *InstIntToPtr) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstIntToPtr) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ bitcast ] -------------------------------------------------------------
 
 // InstBitCast represents a bitcast instruction.
@@ -745,6 +834,8 @@ type InstBitCast struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewBitCast returns a new bitcast instruction based on the given source value and target type.
@@ -778,11 +869,12 @@ func (inst *InstBitCast) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstBitCast) String() string {
-	return fmt.Sprintf("%s = bitcast %s %s to %s",
+	return fmt.Sprintf("%s = bitcast %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -795,6 +887,11 @@ func (inst *InstBitCast) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstBitCast) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ addrspacecast ] -------------------------------------------------------
 
 // InstAddrSpaceCast represents an address space cast instruction.
@@ -810,6 +907,8 @@ type InstAddrSpaceCast struct {
 	From value.Value
 	// Type after conversion.
 	To types.Type
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewAddrSpaceCast returns a new addrspacecast instruction based on the given source value and target type.
@@ -843,11 +942,12 @@ func (inst *InstAddrSpaceCast) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstAddrSpaceCast) String() string {
-	return fmt.Sprintf("%s = addrspacecast %s %s to %s",
+	return fmt.Sprintf("%s = addrspacecast %s %s to %s%s",
 		inst.Ident(),
 		inst.From.Type(),
 		inst.From.Ident(),
-		inst.To)
+		inst.To,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -859,3 +959,8 @@ func (inst *InstAddrSpaceCast) GetParent() *BasicBlock {
 func (inst *InstAddrSpaceCast) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAddrSpaceCast) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/datalayout"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -32,6 +34,8 @@ type InstAlloca struct {
 	Elem types.Type
 	// Number of elements; or nil if one element.
 	NElems value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewAlloca returns a new alloca instruction based on the given element type.
@@ -67,15 +71,17 @@ func (inst *InstAlloca) SetName(name string) {
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstAlloca) String() string {
 	if inst.NElems != nil {
-		return fmt.Sprintf("%s = alloca %s, %s %s",
+		return fmt.Sprintf("%s = alloca %s, %s %s%s",
 			inst.Ident(),
 			inst.Elem,
 			inst.NElems.Type(),
-			inst.NElems.Ident())
+			inst.NElems.Ident(),
+			attachments(inst.Metadata))
 	}
-	return fmt.Sprintf("%s = alloca %s",
+	return fmt.Sprintf("%s = alloca %s%s",
 		inst.Ident(),
-		inst.Elem)
+		inst.Elem,
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -88,6 +94,11 @@ func (inst *InstAlloca) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAlloca) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ load ] ----------------------------------------------------------------
 
 // InstLoad represents a load instruction.
@@ -103,6 +114,16 @@ type InstLoad struct {
 	Typ types.Type
 	// Source address.
 	Src value.Value
+	// Volatile load.
+	Volatile bool
+	// Atomic memory ordering constraints; or OrderingNone if not atomic.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Memory alignment in bytes; or 0 if unspecified.
+	Align int
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewLoad returns a new load instruction based on the given source address.
@@ -140,11 +161,28 @@ func (inst *InstLoad) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstLoad) String() string {
-	return fmt.Sprintf("%s = load %s, %s %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = load", inst.Ident())
+	if inst.Ordering != OrderingNone {
+		buf.WriteString(" atomic")
+	}
+	if inst.Volatile {
+		buf.WriteString(" volatile")
+	}
+	fmt.Fprintf(buf, " %s, %s %s",
 		inst.Type(),
 		inst.Src.Type(),
 		inst.Src.Ident())
+	if inst.Ordering != OrderingNone {
+		writeAtomic(buf, inst.SyncScope, inst.Ordering)
+	}
+	if inst.Align != 0 {
+		fmt.Fprintf(buf, ", align %d", inst.Align)
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -157,6 +195,11 @@ func (inst *InstLoad) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstLoad) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ store ] ---------------------------------------------------------------
 
 // InstStore represents a store instruction.
@@ -170,6 +213,16 @@ type InstStore struct {
 	Src value.Value
 	// Destination address.
 	Dst value.Value
+	// Volatile store.
+	Volatile bool
+	// Atomic memory ordering constraints; or OrderingNone if not atomic.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Memory alignment in bytes; or 0 if unspecified.
+	Align int
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewStore returns a new store instruction based on the given source value and
@@ -183,11 +236,29 @@ func NewStore(src, dst value.Value) *InstStore {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstStore) String() string {
-	return fmt.Sprintf("store %s %s, %s %s",
+	buf := &bytes.Buffer{}
+	buf.WriteString("store")
+	if inst.Ordering != OrderingNone {
+		buf.WriteString(" atomic")
+	}
+	if inst.Volatile {
+		buf.WriteString(" volatile")
+	}
+	fmt.Fprintf(buf, " %s %s, %s %s",
 		inst.Src.Type(),
 		inst.Src.Ident(),
 		inst.Dst.Type(),
 		inst.Dst.Ident())
+	if inst.Ordering != OrderingNone {
+		writeAtomic(buf, inst.SyncScope, inst.Ordering)
+	}
+	if inst.Align != 0 {
+		fmt.Fprintf(buf, ", align %d", inst.Align)
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -200,12 +271,342 @@ func (inst *InstStore) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstStore) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ fence ] ---------------------------------------------------------------
 
+// InstFence represents a fence instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#fence-instruction
+type InstFence struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Atomic memory ordering constraints.
+	Ordering AtomicOrdering
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewFence returns a new fence instruction based on the given atomic memory
+// ordering constraints.
+func NewFence(ordering AtomicOrdering) *InstFence {
+	return &InstFence{
+		Ordering: ordering,
+	}
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstFence) String() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("fence")
+	writeAtomic(buf, inst.SyncScope, inst.Ordering)
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstFence) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstFence) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFence) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// AtomicOrdering represents the set of atomic memory ordering constraints.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#atomic-memory-ordering-constraints
+type AtomicOrdering int
+
+// Atomic memory ordering constraints.
+const (
+	OrderingNone      AtomicOrdering = iota // not atomic
+	OrderingUnordered                       // unordered
+	OrderingMonotonic                       // monotonic
+	OrderingAcquire                         // acquire
+	OrderingRelease                         // release
+	OrderingAcqRel                          // acq_rel: acquire and release
+	OrderingSeqCst                          // seq_cst: sequentially consistent
+)
+
+// String returns the LLVM syntax representation of the atomic memory ordering
+// constraint.
+func (ordering AtomicOrdering) String() string {
+	m := map[AtomicOrdering]string{
+		OrderingNone:      "notatomic",
+		OrderingUnordered: "unordered",
+		OrderingMonotonic: "monotonic",
+		OrderingAcquire:   "acquire",
+		OrderingRelease:   "release",
+		OrderingAcqRel:    "acq_rel",
+		OrderingSeqCst:    "seq_cst",
+	}
+	if s, ok := m[ordering]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown atomic memory ordering %d>", int(ordering))
+}
+
 // --- [ cmpxchg ] -------------------------------------------------------------
 
+// InstCmpXchg represents a cmpxchg instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cmpxchg-instruction
+type InstCmpXchg struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Type of the instruction; a struct of the loaded value type and i1.
+	Typ *types.StructType
+	// Address.
+	Ptr value.Value
+	// Value to compare against.
+	Cmp value.Value
+	// New value to store.
+	New value.Value
+	// Atomic memory ordering constraints on success.
+	Success AtomicOrdering
+	// Atomic memory ordering constraints on failure.
+	Failure AtomicOrdering
+	// Weak cmpxchg; may fail spuriously.
+	Weak bool
+	// Volatile cmpxchg.
+	Volatile bool
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewCmpXchg returns a new cmpxchg instruction based on the given address,
+// value to compare against, new value to store, and atomic memory ordering
+// constraints on success and failure.
+func NewCmpXchg(ptr, cmp, newValue value.Value, success, failure AtomicOrdering) *InstCmpXchg {
+	typ := types.NewStruct(cmp.Type(), types.I1)
+	return &InstCmpXchg{
+		Typ:     typ,
+		Ptr:     ptr,
+		Cmp:     cmp,
+		New:     newValue,
+		Success: success,
+		Failure: failure,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstCmpXchg) Type() types.Type {
+	return inst.Typ
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstCmpXchg) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstCmpXchg) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstCmpXchg) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstCmpXchg) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = cmpxchg", inst.Ident())
+	if inst.Weak {
+		buf.WriteString(" weak")
+	}
+	if inst.Volatile {
+		buf.WriteString(" volatile")
+	}
+	fmt.Fprintf(buf, " %s %s, %s %s, %s %s",
+		inst.Ptr.Type(),
+		inst.Ptr.Ident(),
+		inst.Cmp.Type(),
+		inst.Cmp.Ident(),
+		inst.New.Type(),
+		inst.New.Ident())
+	writeAtomic(buf, inst.SyncScope, inst.Success)
+	fmt.Fprintf(buf, " %s", inst.Failure)
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstCmpXchg) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstCmpXchg) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstCmpXchg) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ atomicrmw ] -----------------------------------------------------------
 
+// InstAtomicRMW represents an atomicrmw instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#atomicrmw-instruction
+type InstAtomicRMW struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Type of the instruction.
+	Typ types.Type
+	// Atomic operation.
+	Op AtomicOp
+	// Address.
+	Ptr value.Value
+	// Operand.
+	X value.Value
+	// Atomic memory ordering constraints.
+	Ordering AtomicOrdering
+	// Volatile atomicrmw.
+	Volatile bool
+	// Synchronization scope; or empty if system scope.
+	SyncScope string
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewAtomicRMW returns a new atomicrmw instruction based on the given atomic
+// operation, address, operand and atomic memory ordering constraints.
+func NewAtomicRMW(op AtomicOp, ptr, x value.Value, ordering AtomicOrdering) *InstAtomicRMW {
+	return &InstAtomicRMW{
+		Typ:      x.Type(),
+		Op:       op,
+		Ptr:      ptr,
+		X:        x,
+		Ordering: ordering,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstAtomicRMW) Type() types.Type {
+	return inst.Typ
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstAtomicRMW) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstAtomicRMW) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstAtomicRMW) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstAtomicRMW) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = atomicrmw", inst.Ident())
+	if inst.Volatile {
+		buf.WriteString(" volatile")
+	}
+	fmt.Fprintf(buf, " %s %s %s, %s %s",
+		inst.Op,
+		inst.Ptr.Type(),
+		inst.Ptr.Ident(),
+		inst.X.Type(),
+		inst.X.Ident())
+	writeAtomic(buf, inst.SyncScope, inst.Ordering)
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstAtomicRMW) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstAtomicRMW) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstAtomicRMW) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// AtomicOp represents the set of operations of the atomicrmw instruction.
+type AtomicOp int
+
+// Atomic operations.
+const (
+	AtomicXchg AtomicOp = iota + 1 // xchg: exchange
+	AtomicAdd                      // add: addition
+	AtomicSub                      // sub: subtraction
+	AtomicAnd                      // and: bitwise AND
+	AtomicNand                     // nand: bitwise NAND
+	AtomicOr                       // or: bitwise OR
+	AtomicXor                      // xor: bitwise XOR
+	AtomicMax                      // max: signed maximum
+	AtomicMin                      // min: signed minimum
+	AtomicUMax                     // umax: unsigned maximum
+	AtomicUMin                     // umin: unsigned minimum
+)
+
+// String returns the LLVM syntax representation of the atomic operation.
+func (op AtomicOp) String() string {
+	m := map[AtomicOp]string{
+		AtomicXchg: "xchg",
+		AtomicAdd:  "add",
+		AtomicSub:  "sub",
+		AtomicAnd:  "and",
+		AtomicNand: "nand",
+		AtomicOr:   "or",
+		AtomicXor:  "xor",
+		AtomicMax:  "max",
+		AtomicMin:  "min",
+		AtomicUMax: "umax",
+		AtomicUMin: "umin",
+	}
+	if s, ok := m[op]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown atomic operation %d>", int(op))
+}
+
 // --- [ getelementptr ] -------------------------------------------------------
 
 // InstGetElementPtr represents a getelementptr instruction.
@@ -225,6 +626,8 @@ type InstGetElementPtr struct {
 	Src value.Value
 	// Element indices.
 	Indices []value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewGetElementPtr returns a new getelementptr instruction based on the given
@@ -244,21 +647,25 @@ func NewGetElementPtr(src value.Value, indices ...value.Value) *InstGetElementPt
 			// ref: http://llvm.org/docs/GetElementPtr.html#why-is-the-extra-0-index-required
 			continue
 		}
-		switch t := e.(type) {
-		case *types.PointerType:
+		if types.IsPointer(e) {
 			// ref: http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep
 			panic("unable to index into element of pointer type; for more information, see http://llvm.org/docs/GetElementPtr.html#what-is-dereferenced-by-gep")
-		case *types.ArrayType:
-			e = t.Elem
-		case *types.StructType:
-			idx, ok := index.(*constant.Int)
+		}
+		// Struct fields are indexed by constant integers; array and vector
+		// elements may be indexed by arbitrary integer values.
+		var idx int64
+		if types.IsStruct(e) {
+			x, ok := index.(*constant.Int)
 			if !ok {
 				panic(fmt.Errorf("invalid index type for structure element; expected *constant.Int, got %T", index))
 			}
-			e = t.Fields[idx.Int64()]
-		default:
-			panic(fmt.Errorf("support for indexing element type %T not yet implemented", e))
+			idx = x.Int64()
 		}
+		t, err := types.ElemType(e, idx)
+		if err != nil {
+			panic(err)
+		}
+		e = t
 	}
 	typ := types.NewPointer(e)
 	return &InstGetElementPtr{
@@ -303,6 +710,9 @@ func (inst *InstGetElementPtr) String() string {
 			index.Type(),
 			index.Ident())
 	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
 	return buf.String()
 }
 
@@ -315,3 +725,35 @@ func (inst *InstGetElementPtr) GetParent() *BasicBlock {
 func (inst *InstGetElementPtr) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstGetElementPtr) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// Offset returns the offset in bytes, relative to the source address, of the
+// address computed by the getelementptr instruction under the given data
+// layout, and the type of the addressed element. An error is returned if any
+// index is not a scalar integer constant.
+func (inst *InstGetElementPtr) Offset(dl *datalayout.DataLayout) (int64, types.Type, error) {
+	indices := make([]constant.Constant, len(inst.Indices))
+	for i, index := range inst.Indices {
+		c, ok := index.(constant.Constant)
+		if !ok {
+			return 0, nil, fmt.Errorf("unable to compute offset of non-constant getelementptr index `%v %v`", index.Type(), index.Ident())
+		}
+		indices[i] = c
+	}
+	return constant.GEPOffset(dl, inst.Src.Type(), inst.Elem, indices)
+}
+
+// ### [ Helper functions ] ####################################################
+
+// writeAtomic writes the synchronization scope and atomic memory ordering
+// constraints of an atomic instruction to buf.
+func writeAtomic(buf *bytes.Buffer, syncScope string, ordering AtomicOrdering) {
+	if len(syncScope) > 0 {
+		fmt.Fprintf(buf, " syncscope(\"%s\")", enc.Escape(syncScope))
+	}
+	fmt.Fprintf(buf, " %s", ordering)
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -31,6 +33,8 @@ type InstICmp struct {
 	Cond IntPred
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewICmp returns a new icmp instruction based on the given integer condition
@@ -38,7 +42,9 @@ type InstICmp struct {
 func NewICmp(cond IntPred, x, y value.Value) *InstICmp {
 	var typ types.Type = types.I1
 	if t, ok := x.Type().(*types.VectorType); ok {
-		typ = types.NewVector(types.I1, t.Len)
+		vt := types.NewVector(types.I1, t.Len)
+		vt.Scalable = t.Scalable
+		typ = vt
 	}
 	return &InstICmp{
 		Typ:  typ,
@@ -71,12 +77,13 @@ func (inst *InstICmp) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstICmp) String() string {
-	return fmt.Sprintf("%s = icmp %s %s %s, %s",
+	return fmt.Sprintf("%s = icmp %s %s %s, %s%s",
 		inst.Ident(),
 		inst.Cond,
 		inst.X.Type(),
 		inst.X.Ident(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -89,6 +96,11 @@ func (inst *InstICmp) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstICmp) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // IntPred represents the set of condition codes of the icmp instruction.
 type IntPred int
 
@@ -144,6 +156,10 @@ type InstFCmp struct {
 	Cond FloatPred
 	// Operands.
 	X, Y value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewFCmp returns a new fcmp instruction based on the given floating-point
@@ -151,7 +167,9 @@ type InstFCmp struct {
 func NewFCmp(cond FloatPred, x, y value.Value) *InstFCmp {
 	var typ types.Type = types.I1
 	if t, ok := x.Type().(*types.VectorType); ok {
-		typ = types.NewVector(types.I1, t.Len)
+		vt := types.NewVector(types.I1, t.Len)
+		vt.Scalable = t.Scalable
+		typ = vt
 	}
 	return &InstFCmp{
 		Typ:  typ,
@@ -184,12 +202,20 @@ func (inst *InstFCmp) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstFCmp) String() string {
-	return fmt.Sprintf("%s = fcmp %s %s %s, %s",
-		inst.Ident(),
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = fcmp", inst.Ident())
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	fmt.Fprintf(buf, " %s %s %s, %s",
 		inst.Cond,
 		inst.X.Type(),
 		inst.X.Ident(),
 		inst.Y.Ident())
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -202,6 +228,11 @@ func (inst *InstFCmp) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstFCmp) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // FloatPred represents the set of condition codes of the fcmp instruction.
 type FloatPred int
 
@@ -267,6 +298,8 @@ type InstPhi struct {
 	Typ types.Type
 	// Incoming values.
 	Incs []*Incoming
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewPhi returns a new phi instruction based on the given incoming values.
@@ -316,6 +349,9 @@ func (inst *InstPhi) String() string {
 			inc.X.Ident(),
 			inc.Pred.Ident())
 	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
 	return buf.String()
 }
 
@@ -329,6 +365,11 @@ func (inst *InstPhi) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstPhi) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // Incoming represents an incoming value of a phi instruction.
 type Incoming struct {
 	// Incoming value.
@@ -361,6 +402,8 @@ type InstSelect struct {
 	Cond value.Value
 	// Operands.
 	X, Y value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewSelect returns a new select instruction based on the given selection
@@ -396,14 +439,15 @@ func (inst *InstSelect) SetName(name string) {
 
 // String returns the LLVM syntax representation of the instruction.
 func (inst *InstSelect) String() string {
-	return fmt.Sprintf("%s = select %s %s, %s %s, %s %s",
+	return fmt.Sprintf("%s = select %s %s, %s %s, %s %s%s",
 		inst.Ident(),
 		inst.Cond.Type(),
 		inst.Cond.Ident(),
 		inst.X.Type(),
 		inst.X.Ident(),
 		inst.Y.Type(),
-		inst.Y.Ident())
+		inst.Y.Ident(),
+		attachments(inst.Metadata))
 }
 
 // GetParent returns the parent basic block of the instruction.
@@ -416,6 +460,11 @@ func (inst *InstSelect) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstSelect) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ call ] ----------------------------------------------------------------
 
 // InstCall represents a call instruction.
@@ -437,7 +486,20 @@ type InstCall struct {
 	// Callee signature.
 	Sig *types.FuncType
 	// Function arguments.
+	//
+	// Args may have one of the following underlying types.
+	//
+	//    value.Value
+	//    *ir.Arg
 	Args []value.Value
+	// Fast-math flags.
+	FastMathFlags []FastMathFlag
+	// Return value attributes of the call site.
+	ReturnAttrs []types.ParamAttribute
+	// Function attributes of the call site.
+	FuncAttrs []FuncAttribute
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
 }
 
 // NewCall returns a new call instruction based on the given callee and function
@@ -496,18 +558,35 @@ func (inst *InstCall) String() string {
 	if sig.Variadic {
 		ret = sig.String()
 	}
-	fmt.Fprintf(buf, "call %s %s(",
+	buf.WriteString("call")
+	for _, flag := range inst.FastMathFlags {
+		fmt.Fprintf(buf, " %s", flag)
+	}
+	for _, attr := range inst.ReturnAttrs {
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	fmt.Fprintf(buf, " %s %s(",
 		ret,
 		inst.Callee.Ident())
 	for i, arg := range inst.Args {
 		if i != 0 {
 			buf.WriteString(", ")
 		}
+		if arg, ok := arg.(*Arg); ok {
+			buf.WriteString(arg.String())
+			continue
+		}
 		fmt.Fprintf(buf, "%s %s",
 			arg.Type(),
 			arg.Ident())
 	}
 	buf.WriteString(")")
+	for _, attr := range inst.FuncAttrs {
+		fmt.Fprintf(buf, " %s", attr)
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
 	return buf.String()
 }
 
@@ -521,10 +600,298 @@ func (inst *InstCall) SetParent(parent *BasicBlock) {
 	inst.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstCall) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ va_arg ] --------------------------------------------------------------
 
 // --- [ landingpad ] ----------------------------------------------------------
 
+// InstLandingPad represents a landingpad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#landingpad-instruction
+type InstLandingPad struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Result type.
+	Typ types.Type
+	// Specifies whether the landing pad is a cleanup landing pad.
+	Cleanup bool
+	// Landing pad clauses.
+	Clauses []*Clause
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewLandingPad returns a new landingpad instruction based on the given result
+// type and landing pad clauses.
+func NewLandingPad(typ types.Type, clauses ...*Clause) *InstLandingPad {
+	return &InstLandingPad{
+		Typ:     typ,
+		Clauses: clauses,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstLandingPad) Type() types.Type {
+	return inst.Typ
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstLandingPad) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstLandingPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstLandingPad) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstLandingPad) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = landingpad %s",
+		inst.Ident(),
+		inst.Type())
+	if inst.Cleanup {
+		buf.WriteString("\n\t\tcleanup")
+	}
+	for _, clause := range inst.Clauses {
+		fmt.Fprintf(buf, "\n\t\t%s %s %s",
+			clause.Kind,
+			clause.X.Type(),
+			clause.X.Ident())
+	}
+	for _, md := range inst.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstLandingPad) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstLandingPad) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstLandingPad) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// Clause represents a clause of a landingpad instruction.
+type Clause struct {
+	// Clause kind.
+	Kind ClauseKind
+	// Type info of a catch clause, or array of type infos of a filter clause.
+	X constant.Constant
+}
+
+// NewClause returns a new landing pad clause based on the given clause kind and
+// operand.
+func NewClause(kind ClauseKind, x constant.Constant) *Clause {
+	return &Clause{
+		Kind: kind,
+		X:    x,
+	}
+}
+
+// ClauseKind represents the set of clause kinds of the landingpad instruction.
+type ClauseKind int
+
+// Landing pad clause kinds.
+const (
+	ClauseCatch  ClauseKind = iota + 1 // catch
+	ClauseFilter                       // filter
+)
+
+// String returns the LLVM syntax representation of the clause kind.
+func (kind ClauseKind) String() string {
+	m := map[ClauseKind]string{
+		ClauseCatch:  "catch",
+		ClauseFilter: "filter",
+	}
+	if s, ok := m[kind]; ok {
+		return s
+	}
+	return fmt.Sprintf("<unknown clause kind %d>", int(kind))
+}
+
 // --- [ catchpad ] ------------------------------------------------------------
 
+// InstCatchPad represents a catchpad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchpad-instruction
+type InstCatchPad struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Parent catchswitch terminator.
+	CatchSwitch *TermCatchSwitch
+	// Exception arguments.
+	Args []value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewCatchPad returns a new catchpad instruction based on the given parent
+// catchswitch terminator and exception arguments.
+func NewCatchPad(catchSwitch *TermCatchSwitch, args ...value.Value) *InstCatchPad {
+	return &InstCatchPad{
+		CatchSwitch: catchSwitch,
+		Args:        args,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstCatchPad) Type() types.Type {
+	return types.Token
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstCatchPad) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstCatchPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstCatchPad) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstCatchPad) String() string {
+	return fmt.Sprintf("%s = catchpad within %s [%s]%s",
+		inst.Ident(),
+		inst.CatchSwitch.Ident(),
+		exceptionArgs(inst.Args),
+		attachments(inst.Metadata))
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstCatchPad) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstCatchPad) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstCatchPad) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
 // --- [ cleanuppad ] ----------------------------------------------------------
+
+// InstCleanupPad represents a cleanuppad instruction.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cleanuppad-instruction
+type InstCleanupPad struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the instruction.
+	Name string
+	// Parent exception pad; or a none token constant if not nested within
+	// another exception pad.
+	ParentPad value.Value
+	// Exception arguments.
+	Args []value.Value
+	// Metadata attachments of the instruction.
+	Metadata []*metadata.Attachment
+}
+
+// NewCleanupPad returns a new cleanuppad instruction based on the given parent
+// exception pad and exception arguments.
+func NewCleanupPad(parentPad value.Value, args ...value.Value) *InstCleanupPad {
+	return &InstCleanupPad{
+		ParentPad: parentPad,
+		Args:      args,
+	}
+}
+
+// Type returns the type of the instruction.
+func (inst *InstCleanupPad) Type() types.Type {
+	return types.Token
+}
+
+// Ident returns the identifier associated with the instruction.
+func (inst *InstCleanupPad) Ident() string {
+	return enc.Local(inst.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// instruction.
+func (inst *InstCleanupPad) GetName() string {
+	return inst.Name
+}
+
+// SetName sets the name of the local variable associated with the instruction.
+func (inst *InstCleanupPad) SetName(name string) {
+	inst.Name = name
+}
+
+// String returns the LLVM syntax representation of the instruction.
+func (inst *InstCleanupPad) String() string {
+	return fmt.Sprintf("%s = cleanuppad within %s [%s]%s",
+		inst.Ident(),
+		inst.ParentPad.Ident(),
+		exceptionArgs(inst.Args),
+		attachments(inst.Metadata))
+}
+
+// GetParent returns the parent basic block of the instruction.
+func (inst *InstCleanupPad) GetParent() *BasicBlock {
+	return inst.Parent
+}
+
+// SetParent sets the parent basic block of the instruction.
+func (inst *InstCleanupPad) SetParent(parent *BasicBlock) {
+	inst.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the instruction.
+func (inst *InstCleanupPad) GetMetadata() []*metadata.Attachment {
+	return inst.Metadata
+}
+
+// ### [ Helper functions ] ####################################################
+
+// exceptionArgs returns the LLVM syntax representation of the given exception
+// arguments of a catchpad or cleanuppad instruction.
+func exceptionArgs(args []value.Value) string {
+	buf := &bytes.Buffer{}
+	for i, arg := range args {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s %s",
+			arg.Type(),
+			arg.Ident())
+	}
+	return buf.String()
+}
@@ -5,7 +5,12 @@
 
 package ir
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/ir/metadata"
+)
 
 // An Instruction represents a non-branching LLVM IR instruction.
 //
@@ -43,6 +48,21 @@ import "fmt"
 //    *ir.InstOr     (https://godoc.org/github.com/llir/llvm/ir#InstOr)
 //    *ir.InstXor    (https://godoc.org/github.com/llir/llvm/ir#InstXor)
 //
+// Vector instructions
+//
+// http://llvm.org/docs/LangRef.html#vector-operations
+//
+//    *ir.InstExtractElement   (https://godoc.org/github.com/llir/llvm/ir#InstExtractElement)
+//    *ir.InstInsertElement    (https://godoc.org/github.com/llir/llvm/ir#InstInsertElement)
+//    *ir.InstShuffleVector    (https://godoc.org/github.com/llir/llvm/ir#InstShuffleVector)
+//
+// Aggregate instructions
+//
+// http://llvm.org/docs/LangRef.html#aggregate-operations
+//
+//    *ir.InstExtractValue   (https://godoc.org/github.com/llir/llvm/ir#InstExtractValue)
+//    *ir.InstInsertValue    (https://godoc.org/github.com/llir/llvm/ir#InstInsertValue)
+//
 // Memory instructions
 //
 // http://llvm.org/docs/LangRef.html#memory-access-and-addressing-operations
@@ -50,6 +70,9 @@ import "fmt"
 //    *ir.InstAlloca          (https://godoc.org/github.com/llir/llvm/ir#InstAlloca)
 //    *ir.InstLoad            (https://godoc.org/github.com/llir/llvm/ir#InstLoad)
 //    *ir.InstStore           (https://godoc.org/github.com/llir/llvm/ir#InstStore)
+//    *ir.InstFence           (https://godoc.org/github.com/llir/llvm/ir#InstFence)
+//    *ir.InstCmpXchg         (https://godoc.org/github.com/llir/llvm/ir#InstCmpXchg)
+//    *ir.InstAtomicRMW       (https://godoc.org/github.com/llir/llvm/ir#InstAtomicRMW)
 //    *ir.InstGetElementPtr   (https://godoc.org/github.com/llir/llvm/ir#InstGetElementPtr)
 //
 // Conversion instructions
@@ -74,15 +97,30 @@ import "fmt"
 //
 // http://llvm.org/docs/LangRef.html#other-operations
 //
-//    *ir.InstICmp     (https://godoc.org/github.com/llir/llvm/ir#InstICmp)
-//    *ir.InstFCmp     (https://godoc.org/github.com/llir/llvm/ir#InstFCmp)
-//    *ir.InstPhi      (https://godoc.org/github.com/llir/llvm/ir#InstPhi)
-//    *ir.InstSelect   (https://godoc.org/github.com/llir/llvm/ir#InstSelect)
-//    *ir.InstCall     (https://godoc.org/github.com/llir/llvm/ir#InstCall)
+//    *ir.InstICmp         (https://godoc.org/github.com/llir/llvm/ir#InstICmp)
+//    *ir.InstFCmp         (https://godoc.org/github.com/llir/llvm/ir#InstFCmp)
+//    *ir.InstPhi          (https://godoc.org/github.com/llir/llvm/ir#InstPhi)
+//    *ir.InstSelect       (https://godoc.org/github.com/llir/llvm/ir#InstSelect)
+//    *ir.InstCall         (https://godoc.org/github.com/llir/llvm/ir#InstCall)
+//    *ir.InstLandingPad   (https://godoc.org/github.com/llir/llvm/ir#InstLandingPad)
+//    *ir.InstCatchPad     (https://godoc.org/github.com/llir/llvm/ir#InstCatchPad)
+//    *ir.InstCleanupPad   (https://godoc.org/github.com/llir/llvm/ir#InstCleanupPad)
 type Instruction interface {
 	fmt.Stringer
 	// GetParent returns the parent basic block of the instruction.
 	GetParent() *BasicBlock
 	// SetParent sets the parent basic block of the instruction.
 	SetParent(parent *BasicBlock)
+	// GetMetadata returns the metadata attachments of the instruction.
+	GetMetadata() []*metadata.Attachment
+}
+
+// attachments returns the LLVM syntax representation of the given metadata
+// attachments of an instruction or terminator; e.g. ", !dbg !5".
+func attachments(mds []*metadata.Attachment) string {
+	buf := &bytes.Buffer{}
+	for _, md := range mds {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
 }
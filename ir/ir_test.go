@@ -3,12 +3,15 @@ package ir_test
 import (
 	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
 
 // Validate that the relevant types satisfy the constant.Constant interface.
 var (
 	_ constant.Constant = &ir.Global{}
+	_ constant.Constant = &ir.Alias{}
+	_ constant.Constant = &ir.IFunc{}
 	_ constant.Constant = &ir.Function{}
 )
 
@@ -35,11 +38,19 @@ var (
 	_ ir.Instruction = &ir.InstOr{}
 	_ ir.Instruction = &ir.InstXor{}
 	// Vector instructions
+	_ ir.Instruction = &ir.InstExtractElement{}
+	_ ir.Instruction = &ir.InstInsertElement{}
+	_ ir.Instruction = &ir.InstShuffleVector{}
 	// Aggregate instructions
+	_ ir.Instruction = &ir.InstExtractValue{}
+	_ ir.Instruction = &ir.InstInsertValue{}
 	// Memory instructions
 	_ ir.Instruction = &ir.InstAlloca{}
 	_ ir.Instruction = &ir.InstLoad{}
 	_ ir.Instruction = &ir.InstStore{}
+	_ ir.Instruction = &ir.InstFence{}
+	_ ir.Instruction = &ir.InstCmpXchg{}
+	_ ir.Instruction = &ir.InstAtomicRMW{}
 	_ ir.Instruction = &ir.InstGetElementPtr{}
 	// Conversion instructions
 	_ ir.Instruction = &ir.InstTrunc{}
@@ -61,6 +72,9 @@ var (
 	_ ir.Instruction = &ir.InstPhi{}
 	_ ir.Instruction = &ir.InstSelect{}
 	_ ir.Instruction = &ir.InstCall{}
+	_ ir.Instruction = &ir.InstLandingPad{}
+	_ ir.Instruction = &ir.InstCatchPad{}
+	_ ir.Instruction = &ir.InstCleanupPad{}
 )
 
 // Validate that the relevant types satisfy the ir.Terminator interface.
@@ -70,12 +84,20 @@ var (
 	_ ir.Terminator = &ir.TermBr{}
 	_ ir.Terminator = &ir.TermCondBr{}
 	_ ir.Terminator = &ir.TermSwitch{}
+	_ ir.Terminator = &ir.TermIndirectBr{}
+	_ ir.Terminator = &ir.TermInvoke{}
+	_ ir.Terminator = &ir.TermResume{}
+	_ ir.Terminator = &ir.TermCatchSwitch{}
+	_ ir.Terminator = &ir.TermCatchRet{}
+	_ ir.Terminator = &ir.TermCleanupRet{}
 	_ ir.Terminator = &ir.TermUnreachable{}
 )
 
 // Validate that the relevant types satisfy the value.Named interface.
 var (
 	_ value.Named = &ir.Global{}
+	_ value.Named = &ir.Alias{}
+	_ value.Named = &ir.IFunc{}
 	_ value.Named = &ir.Function{}
 	_ value.Named = &ir.BasicBlock{}
 	// Binary instructions
@@ -99,10 +121,17 @@ var (
 	_ value.Named = &ir.InstOr{}
 	_ value.Named = &ir.InstXor{}
 	// Vector instructions
+	_ value.Named = &ir.InstExtractElement{}
+	_ value.Named = &ir.InstInsertElement{}
+	_ value.Named = &ir.InstShuffleVector{}
 	// Aggregate instructions
+	_ value.Named = &ir.InstExtractValue{}
+	_ value.Named = &ir.InstInsertValue{}
 	// Memory instructions
 	_ value.Named = &ir.InstAlloca{}
 	_ value.Named = &ir.InstLoad{}
+	_ value.Named = &ir.InstCmpXchg{}
+	_ value.Named = &ir.InstAtomicRMW{}
 	_ value.Named = &ir.InstGetElementPtr{}
 	// Conversion instructions
 	_ value.Named = &ir.InstTrunc{}
@@ -124,4 +153,26 @@ var (
 	_ value.Named = &ir.InstPhi{}
 	_ value.Named = &ir.InstSelect{}
 	_ value.Named = &ir.InstCall{}
+	_ value.Named = &ir.InstLandingPad{}
+	_ value.Named = &ir.InstCatchPad{}
+	_ value.Named = &ir.InstCleanupPad{}
+	// Terminators
+	_ value.Named = &ir.TermInvoke{}
+	_ value.Named = &ir.TermCatchSwitch{}
+)
+
+// Validate that the relevant types satisfy the ir.FuncAttribute interface.
+var (
+	_ ir.FuncAttribute = ir.AttrKind(0)
+	_ ir.FuncAttribute = ir.AttrInt{}
+	_ ir.FuncAttribute = ir.AttrAllocSize{}
+	_ ir.FuncAttribute = ir.AttrString{}
+	_ ir.FuncAttribute = &ir.AttrGroupDef{}
+)
+
+// Validate that the relevant types satisfy the types.ParamAttribute interface.
+var (
+	_ types.ParamAttribute = ir.AttrKind(0)
+	_ types.ParamAttribute = ir.AttrInt{}
+	_ types.ParamAttribute = ir.AttrString{}
 )
@@ -9,6 +9,7 @@ import (
 
 	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
@@ -57,6 +58,9 @@ func WalkFuncBeforeAfter(f *ir.Function, before, after func(interface{})) {
 	// Traverse child nodes of function, instead of f directly, as *ir.Function
 	// nodes are not traversed when staying within the scope of the function.
 	w.walkBeforeAfter(&f.Sig, before, after)
+	if f.Personality != nil {
+		w.walkBeforeAfter(&f.Personality, before, after)
+	}
 	if f.Blocks != nil {
 		w.walkBeforeAfter(&f.Blocks, before, after)
 	}
@@ -77,7 +81,7 @@ type walker struct {
 // traversal.
 func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{})) {
 	switch x.(type) {
-	case []*ir.Global, []*ir.Function, []types.Type, []*types.Param, []value.Value, []constant.Constant, []*ir.BasicBlock, []ir.Instruction, []*ir.Incoming, []*ir.Case:
+	case []*ir.Comdat, []*ir.Global, []*ir.Alias, []*ir.IFunc, []*ir.Function, []types.Type, []*types.Param, []value.Value, []constant.Constant, []*ir.BasicBlock, []ir.Instruction, []*ir.Incoming, []*ir.Clause, []*ir.Case, []metadata.Metadata:
 		// unhashable type.
 	case *ir.Function:
 		if w.funcScope {
@@ -112,10 +116,20 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case *ir.Terminator:
 		w.walkBeforeAfter(*n, before, after)
+	case *metadata.Metadata:
+		w.walkBeforeAfter(*n, before, after)
+	case *metadata.Node:
+		w.walkBeforeAfter(*n, before, after)
 
 	// pointers to struct pointers
+	case **ir.Comdat:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.Global:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.Alias:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.IFunc:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.Function:
 		w.walkBeforeAfter(*n, before, after)
 	// Types
@@ -133,10 +147,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **types.VectorType:
 		w.walkBeforeAfter(*n, before, after)
+	case **types.MMXType:
+		w.walkBeforeAfter(*n, before, after)
 	case **types.LabelType:
 		w.walkBeforeAfter(*n, before, after)
 	case **types.MetadataType:
 		w.walkBeforeAfter(*n, before, after)
+	case **types.TokenType:
+		w.walkBeforeAfter(*n, before, after)
 	case **types.ArrayType:
 		w.walkBeforeAfter(*n, before, after)
 	case **types.StructType:
@@ -148,6 +166,8 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **constant.Null:
 		w.walkBeforeAfter(*n, before, after)
+	case **constant.NoneToken:
+		w.walkBeforeAfter(*n, before, after)
 	case **constant.Vector:
 		w.walkBeforeAfter(*n, before, after)
 	case **constant.Array:
@@ -156,6 +176,12 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **constant.ZeroInitializer:
 		w.walkBeforeAfter(*n, before, after)
+	case **constant.Undef:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.Poison:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.BlockAddress:
+		w.walkBeforeAfter(*n, before, after)
 	// Constant expressions
 	case **constant.ExprAdd:
 		w.walkBeforeAfter(*n, before, after)
@@ -193,6 +219,16 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **constant.ExprXor:
 		w.walkBeforeAfter(*n, before, after)
+	case **constant.ExprExtractElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.ExprInsertElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.ExprShuffleVector:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.ExprExtractValue:
+		w.walkBeforeAfter(*n, before, after)
+	case **constant.ExprInsertValue:
+		w.walkBeforeAfter(*n, before, after)
 	case **constant.ExprGetElementPtr:
 		w.walkBeforeAfter(*n, before, after)
 	case **constant.ExprTrunc:
@@ -267,12 +303,28 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstXor:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstExtractElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstInsertElement:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstShuffleVector:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstExtractValue:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstInsertValue:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstAlloca:
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstLoad:
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstStore:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstFence:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstCmpXchg:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstAtomicRMW:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstGetElementPtr:
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstTrunc:
@@ -313,6 +365,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.InstCall:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstLandingPad:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.Clause:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstCatchPad:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.InstCleanupPad:
+		w.walkBeforeAfter(*n, before, after)
 	// Terminators
 	case **ir.TermRet:
 		w.walkBeforeAfter(*n, before, after)
@@ -322,20 +382,49 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case **ir.TermSwitch:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermIndirectBr:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.Case:
 		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermInvoke:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermResume:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermCatchSwitch:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermCatchRet:
+		w.walkBeforeAfter(*n, before, after)
+	case **ir.TermCleanupRet:
+		w.walkBeforeAfter(*n, before, after)
 	case **ir.TermUnreachable:
 		w.walkBeforeAfter(*n, before, after)
+	// Metadata
+	case **metadata.DICompileUnit:
+		w.walkBeforeAfter(*n, before, after)
+	case **metadata.DIFile:
+		w.walkBeforeAfter(*n, before, after)
+	case **metadata.DILocation:
+		w.walkBeforeAfter(*n, before, after)
+	case **metadata.DIGlobalVariable:
+		w.walkBeforeAfter(*n, before, after)
 
 	// pointers to slices
 	case *[]types.Type:
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*types.Param:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ir.Comdat:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]*ir.Global:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ir.Alias:
+		w.walkBeforeAfter(*n, before, after)
+	case *[]*ir.IFunc:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]value.Value:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]metadata.Metadata:
+		w.walkBeforeAfter(*n, before, after)
 	case *[]constant.Constant:
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*ir.Function:
@@ -348,18 +437,35 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(*n, before, after)
 	case *[]*ir.Incoming:
 		w.walkBeforeAfter(*n, before, after)
+	case *[]*ir.Clause:
+		w.walkBeforeAfter(*n, before, after)
 
 	// These are ordered and grouped to match ../../ll.bnf
 	case *ir.Module:
 		if n.Types != nil {
 			w.walkBeforeAfter(&n.Types, before, after)
 		}
+		if n.Comdats != nil {
+			w.walkBeforeAfter(&n.Comdats, before, after)
+		}
 		if n.Globals != nil {
 			w.walkBeforeAfter(&n.Globals, before, after)
 		}
+		if n.Aliases != nil {
+			w.walkBeforeAfter(&n.Aliases, before, after)
+		}
+		if n.IFuncs != nil {
+			w.walkBeforeAfter(&n.IFuncs, before, after)
+		}
 		if n.Funcs != nil {
 			w.walkBeforeAfter(&n.Funcs, before, after)
 		}
+	case []*ir.Comdat:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ir.Comdat:
+		// nothing to do.
 	case []*ir.Global:
 		for i := range n {
 			w.walkBeforeAfter(&n[i], before, after)
@@ -369,12 +475,29 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		if n.Init != nil {
 			w.walkBeforeAfter(&n.Init, before, after)
 		}
+	case []*ir.Alias:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ir.Alias:
+		w.walkBeforeAfter(&n.Content, before, after)
+		w.walkBeforeAfter(&n.Aliasee, before, after)
+	case []*ir.IFunc:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ir.IFunc:
+		w.walkBeforeAfter(&n.Content, before, after)
+		w.walkBeforeAfter(&n.Resolver, before, after)
 	case []*ir.Function:
 		for i := range n {
 			w.walkBeforeAfter(&n[i], before, after)
 		}
 	case *ir.Function:
 		w.walkBeforeAfter(&n.Sig, before, after)
+		if n.Personality != nil {
+			w.walkBeforeAfter(&n.Personality, before, after)
+		}
 		if n.Blocks != nil {
 			w.walkBeforeAfter(&n.Blocks, before, after)
 		}
@@ -404,10 +527,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(&n.Elem, before, after)
 	case *types.VectorType:
 		w.walkBeforeAfter(&n.Elem, before, after)
+	case *types.MMXType:
+		// nothing to do.
 	case *types.LabelType:
 		// nothing to do.
 	case *types.MetadataType:
 		// nothing to do.
+	case *types.TokenType:
+		// nothing to do.
 	case *types.ArrayType:
 		w.walkBeforeAfter(&n.Elem, before, after)
 	case *types.StructType:
@@ -429,6 +556,8 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		w.walkBeforeAfter(&n.Typ, before, after)
 	case *constant.Null:
 		w.walkBeforeAfter(&n.Typ, before, after)
+	case *constant.NoneToken:
+		w.walkBeforeAfter(&n.Typ, before, after)
 	case *constant.Vector:
 		w.walkBeforeAfter(&n.Typ, before, after)
 		if n.Elems != nil {
@@ -446,6 +575,14 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		}
 	case *constant.ZeroInitializer:
 		w.walkBeforeAfter(&n.Typ, before, after)
+	case *constant.Undef:
+		w.walkBeforeAfter(&n.Typ, before, after)
+	case *constant.Poison:
+		w.walkBeforeAfter(&n.Typ, before, after)
+	case *constant.BlockAddress:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.Func, before, after)
+		w.walkBeforeAfter(&n.Block, before, after)
 	// Constant expressions
 	case *constant.ExprAdd:
 		w.walkBeforeAfter(&n.X, before, after)
@@ -501,6 +638,25 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *constant.ExprXor:
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Y, before, after)
+	case *constant.ExprExtractElement:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *constant.ExprInsertElement:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *constant.ExprShuffleVector:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Y, before, after)
+		w.walkBeforeAfter(&n.Mask, before, after)
+	case *constant.ExprExtractValue:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+	case *constant.ExprInsertValue:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
 	case *constant.ExprGetElementPtr:
 		w.walkBeforeAfter(&n.Typ, before, after)
 		w.walkBeforeAfter(&n.Elem, before, after)
@@ -628,6 +784,25 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ir.InstXor:
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Y, before, after)
+	case *ir.InstExtractElement:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ir.InstInsertElement:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
+		w.walkBeforeAfter(&n.Index, before, after)
+	case *ir.InstShuffleVector:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Y, before, after)
+		w.walkBeforeAfter(&n.Mask, before, after)
+	case *ir.InstExtractValue:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ir.InstInsertValue:
+		w.walkBeforeAfter(&n.X, before, after)
+		w.walkBeforeAfter(&n.Elem, before, after)
 	case *ir.InstAlloca:
 		w.walkBeforeAfter(&n.Elem, before, after)
 		if n.NElems != nil {
@@ -639,6 +814,17 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ir.InstStore:
 		w.walkBeforeAfter(&n.Src, before, after)
 		w.walkBeforeAfter(&n.Dst, before, after)
+	case *ir.InstFence:
+		// nothing to do.
+	case *ir.InstCmpXchg:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.Ptr, before, after)
+		w.walkBeforeAfter(&n.Cmp, before, after)
+		w.walkBeforeAfter(&n.New, before, after)
+	case *ir.InstAtomicRMW:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		w.walkBeforeAfter(&n.Ptr, before, after)
+		w.walkBeforeAfter(&n.X, before, after)
 	case *ir.InstGetElementPtr:
 		w.walkBeforeAfter(&n.Elem, before, after)
 		w.walkBeforeAfter(&n.Src, before, after)
@@ -712,6 +898,29 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 		if n.Args != nil {
 			w.walkBeforeAfter(&n.Args, before, after)
 		}
+	case *ir.Arg:
+		w.walkBeforeAfter(&n.Value, before, after)
+	case *ir.InstLandingPad:
+		w.walkBeforeAfter(&n.Typ, before, after)
+		if n.Clauses != nil {
+			w.walkBeforeAfter(&n.Clauses, before, after)
+		}
+	case []*ir.Clause:
+		for i := range n {
+			w.walkBeforeAfter(&n[i], before, after)
+		}
+	case *ir.Clause:
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ir.InstCatchPad:
+		w.walkBeforeAfter(&n.CatchSwitch, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
+	case *ir.InstCleanupPad:
+		w.walkBeforeAfter(&n.ParentPad, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
 	// Terminators
 	case *ir.TermRet:
 		if n.X != nil {
@@ -736,9 +945,223 @@ func (w *walker) walkBeforeAfter(x interface{}, before, after func(interface{}))
 	case *ir.Case:
 		w.walkBeforeAfter(&n.X, before, after)
 		w.walkBeforeAfter(&n.Target, before, after)
+	case *ir.TermIndirectBr:
+		w.walkBeforeAfter(&n.Addr, before, after)
+		if n.ValidTargets != nil {
+			w.walkBeforeAfter(&n.ValidTargets, before, after)
+		}
+	case *ir.TermInvoke:
+		w.walkBeforeAfter(&n.Callee, before, after)
+		w.walkBeforeAfter(&n.Sig, before, after)
+		if n.Args != nil {
+			w.walkBeforeAfter(&n.Args, before, after)
+		}
+		w.walkBeforeAfter(&n.TargetNormal, before, after)
+		w.walkBeforeAfter(&n.TargetUnwind, before, after)
+	case *ir.TermResume:
+		w.walkBeforeAfter(&n.X, before, after)
+	case *ir.TermCatchSwitch:
+		w.walkBeforeAfter(&n.ParentPad, before, after)
+		if n.Handlers != nil {
+			w.walkBeforeAfter(&n.Handlers, before, after)
+		}
+		if n.UnwindTarget != nil {
+			w.walkBeforeAfter(&n.UnwindTarget, before, after)
+		}
+	case *ir.TermCatchRet:
+		w.walkBeforeAfter(&n.CatchPad, before, after)
+		w.walkBeforeAfter(&n.Target, before, after)
+	case *ir.TermCleanupRet:
+		w.walkBeforeAfter(&n.CleanupPad, before, after)
+		if n.UnwindTarget != nil {
+			w.walkBeforeAfter(&n.UnwindTarget, before, after)
+		}
 	case *ir.TermUnreachable:
 		// nothing to do.
 
+	// Metadata
+	case []metadata.Metadata:
+		for i := range n {
+			// Skip null metadata operands.
+			if n[i] != nil {
+				w.walkBeforeAfter(&n[i], before, after)
+			}
+		}
+	case *metadata.MDNode:
+		if n.Nodes != nil {
+			w.walkBeforeAfter(&n.Nodes, before, after)
+		}
+	case *metadata.MDString:
+		// nothing to do.
+	case *metadata.ValueAsMetadata:
+		w.walkBeforeAfter(&n.Value, before, after)
+	case *metadata.DICompileUnit:
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.Enums != nil {
+			w.walkBeforeAfter(&n.Enums, before, after)
+		}
+		if n.RetainedTypes != nil {
+			w.walkBeforeAfter(&n.RetainedTypes, before, after)
+		}
+		if n.Globals != nil {
+			w.walkBeforeAfter(&n.Globals, before, after)
+		}
+		if n.Imports != nil {
+			w.walkBeforeAfter(&n.Imports, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIFile:
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DISubprogram:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.Sig != nil {
+			w.walkBeforeAfter(&n.Sig, before, after)
+		}
+		if n.Unit != nil {
+			w.walkBeforeAfter(&n.Unit, before, after)
+		}
+		if n.Declaration != nil {
+			w.walkBeforeAfter(&n.Declaration, before, after)
+		}
+		if n.RetainedNodes != nil {
+			w.walkBeforeAfter(&n.RetainedNodes, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DILocation:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.InlinedAt != nil {
+			w.walkBeforeAfter(&n.InlinedAt, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DILocalVariable:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.VarType != nil {
+			w.walkBeforeAfter(&n.VarType, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIBasicType:
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DICompositeType:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.BaseType != nil {
+			w.walkBeforeAfter(&n.BaseType, before, after)
+		}
+		if n.Elements != nil {
+			w.walkBeforeAfter(&n.Elements, before, after)
+		}
+		if n.VtableHolder != nil {
+			w.walkBeforeAfter(&n.VtableHolder, before, after)
+		}
+		if n.TemplateParams != nil {
+			w.walkBeforeAfter(&n.TemplateParams, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIDerivedType:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.BaseType != nil {
+			w.walkBeforeAfter(&n.BaseType, before, after)
+		}
+		if n.ExtraData != nil {
+			w.walkBeforeAfter(&n.ExtraData, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DISubroutineType:
+		if n.Types != nil {
+			w.walkBeforeAfter(&n.Types, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DILexicalBlock:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DISubrange:
+		if n.CountVar != nil {
+			w.walkBeforeAfter(&n.CountVar, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIGlobalVariable:
+		if n.Scope != nil {
+			w.walkBeforeAfter(&n.Scope, before, after)
+		}
+		if n.File != nil {
+			w.walkBeforeAfter(&n.File, before, after)
+		}
+		if n.VarType != nil {
+			w.walkBeforeAfter(&n.VarType, before, after)
+		}
+		if n.Declaration != nil {
+			w.walkBeforeAfter(&n.Declaration, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIGlobalVariableExpression:
+		if n.Var != nil {
+			w.walkBeforeAfter(&n.Var, before, after)
+		}
+		if n.Expr != nil {
+			w.walkBeforeAfter(&n.Expr, before, after)
+		}
+		for _, f := range n.Extra {
+			w.walkBeforeAfter(f, before, after)
+		}
+	case *metadata.DIExpression:
+		// nothing to do.
+	case *metadata.DIField:
+		if n.MD != nil {
+			w.walkBeforeAfter(&n.MD, before, after)
+		}
+
 	default:
 		panic(fmt.Errorf("support for type %T not yet implemented", x))
 	}
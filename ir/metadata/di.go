@@ -0,0 +1,1472 @@
+// === [ Specialized metadata nodes ] ==========================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#specialized-metadata-nodes
+//    http://llvm.org/docs/SourceLevelDebugging.html
+
+package metadata
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/types"
+)
+
+// --- [ DICompileUnit ] -------------------------------------------------------
+
+// A DICompileUnit represents a compile unit debug information metadata node;
+// e.g.
+//
+//    distinct !DICompileUnit(language: DW_LANG_C99, file: !1, producer: "clang", emissionKind: FullDebug)
+type DICompileUnit struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source language; e.g. DW_LANG_C99.
+	Language string
+	// Source file.
+	File *DIFile
+	// Producer; e.g. clang version 7.0.0.
+	Producer string
+	// Optimized.
+	IsOptimized bool
+	// Command line flags of the producer.
+	Flags string
+	// Objective-C runtime version.
+	RuntimeVersion int64
+	// Split debug filename.
+	SplitDebugFilename string
+	// Emission kind; e.g. FullDebug, LineTablesOnly or NoDebug.
+	EmissionKind string
+	// Enum types; or nil if not present.
+	Enums Metadata
+	// Retained types; or nil if not present.
+	RetainedTypes Metadata
+	// Global variables; or nil if not present.
+	Globals Metadata
+	// Imported entities; or nil if not present.
+	Imports Metadata
+	// Split DWARF object ID.
+	DwoID int64
+	// Name table kind; e.g. None.
+	NameTableKind string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDICompileUnit returns a new inline compile unit based on the given source
+// language and source file.
+func NewDICompileUnit(lang string, file *DIFile) *DICompileUnit {
+	return &DICompileUnit{ID: -1, Language: lang, File: file}
+}
+
+// Type returns the type of the compile unit.
+func (md *DICompileUnit) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the compile unit.
+func (md *DICompileUnit) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the compile
+// unit.
+func (md *DICompileUnit) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the compile
+// unit.
+func (md *DICompileUnit) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the compile
+// unit.
+func (md *DICompileUnit) body() string {
+	fs := &fields{}
+	fs.enum("language", md.Language)
+	fs.md("file", md.File)
+	fs.str("producer", md.Producer)
+	fs.bool("isOptimized", md.IsOptimized)
+	fs.str("flags", md.Flags)
+	fs.int("runtimeVersion", md.RuntimeVersion)
+	fs.str("splitDebugFilename", md.SplitDebugFilename)
+	fs.enum("emissionKind", md.EmissionKind)
+	fs.md("enums", md.Enums)
+	fs.md("retainedTypes", md.RetainedTypes)
+	fs.md("globals", md.Globals)
+	fs.md("imports", md.Imports)
+	fs.int("dwoId", md.DwoID)
+	fs.enum("nameTableKind", md.NameTableKind)
+	fs.extra(md.Extra)
+	return fs.node("DICompileUnit")
+}
+
+// GetID returns the metadata ID of the compile unit.
+func (md *DICompileUnit) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the compile unit.
+func (md *DICompileUnit) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DICompileUnit) IsMetadata() {}
+
+// --- [ DIFile ] --------------------------------------------------------------
+
+// A DIFile represents a source file debug information metadata node; e.g.
+//
+//    !DIFile(filename: "foo.c", directory: "/home/u/src")
+type DIFile struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source filename.
+	Filename string
+	// Source directory.
+	Directory string
+	// Checksum kind; e.g. CSK_MD5.
+	ChecksumKind string
+	// Checksum of the source file.
+	Checksum string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDIFile returns a new inline source file based on the given filename and
+// directory.
+func NewDIFile(filename, dir string) *DIFile {
+	return &DIFile{ID: -1, Filename: filename, Directory: dir}
+}
+
+// Type returns the type of the source file.
+func (md *DIFile) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the source file.
+func (md *DIFile) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the source
+// file.
+func (md *DIFile) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the source
+// file.
+func (md *DIFile) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the source
+// file.
+func (md *DIFile) body() string {
+	fs := &fields{}
+	// The filename and directory fields are required.
+	fs.add("filename", quote(md.Filename))
+	fs.add("directory", quote(md.Directory))
+	fs.enum("checksumkind", md.ChecksumKind)
+	fs.str("checksum", md.Checksum)
+	fs.extra(md.Extra)
+	return fs.node("DIFile")
+}
+
+// GetID returns the metadata ID of the source file.
+func (md *DIFile) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the source file.
+func (md *DIFile) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIFile) IsMetadata() {}
+
+// --- [ DISubprogram ] --------------------------------------------------------
+
+// A DISubprogram represents a subprogram (e.g. function) debug information
+// metadata node; e.g.
+//
+//    distinct !DISubprogram(name: "foo", scope: !1, file: !1, line: 3, type: !5, isDefinition: true, unit: !0)
+type DISubprogram struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source name of the subprogram.
+	Name string
+	// Linkage name of the subprogram; e.g. mangled C++ name.
+	LinkageName string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Subprogram type (e.g. DISubroutineType), as specified by the type field;
+	// or nil if not present.
+	Sig Metadata
+	// Local to the compile unit (e.g. static function).
+	IsLocal bool
+	// Definition (as opposed to declaration).
+	IsDefinition bool
+	// Source line of the subprogram scope.
+	ScopeLine int64
+	// Debug information flags; e.g. DIFlagPrototyped.
+	Flags []string
+	// Subprogram flags; e.g. DISPFlagDefinition.
+	SPFlags []string
+	// Optimized.
+	IsOptimized bool
+	// Compile unit.
+	Unit *DICompileUnit
+	// Declaration of the subprogram; or nil if not present.
+	Declaration Metadata
+	// Retained nodes (e.g. local variables); or nil if not present.
+	RetainedNodes Metadata
+	// Fields not modelled by the node type (e.g. virtualIndex), in order of
+	// appearance.
+	Extra []*DIField
+}
+
+// NewDISubprogram returns a new inline subprogram based on the given source
+// name, source file and line.
+func NewDISubprogram(name string, file *DIFile, line int64) *DISubprogram {
+	return &DISubprogram{ID: -1, Name: name, File: file, Line: line}
+}
+
+// Type returns the type of the subprogram.
+func (md *DISubprogram) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the subprogram.
+func (md *DISubprogram) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the
+// subprogram.
+func (md *DISubprogram) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the
+// subprogram.
+func (md *DISubprogram) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the subprogram.
+func (md *DISubprogram) body() string {
+	fs := &fields{}
+	fs.str("name", md.Name)
+	fs.str("linkageName", md.LinkageName)
+	fs.md("scope", md.Scope)
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	fs.md("type", md.Sig)
+	fs.bool("isLocal", md.IsLocal)
+	fs.bool("isDefinition", md.IsDefinition)
+	fs.int("scopeLine", md.ScopeLine)
+	fs.flags("flags", md.Flags)
+	fs.flags("spFlags", md.SPFlags)
+	fs.bool("isOptimized", md.IsOptimized)
+	fs.md("unit", md.Unit)
+	fs.md("declaration", md.Declaration)
+	fs.md("retainedNodes", md.RetainedNodes)
+	fs.extra(md.Extra)
+	return fs.node("DISubprogram")
+}
+
+// GetID returns the metadata ID of the subprogram.
+func (md *DISubprogram) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the subprogram.
+func (md *DISubprogram) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DISubprogram) IsMetadata() {}
+
+// --- [ DILocation ] ----------------------------------------------------------
+
+// A DILocation represents a source location debug information metadata node;
+// e.g.
+//
+//    !DILocation(line: 2, column: 9, scope: !4, inlinedAt: !8)
+type DILocation struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source line.
+	Line int64
+	// Source column.
+	Column int64
+	// Enclosing scope; e.g. DISubprogram.
+	Scope Metadata
+	// Source location of the call site into which the scope was inlined; or nil
+	// if not inlined.
+	InlinedAt *DILocation
+	// Implicit code generated by the compiler.
+	IsImplicitCode bool
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDILocation returns a new inline source location based on the given line,
+// column and enclosing scope.
+func NewDILocation(line, column int64, scope Metadata) *DILocation {
+	return &DILocation{ID: -1, Line: line, Column: column, Scope: scope}
+}
+
+// Type returns the type of the source location.
+func (md *DILocation) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the source location.
+func (md *DILocation) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the source
+// location.
+func (md *DILocation) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the source
+// location.
+func (md *DILocation) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the source
+// location.
+func (md *DILocation) body() string {
+	fs := &fields{}
+	// The line and scope fields are required.
+	fs.add("line", fmt.Sprint(md.Line))
+	fs.int("column", md.Column)
+	fs.add("scope", ref(md.Scope))
+	if md.InlinedAt != nil {
+		fs.md("inlinedAt", md.InlinedAt)
+	}
+	fs.bool("isImplicitCode", md.IsImplicitCode)
+	fs.extra(md.Extra)
+	return fs.node("DILocation")
+}
+
+// InlinedAtChain returns the inlined-at chain of the source location; i.e. the
+// source location followed by the call site locations into which it was
+// inlined, from innermost to outermost.
+func (md *DILocation) InlinedAtChain() []*DILocation {
+	var chain []*DILocation
+	// Track visited locations to guard against malformed cyclic chains.
+	visited := make(map[*DILocation]bool)
+	for loc := md; loc != nil && !visited[loc]; loc = loc.InlinedAt {
+		visited[loc] = true
+		chain = append(chain, loc)
+	}
+	return chain
+}
+
+// GetID returns the metadata ID of the source location.
+func (md *DILocation) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the source location.
+func (md *DILocation) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DILocation) IsMetadata() {}
+
+// --- [ DILocalVariable ] -----------------------------------------------------
+
+// A DILocalVariable represents a local variable debug information metadata
+// node; e.g.
+//
+//    !DILocalVariable(name: "x", arg: 1, scope: !4, file: !1, line: 2, type: !7)
+type DILocalVariable struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source name of the local variable.
+	Name string
+	// Argument number (1-based) of function parameters; or 0 if not a
+	// parameter.
+	Arg int64
+	// Enclosing scope.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Source type of the variable, as specified by the type field; or nil if
+	// not present.
+	VarType Metadata
+	// Debug information flags; e.g. DIFlagArtificial.
+	Flags []string
+	// Alignment in bits; or 0 if default.
+	Align int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDILocalVariable returns a new inline local variable based on the given
+// source name and enclosing scope.
+func NewDILocalVariable(name string, scope Metadata) *DILocalVariable {
+	return &DILocalVariable{ID: -1, Name: name, Scope: scope}
+}
+
+// Type returns the type of the local variable.
+func (md *DILocalVariable) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the local variable.
+func (md *DILocalVariable) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the local
+// variable.
+func (md *DILocalVariable) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the local
+// variable.
+func (md *DILocalVariable) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the local
+// variable.
+func (md *DILocalVariable) body() string {
+	fs := &fields{}
+	fs.str("name", md.Name)
+	fs.int("arg", md.Arg)
+	// The scope field is required.
+	fs.add("scope", ref(md.Scope))
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	fs.md("type", md.VarType)
+	fs.flags("flags", md.Flags)
+	fs.int("align", md.Align)
+	fs.extra(md.Extra)
+	return fs.node("DILocalVariable")
+}
+
+// GetID returns the metadata ID of the local variable.
+func (md *DILocalVariable) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the local variable.
+func (md *DILocalVariable) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DILocalVariable) IsMetadata() {}
+
+// --- [ DIBasicType ] ---------------------------------------------------------
+
+// A DIBasicType represents a basic type debug information metadata node; e.g.
+//
+//    !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)
+type DIBasicType struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// DWARF tag; e.g. DW_TAG_base_type (default if empty).
+	Tag string
+	// Source name of the type.
+	Name string
+	// Size in bits.
+	Size int64
+	// Alignment in bits; or 0 if default.
+	Align int64
+	// DWARF attribute type encoding; e.g. DW_ATE_signed.
+	Encoding string
+	// Debug information flags.
+	Flags []string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDIBasicType returns a new inline basic type based on the given source
+// name, size in bits and DWARF attribute type encoding.
+func NewDIBasicType(name string, size int64, encoding string) *DIBasicType {
+	return &DIBasicType{ID: -1, Name: name, Size: size, Encoding: encoding}
+}
+
+// Type returns the type of the basic type.
+func (md *DIBasicType) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the basic type.
+func (md *DIBasicType) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the basic
+// type.
+func (md *DIBasicType) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the basic
+// type.
+func (md *DIBasicType) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the basic type.
+func (md *DIBasicType) body() string {
+	fs := &fields{}
+	fs.enum("tag", md.Tag)
+	fs.str("name", md.Name)
+	fs.int("size", md.Size)
+	fs.int("align", md.Align)
+	fs.enum("encoding", md.Encoding)
+	fs.flags("flags", md.Flags)
+	fs.extra(md.Extra)
+	return fs.node("DIBasicType")
+}
+
+// GetID returns the metadata ID of the basic type.
+func (md *DIBasicType) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the basic type.
+func (md *DIBasicType) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIBasicType) IsMetadata() {}
+
+// --- [ DICompositeType ] -----------------------------------------------------
+
+// A DICompositeType represents a composite type (e.g. struct, array or enum)
+// debug information metadata node; e.g.
+//
+//    !DICompositeType(tag: DW_TAG_structure_type, name: "S", file: !1, line: 1, size: 64, elements: !9)
+type DICompositeType struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// DWARF tag; e.g. DW_TAG_structure_type.
+	Tag string
+	// Source name of the type.
+	Name string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Base type (e.g. element type of arrays); or nil if not present.
+	BaseType Metadata
+	// Size in bits.
+	Size int64
+	// Alignment in bits; or 0 if default.
+	Align int64
+	// Offset in bits.
+	Offset int64
+	// Debug information flags.
+	Flags []string
+	// Elements (e.g. members or enumerators); or nil if not present.
+	Elements Metadata
+	// Type containing the vtable pointer; or nil if not present.
+	VtableHolder Metadata
+	// Template parameters; or nil if not present.
+	TemplateParams Metadata
+	// Unique identifier of the type; e.g. mangled C++ name.
+	Identifier string
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDICompositeType returns a new inline composite type based on the given
+// DWARF tag.
+func NewDICompositeType(tag string) *DICompositeType {
+	return &DICompositeType{ID: -1, Tag: tag}
+}
+
+// Type returns the type of the composite type.
+func (md *DICompositeType) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the composite type.
+func (md *DICompositeType) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the
+// composite type.
+func (md *DICompositeType) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the
+// composite type.
+func (md *DICompositeType) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the composite
+// type.
+func (md *DICompositeType) body() string {
+	fs := &fields{}
+	fs.enum("tag", md.Tag)
+	fs.str("name", md.Name)
+	fs.md("scope", md.Scope)
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	fs.md("baseType", md.BaseType)
+	fs.int("size", md.Size)
+	fs.int("align", md.Align)
+	fs.int("offset", md.Offset)
+	fs.flags("flags", md.Flags)
+	fs.md("elements", md.Elements)
+	fs.md("vtableHolder", md.VtableHolder)
+	fs.md("templateParams", md.TemplateParams)
+	fs.str("identifier", md.Identifier)
+	fs.extra(md.Extra)
+	return fs.node("DICompositeType")
+}
+
+// GetID returns the metadata ID of the composite type.
+func (md *DICompositeType) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the composite type.
+func (md *DICompositeType) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DICompositeType) IsMetadata() {}
+
+// --- [ DIDerivedType ] -------------------------------------------------------
+
+// A DIDerivedType represents a derived type (e.g. pointer, typedef or member)
+// debug information metadata node; e.g.
+//
+//    !DIDerivedType(tag: DW_TAG_pointer_type, baseType: !7, size: 64)
+type DIDerivedType struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// DWARF tag; e.g. DW_TAG_pointer_type.
+	Tag string
+	// Source name of the type.
+	Name string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Base type; or nil if not present (e.g. void pointer).
+	BaseType Metadata
+	// Size in bits.
+	Size int64
+	// Alignment in bits; or 0 if default.
+	Align int64
+	// Offset in bits.
+	Offset int64
+	// Debug information flags.
+	Flags []string
+	// Extra data; or nil if not present.
+	ExtraData Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDIDerivedType returns a new inline derived type based on the given DWARF
+// tag and base type.
+func NewDIDerivedType(tag string, baseType Metadata) *DIDerivedType {
+	return &DIDerivedType{ID: -1, Tag: tag, BaseType: baseType}
+}
+
+// Type returns the type of the derived type.
+func (md *DIDerivedType) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the derived type.
+func (md *DIDerivedType) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the derived
+// type.
+func (md *DIDerivedType) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the derived
+// type.
+func (md *DIDerivedType) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the derived
+// type.
+func (md *DIDerivedType) body() string {
+	fs := &fields{}
+	fs.enum("tag", md.Tag)
+	fs.str("name", md.Name)
+	fs.md("scope", md.Scope)
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	// The base type field is required; null for void pointers.
+	fs.add("baseType", ref(md.BaseType))
+	fs.int("size", md.Size)
+	fs.int("align", md.Align)
+	fs.int("offset", md.Offset)
+	fs.flags("flags", md.Flags)
+	fs.md("extraData", md.ExtraData)
+	fs.extra(md.Extra)
+	return fs.node("DIDerivedType")
+}
+
+// GetID returns the metadata ID of the derived type.
+func (md *DIDerivedType) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the derived type.
+func (md *DIDerivedType) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIDerivedType) IsMetadata() {}
+
+// --- [ DISubroutineType ] ----------------------------------------------------
+
+// A DISubroutineType represents a subroutine type debug information metadata
+// node; e.g.
+//
+//    !DISubroutineType(types: !{!7, !7})
+type DISubroutineType struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Debug information flags.
+	Flags []string
+	// Return type followed by parameter types; a null return type represents
+	// void.
+	Types Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDISubroutineType returns a new inline subroutine type based on the given
+// return and parameter types.
+func NewDISubroutineType(types Metadata) *DISubroutineType {
+	return &DISubroutineType{ID: -1, Types: types}
+}
+
+// Type returns the type of the subroutine type.
+func (md *DISubroutineType) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the subroutine type.
+func (md *DISubroutineType) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the
+// subroutine type.
+func (md *DISubroutineType) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the
+// subroutine type.
+func (md *DISubroutineType) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the subroutine
+// type.
+func (md *DISubroutineType) body() string {
+	fs := &fields{}
+	fs.flags("flags", md.Flags)
+	// The types field is required.
+	fs.add("types", ref(md.Types))
+	fs.extra(md.Extra)
+	return fs.node("DISubroutineType")
+}
+
+// GetID returns the metadata ID of the subroutine type.
+func (md *DISubroutineType) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the subroutine type.
+func (md *DISubroutineType) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DISubroutineType) IsMetadata() {}
+
+// --- [ DILexicalBlock ] ------------------------------------------------------
+
+// A DILexicalBlock represents a lexical block (e.g. the body of a loop) debug
+// information metadata node; e.g.
+//
+//    distinct !DILexicalBlock(scope: !7, file: !1, line: 6, column: 3)
+type DILexicalBlock struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Enclosing scope; e.g. DISubprogram or DILexicalBlock.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Source column.
+	Column int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDILexicalBlock returns a new inline lexical block based on the given
+// enclosing scope, source file, line and column.
+func NewDILexicalBlock(scope Metadata, file *DIFile, line, column int64) *DILexicalBlock {
+	return &DILexicalBlock{ID: -1, Scope: scope, File: file, Line: line, Column: column}
+}
+
+// Type returns the type of the lexical block.
+func (md *DILexicalBlock) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the lexical block.
+func (md *DILexicalBlock) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the lexical
+// block.
+func (md *DILexicalBlock) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the lexical
+// block.
+func (md *DILexicalBlock) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the lexical
+// block.
+func (md *DILexicalBlock) body() string {
+	fs := &fields{}
+	// The scope field is required.
+	fs.add("scope", ref(md.Scope))
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	fs.int("column", md.Column)
+	fs.extra(md.Extra)
+	return fs.node("DILexicalBlock")
+}
+
+// GetID returns the metadata ID of the lexical block.
+func (md *DILexicalBlock) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the lexical block.
+func (md *DILexicalBlock) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DILexicalBlock) IsMetadata() {}
+
+// --- [ DISubrange ] ----------------------------------------------------------
+
+// A DISubrange represents an array subrange debug information metadata node;
+// e.g.
+//
+//    !DISubrange(count: 4)
+type DISubrange struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Number of elements; or -1 if unknown (e.g. flexible array member).
+	Count int64
+	// Variable holding the number of elements (e.g. of a variable length
+	// array); or nil if the number of elements is constant.
+	CountVar Metadata
+	// Lower bound of the subrange.
+	LowerBound int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDISubrange returns a new inline array subrange based on the given number
+// of elements.
+func NewDISubrange(count int64) *DISubrange {
+	return &DISubrange{ID: -1, Count: count}
+}
+
+// Type returns the type of the array subrange.
+func (md *DISubrange) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the array subrange.
+func (md *DISubrange) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the array
+// subrange.
+func (md *DISubrange) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the array
+// subrange.
+func (md *DISubrange) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the array
+// subrange.
+func (md *DISubrange) body() string {
+	fs := &fields{}
+	// The count field is required.
+	if !isNil(md.CountVar) {
+		fs.add("count", md.CountVar.Ident())
+	} else {
+		fs.add("count", fmt.Sprint(md.Count))
+	}
+	fs.int("lowerBound", md.LowerBound)
+	fs.extra(md.Extra)
+	return fs.node("DISubrange")
+}
+
+// GetID returns the metadata ID of the array subrange.
+func (md *DISubrange) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the array subrange.
+func (md *DISubrange) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DISubrange) IsMetadata() {}
+
+// --- [ DIGlobalVariable ] ----------------------------------------------------
+
+// A DIGlobalVariable represents a global variable debug information metadata
+// node; e.g.
+//
+//    distinct !DIGlobalVariable(name: "g", scope: !2, file: !3, line: 1, type: !9, isDefinition: true)
+type DIGlobalVariable struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Source name of the global variable.
+	Name string
+	// Linkage name of the global variable; e.g. mangled C++ name.
+	LinkageName string
+	// Enclosing scope; or nil if not present.
+	Scope Metadata
+	// Source file.
+	File *DIFile
+	// Source line.
+	Line int64
+	// Source type of the variable; or nil if not present.
+	VarType Metadata
+	// Local to the compile unit (e.g. static variable).
+	IsLocal bool
+	// Definition (as opposed to declaration).
+	IsDefinition bool
+	// Declaration of the static data member; or nil if not present.
+	Declaration Metadata
+	// Alignment in bits; or 0 if default.
+	Align int64
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDIGlobalVariable returns a new inline global variable based on the given
+// source name, source file and line.
+func NewDIGlobalVariable(name string, file *DIFile, line int64) *DIGlobalVariable {
+	return &DIGlobalVariable{ID: -1, Name: name, File: file, Line: line}
+}
+
+// Type returns the type of the global variable.
+func (md *DIGlobalVariable) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the global variable.
+func (md *DIGlobalVariable) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the global
+// variable.
+func (md *DIGlobalVariable) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the global
+// variable.
+func (md *DIGlobalVariable) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the global
+// variable.
+func (md *DIGlobalVariable) body() string {
+	fs := &fields{}
+	fs.str("name", md.Name)
+	fs.str("linkageName", md.LinkageName)
+	fs.md("scope", md.Scope)
+	fs.md("file", md.File)
+	fs.int("line", md.Line)
+	fs.md("type", md.VarType)
+	fs.bool("isLocal", md.IsLocal)
+	fs.bool("isDefinition", md.IsDefinition)
+	fs.md("declaration", md.Declaration)
+	fs.int("align", md.Align)
+	fs.extra(md.Extra)
+	return fs.node("DIGlobalVariable")
+}
+
+// GetID returns the metadata ID of the global variable.
+func (md *DIGlobalVariable) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the global variable.
+func (md *DIGlobalVariable) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIGlobalVariable) IsMetadata() {}
+
+// --- [ DIGlobalVariableExpression ] ------------------------------------------
+
+// A DIGlobalVariableExpression represents a global variable expression debug
+// information metadata node; i.e. the pairing of a global variable and the
+// DWARF expression computing its location; e.g.
+//
+//    !DIGlobalVariableExpression(var: !1, expr: !DIExpression())
+type DIGlobalVariableExpression struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// Global variable.
+	Var *DIGlobalVariable
+	// DWARF expression; e.g. DIExpression.
+	Expr Metadata
+	// Fields not modelled by the node type, in order of appearance.
+	Extra []*DIField
+}
+
+// NewDIGlobalVariableExpression returns a new inline global variable
+// expression based on the given global variable and DWARF expression.
+func NewDIGlobalVariableExpression(v *DIGlobalVariable, expr Metadata) *DIGlobalVariableExpression {
+	return &DIGlobalVariableExpression{ID: -1, Var: v, Expr: expr}
+}
+
+// Type returns the type of the global variable expression.
+func (md *DIGlobalVariableExpression) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the global variable
+// expression.
+func (md *DIGlobalVariableExpression) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the global
+// variable expression.
+func (md *DIGlobalVariableExpression) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the global
+// variable expression.
+func (md *DIGlobalVariableExpression) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the global
+// variable expression.
+func (md *DIGlobalVariableExpression) body() string {
+	fs := &fields{}
+	// The var and expr fields are required.
+	fs.add("var", ref(md.Var))
+	fs.add("expr", ref(md.Expr))
+	fs.extra(md.Extra)
+	return fs.node("DIGlobalVariableExpression")
+}
+
+// GetID returns the metadata ID of the global variable expression.
+func (md *DIGlobalVariableExpression) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the global variable expression.
+func (md *DIGlobalVariableExpression) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIGlobalVariableExpression) IsMetadata() {}
+
+// --- [ DIExpression ] --------------------------------------------------------
+
+// A DIExpression represents a DWARF expression debug information metadata
+// node; e.g.
+//
+//    !DIExpression(DW_OP_deref, DW_OP_plus_uconst, 8)
+type DIExpression struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct metadata node.
+	Distinct bool
+	// DWARF operations and their operands.
+	Elems []DIExpressionElem
+}
+
+// NewDIExpression returns a new inline DWARF expression based on the given
+// DWARF operations and operands.
+func NewDIExpression(elems ...DIExpressionElem) *DIExpression {
+	return &DIExpression{ID: -1, Elems: elems}
+}
+
+// Type returns the type of the DWARF expression.
+func (md *DIExpression) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the DWARF expression.
+func (md *DIExpression) Ident() string {
+	if md.ID == -1 {
+		return md.body()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the DWARF
+// expression.
+func (md *DIExpression) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the DWARF
+// expression.
+func (md *DIExpression) Def() string {
+	return def(md.Distinct, md.body())
+}
+
+// body returns the LLVM syntax representation of the fields of the DWARF
+// expression.
+func (md *DIExpression) body() string {
+	fs := &fields{}
+	for _, elem := range md.Elems {
+		*fs = append(*fs, elem.String())
+	}
+	return fs.node("DIExpression")
+}
+
+// GetID returns the metadata ID of the DWARF expression.
+func (md *DIExpression) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the DWARF expression.
+func (md *DIExpression) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*DIExpression) IsMetadata() {}
+
+// DIExpressionElem is a DWARF operation or an operand of a DWARF expression.
+//
+// DIExpressionElem may have one of the following underlying types.
+//
+//    metadata.DwarfOp   (https://godoc.org/github.com/llir/llvm/ir/metadata#DwarfOp)
+//    metadata.Uint      (https://godoc.org/github.com/llir/llvm/ir/metadata#Uint)
+type DIExpressionElem interface {
+	fmt.Stringer
+	// isDIExpressionElem ensures that only DWARF operations and operands can be
+	// assigned to the metadata.DIExpressionElem interface.
+	isDIExpressionElem()
+}
+
+// DwarfOp is a DWARF operation of a DWARF expression; e.g. DW_OP_deref.
+type DwarfOp string
+
+// String returns the LLVM syntax representation of the DWARF operation.
+func (op DwarfOp) String() string {
+	return string(op)
+}
+
+// isDIExpressionElem ensures that only DWARF operations and operands can be
+// assigned to the metadata.DIExpressionElem interface.
+func (DwarfOp) isDIExpressionElem() {}
+
+// Uint is an unsigned integer operand of a DWARF expression.
+type Uint uint64
+
+// String returns the LLVM syntax representation of the integer operand.
+func (x Uint) String() string {
+	return fmt.Sprint(uint64(x))
+}
+
+// isDIExpressionElem ensures that only DWARF operations and operands can be
+// assigned to the metadata.DIExpressionElem interface.
+func (Uint) isDIExpressionElem() {}
+
+// --- [ Extra fields ] --------------------------------------------------------
+
+// A DIField represents a field of a specialized metadata node which is not
+// modelled by the node type; e.g. `virtualIndex: 2`.
+type DIField struct {
+	// Field name; e.g. virtualIndex.
+	Name string
+	// Metadata value; or nil if the field holds a literal value or null.
+	MD Metadata
+	// Literal value in LLVM syntax (e.g. 2, "foo" or DW_VIRTUALITY_virtual); or
+	// null.
+	Lit string
+}
+
+// String returns the LLVM syntax representation of the field.
+func (f *DIField) String() string {
+	if !isNil(f.MD) {
+		return fmt.Sprintf("%s: %s", f.Name, f.MD.Ident())
+	}
+	return fmt.Sprintf("%s: %s", f.Name, f.Lit)
+}
+
+// ### [ Helper functions ] ####################################################
+
+// fields is a list of the "key: value" fields of a specialized metadata node,
+// in LLVM syntax.
+type fields []string
+
+// add appends the given field to the list.
+func (fs *fields) add(key, val string) {
+	*fs = append(*fs, fmt.Sprintf("%s: %s", key, val))
+}
+
+// int appends the given integer field to the list, unless zero.
+func (fs *fields) int(key string, x int64) {
+	if x != 0 {
+		fs.add(key, fmt.Sprint(x))
+	}
+}
+
+// bool appends the given boolean field to the list, unless false.
+func (fs *fields) bool(key string, x bool) {
+	if x {
+		fs.add(key, "true")
+	}
+}
+
+// str appends the given string field to the list, unless empty.
+func (fs *fields) str(key, s string) {
+	if len(s) > 0 {
+		fs.add(key, quote(s))
+	}
+}
+
+// enum appends the given enumeration field (e.g. DW_TAG_base_type) to the list,
+// unless empty.
+func (fs *fields) enum(key, s string) {
+	if len(s) > 0 {
+		fs.add(key, s)
+	}
+}
+
+// flags appends the given flags field to the list, unless empty.
+func (fs *fields) flags(key string, flags []string) {
+	if len(flags) > 0 {
+		fs.add(key, strings.Join(flags, " | "))
+	}
+}
+
+// md appends the given metadata field to the list, unless nil.
+func (fs *fields) md(key string, md Metadata) {
+	if !isNil(md) {
+		fs.add(key, md.Ident())
+	}
+}
+
+// extra appends the given fields not modelled by the node type to the list.
+func (fs *fields) extra(extra []*DIField) {
+	for _, f := range extra {
+		*fs = append(*fs, f.String())
+	}
+}
+
+// node returns the LLVM syntax representation of the specialized metadata node
+// with the given name and list of fields.
+func (fs *fields) node(name string) string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "!%s(", name)
+	for i, f := range *fs {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+// def returns the LLVM syntax representation of the definition of a metadata
+// node, based on its distinct flag and body.
+func def(distinct bool, body string) string {
+	if distinct {
+		return "distinct " + body
+	}
+	return body
+}
+
+// ref returns the LLVM syntax representation of a reference to the given
+// metadata; or null if nil.
+func ref(md Metadata) string {
+	if isNil(md) {
+		return "null"
+	}
+	return md.Ident()
+}
+
+// quote returns the given string as a quoted and escaped string literal.
+func quote(s string) string {
+	return fmt.Sprintf(`"%s"`, enc.Escape(s))
+}
+
+// isNil reports whether the given metadata is nil, including typed nil
+// pointers of specialized metadata nodes (e.g. a nil *DIFile).
+func isNil(md Metadata) bool {
+	switch md := md.(type) {
+	case nil:
+		return true
+	case *DIFile:
+		return md == nil
+	case *DICompileUnit:
+		return md == nil
+	case *DILocation:
+		return md == nil
+	case *DIGlobalVariable:
+		return md == nil
+	}
+	return false
+}
@@ -0,0 +1,280 @@
+// === [ Metadata ] ============================================================
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#metadata
+
+// Package metadata declares the types used to represent LLVM IR metadata.
+package metadata
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/llir/llvm/internal/enc"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Metadata represents a metadata node or a metadata value.
+//
+// Metadata may have one of the following underlying types.
+//
+//    metadata.Node               (https://godoc.org/github.com/llir/llvm/ir/metadata#Node)
+//    *metadata.MDString          (https://godoc.org/github.com/llir/llvm/ir/metadata#MDString)
+//    *metadata.ValueAsMetadata   (https://godoc.org/github.com/llir/llvm/ir/metadata#ValueAsMetadata)
+//
+// Metadata values are of the metadata type, and may be used as function
+// arguments; e.g.
+//
+//    call void @llvm.dbg.value(metadata i32 %x, metadata !12, metadata !13)
+type Metadata interface {
+	value.Value
+	// IsMetadata ensures that only metadata can be assigned to the
+	// metadata.Metadata interface.
+	IsMetadata()
+}
+
+// --- [ Metadata nodes ] ------------------------------------------------------
+
+// Node represents a metadata node, which may be defined at the top-level of a
+// module and referenced by metadata ID; e.g.
+//
+//    !0 = !{!1, !2}
+//    !1 = !DILocation(line: 2, scope: !3)
+//
+// Node may have one of the following underlying types.
+//
+//    *metadata.MDNode                       (https://godoc.org/github.com/llir/llvm/ir/metadata#MDNode)
+//    *metadata.DICompileUnit                (https://godoc.org/github.com/llir/llvm/ir/metadata#DICompileUnit)
+//    *metadata.DIFile                       (https://godoc.org/github.com/llir/llvm/ir/metadata#DIFile)
+//    *metadata.DISubprogram                 (https://godoc.org/github.com/llir/llvm/ir/metadata#DISubprogram)
+//    *metadata.DILocation                   (https://godoc.org/github.com/llir/llvm/ir/metadata#DILocation)
+//    *metadata.DILocalVariable              (https://godoc.org/github.com/llir/llvm/ir/metadata#DILocalVariable)
+//    *metadata.DIBasicType                  (https://godoc.org/github.com/llir/llvm/ir/metadata#DIBasicType)
+//    *metadata.DICompositeType              (https://godoc.org/github.com/llir/llvm/ir/metadata#DICompositeType)
+//    *metadata.DIDerivedType                (https://godoc.org/github.com/llir/llvm/ir/metadata#DIDerivedType)
+//    *metadata.DISubroutineType             (https://godoc.org/github.com/llir/llvm/ir/metadata#DISubroutineType)
+//    *metadata.DILexicalBlock               (https://godoc.org/github.com/llir/llvm/ir/metadata#DILexicalBlock)
+//    *metadata.DISubrange                   (https://godoc.org/github.com/llir/llvm/ir/metadata#DISubrange)
+//    *metadata.DIGlobalVariable             (https://godoc.org/github.com/llir/llvm/ir/metadata#DIGlobalVariable)
+//    *metadata.DIGlobalVariableExpression   (https://godoc.org/github.com/llir/llvm/ir/metadata#DIGlobalVariableExpression)
+//    *metadata.DIExpression                 (https://godoc.org/github.com/llir/llvm/ir/metadata#DIExpression)
+type Node interface {
+	Metadata
+	// GetID returns the metadata ID of the metadata node; or -1 if inline.
+	GetID() int64
+	// SetID sets the metadata ID of the metadata node.
+	SetID(id int64)
+	// Def returns the LLVM syntax representation of the definition of the
+	// metadata node.
+	Def() string
+}
+
+// An MDNode represents a metadata node; i.e. a tuple of metadata operands.
+//
+// Metadata nodes are either numbered (e.g. !0) or inline (e.g. !{!0, !1}).
+// Numbered metadata nodes are defined at the top-level of the module.
+type MDNode struct {
+	// Metadata ID; or -1 if inline.
+	ID int64
+	// Distinct specifies whether the metadata node is distinct; i.e. never
+	// merged with structurally equivalent (uniqued) metadata nodes.
+	Distinct bool
+	// Metadata operands; a nil operand represents null.
+	Nodes []Metadata
+}
+
+// NewMDNode returns a new inline metadata node based on the given metadata
+// operands.
+func NewMDNode(nodes ...Metadata) *MDNode {
+	return &MDNode{ID: -1, Nodes: nodes}
+}
+
+// Type returns the type of the metadata node.
+func (md *MDNode) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the metadata node.
+func (md *MDNode) Ident() string {
+	if md.ID == -1 {
+		return md.tuple()
+	}
+	return enc.MetadataID(md.ID)
+}
+
+// String returns the LLVM syntax representation of a reference to the metadata
+// node.
+func (md *MDNode) String() string {
+	return md.Ident()
+}
+
+// Def returns the LLVM syntax representation of the definition of the metadata
+// node.
+func (md *MDNode) Def() string {
+	if md.Distinct {
+		return "distinct " + md.tuple()
+	}
+	return md.tuple()
+}
+
+// tuple returns the LLVM syntax representation of the metadata operands of the
+// metadata node.
+func (md *MDNode) tuple() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("!{")
+	for i, node := range md.Nodes {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		if node == nil {
+			buf.WriteString("null")
+			continue
+		}
+		buf.WriteString(node.Ident())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// GetID returns the metadata ID of the metadata node.
+func (md *MDNode) GetID() int64 {
+	return md.ID
+}
+
+// SetID sets the metadata ID of the metadata node.
+func (md *MDNode) SetID(id int64) {
+	md.ID = id
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*MDNode) IsMetadata() {}
+
+// --- [ Metadata strings ] ----------------------------------------------------
+
+// An MDString represents a metadata string.
+type MDString struct {
+	// String value.
+	Val string
+}
+
+// NewMDString returns a new metadata string based on the given string value.
+func NewMDString(val string) *MDString {
+	return &MDString{Val: val}
+}
+
+// Type returns the type of the metadata string.
+func (md *MDString) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the metadata string.
+func (md *MDString) Ident() string {
+	return fmt.Sprintf(`!"%s"`, enc.Escape(md.Val))
+}
+
+// String returns the LLVM syntax representation of the metadata string.
+func (md *MDString) String() string {
+	return md.Ident()
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*MDString) IsMetadata() {}
+
+// --- [ Metadata values ] -----------------------------------------------------
+
+// A ValueAsMetadata represents an LLVM IR value used as metadata; e.g.
+//
+//    i32 42
+type ValueAsMetadata struct {
+	// Wrapped value.
+	Value value.Value
+}
+
+// NewValueAsMetadata returns a new metadata value based on the given value.
+func NewValueAsMetadata(v value.Value) *ValueAsMetadata {
+	return &ValueAsMetadata{Value: v}
+}
+
+// Type returns the type of the metadata value.
+func (md *ValueAsMetadata) Type() types.Type {
+	return types.Metadata
+}
+
+// Ident returns the identifier associated with the metadata value.
+func (md *ValueAsMetadata) Ident() string {
+	return fmt.Sprintf("%s %s", md.Value.Type(), md.Value.Ident())
+}
+
+// String returns the LLVM syntax representation of the metadata value.
+func (md *ValueAsMetadata) String() string {
+	return md.Ident()
+}
+
+// IsMetadata ensures that only metadata can be assigned to the
+// metadata.Metadata interface.
+func (*ValueAsMetadata) IsMetadata() {}
+
+// --- [ Named metadata ] ------------------------------------------------------
+
+// A Named represents a named metadata definition; e.g.
+//
+//    !llvm.module.flags = !{!0, !1}
+type Named struct {
+	// Metadata name.
+	Name string
+	// Metadata nodes.
+	Nodes []Node
+}
+
+// NewNamed returns a new named metadata definition based on the given metadata
+// name and metadata nodes.
+func NewNamed(name string, nodes ...Node) *Named {
+	return &Named{Name: name, Nodes: nodes}
+}
+
+// Ident returns the identifier associated with the named metadata.
+func (md *Named) Ident() string {
+	return enc.MetadataName(md.Name)
+}
+
+// String returns the LLVM syntax representation of the named metadata
+// definition.
+func (md *Named) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = !{", md.Ident())
+	for i, node := range md.Nodes {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(node.Ident())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// --- [ Metadata attachments ] ------------------------------------------------
+
+// An Attachment represents a metadata attachment of an instruction, a global
+// variable or a function; e.g.
+//
+//    !dbg !5
+type Attachment struct {
+	// Metadata kind name; e.g. dbg.
+	Name string
+	// Attached metadata node.
+	Node Node
+}
+
+// NewAttachment returns a new metadata attachment based on the given metadata
+// kind name and metadata node.
+func NewAttachment(name string, node Node) *Attachment {
+	return &Attachment{Name: name, Node: node}
+}
+
+// String returns the LLVM syntax representation of the metadata attachment.
+func (md *Attachment) String() string {
+	return fmt.Sprintf("%s %s", enc.MetadataName(md.Name), md.Node.Ident())
+}
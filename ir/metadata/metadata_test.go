@@ -0,0 +1,176 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/types"
+)
+
+// Validate that the relevant types satisfy the metadata.Metadata interface.
+var (
+	_ metadata.Metadata = &metadata.MDNode{}
+	_ metadata.Metadata = &metadata.MDString{}
+	_ metadata.Metadata = &metadata.ValueAsMetadata{}
+)
+
+// Validate that the relevant types satisfy the metadata.Node interface.
+var (
+	_ metadata.Node = &metadata.MDNode{}
+	_ metadata.Node = &metadata.DICompileUnit{}
+	_ metadata.Node = &metadata.DIFile{}
+	_ metadata.Node = &metadata.DISubprogram{}
+	_ metadata.Node = &metadata.DILocation{}
+	_ metadata.Node = &metadata.DILocalVariable{}
+	_ metadata.Node = &metadata.DIBasicType{}
+	_ metadata.Node = &metadata.DICompositeType{}
+	_ metadata.Node = &metadata.DIDerivedType{}
+	_ metadata.Node = &metadata.DISubroutineType{}
+	_ metadata.Node = &metadata.DILexicalBlock{}
+	_ metadata.Node = &metadata.DISubrange{}
+	_ metadata.Node = &metadata.DIGlobalVariable{}
+	_ metadata.Node = &metadata.DIGlobalVariableExpression{}
+	_ metadata.Node = &metadata.DIExpression{}
+)
+
+func TestMDNodeString(t *testing.T) {
+	x := metadata.NewMDNode(metadata.NewMDString("foo"))
+	x.ID = 0
+	y := metadata.NewMDNode(x, nil, metadata.NewValueAsMetadata(constant.NewInt(42, types.I32)))
+	y.ID = 1
+	y.Distinct = true
+	golden := []struct {
+		want string
+		got  string
+	}{
+		{want: "!0", got: x.String()},
+		{want: `!{!"foo"}`, got: x.Def()},
+		{want: "!1", got: y.String()},
+		{want: "distinct !{!0, null, i32 42}", got: y.Def()},
+		{want: "!{!0, !1}", got: metadata.NewMDNode(x, y).String()},
+		{want: "!{}", got: metadata.NewMDNode().String()},
+	}
+	for i, g := range golden {
+		if g.got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, g.got)
+		}
+	}
+}
+
+func TestNamedString(t *testing.T) {
+	x := metadata.NewMDNode()
+	x.ID = 0
+	y := metadata.NewMDNode()
+	y.ID = 1
+	golden := []struct {
+		want string
+		md   *metadata.Named
+	}{
+		{want: "!llvm.module.flags = !{!0, !1}", md: metadata.NewNamed("llvm.module.flags", x, y)},
+		{want: "!foo = !{}", md: metadata.NewNamed("foo")},
+	}
+	for i, g := range golden {
+		got := g.md.String()
+		if got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, got)
+		}
+	}
+}
+
+func TestAttachmentString(t *testing.T) {
+	x := metadata.NewMDNode()
+	x.ID = 5
+	const want = "!dbg !5"
+	got := metadata.NewAttachment("dbg", x).String()
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestDIString(t *testing.T) {
+	file := metadata.NewDIFile("foo.c", "/home/u")
+	file.ID = 1
+	cu := metadata.NewDICompileUnit("DW_LANG_C99", file)
+	cu.ID = 0
+	cu.Distinct = true
+	cu.Producer = "clang"
+	cu.EmissionKind = "FullDebug"
+	sp := metadata.NewDISubprogram("main", file, 3)
+	sp.ID = 2
+	sp.Distinct = true
+	sp.Unit = cu
+	sp.SPFlags = []string{"DISPFlagDefinition", "DISPFlagOptimized"}
+	i32 := metadata.NewDIBasicType("int", 32, "DW_ATE_signed")
+	ptr := metadata.NewDIDerivedType("DW_TAG_pointer_type", i32)
+	ptr.Size = 64
+	callSite := metadata.NewDILocation(7, 3, sp)
+	callSite.ID = 3
+	loc := metadata.NewDILocation(4, 12, sp)
+	loc.InlinedAt = callSite
+	sig := metadata.NewDISubroutineType(metadata.NewMDNode(nil, i32))
+	block := metadata.NewDILexicalBlock(sp, file, 6, 3)
+	block.ID = 4
+	block.Distinct = true
+	g := metadata.NewDIGlobalVariable("g", file, 1)
+	g.ID = 5
+	g.Distinct = true
+	g.Scope = cu
+	g.VarType = i32
+	g.IsDefinition = true
+	virt := metadata.NewDISubprogram("f", file, 9)
+	virt.Extra = []*metadata.DIField{
+		{Name: "virtuality", Lit: "DW_VIRTUALITY_virtual"},
+		{Name: "virtualIndex", Lit: "2"},
+		{Name: "containingType", MD: ptr},
+		{Name: "templateParams", Lit: "null"},
+	}
+	golden := []struct {
+		want string
+		got  string
+	}{
+		{want: "!1", got: file.String()},
+		{want: `!DIFile(filename: "foo.c", directory: "/home/u")`, got: file.Def()},
+		{want: `distinct !DICompileUnit(language: DW_LANG_C99, file: !1, producer: "clang", emissionKind: FullDebug)`, got: cu.Def()},
+		{want: `distinct !DISubprogram(name: "main", file: !1, line: 3, spFlags: DISPFlagDefinition | DISPFlagOptimized, unit: !0)`, got: sp.Def()},
+		{want: `!DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)`, got: i32.String()},
+		{want: `!DIDerivedType(tag: DW_TAG_pointer_type, baseType: !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed), size: 64)`, got: ptr.String()},
+		{want: "!DILocation(line: 4, column: 12, scope: !2, inlinedAt: !3)", got: loc.String()},
+		{want: `!DISubroutineType(types: !{null, !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)})`, got: sig.String()},
+		{want: "distinct !DILexicalBlock(scope: !2, file: !1, line: 6, column: 3)", got: block.Def()},
+		{want: "!DISubrange(count: 4)", got: metadata.NewDISubrange(4).String()},
+		{want: `distinct !DIGlobalVariable(name: "g", scope: !0, file: !1, line: 1, type: !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed), isDefinition: true)`, got: g.Def()},
+		{want: "!DIGlobalVariableExpression(var: !5, expr: !DIExpression())", got: metadata.NewDIGlobalVariableExpression(g, metadata.NewDIExpression()).String()},
+		{want: `!DISubprogram(name: "f", file: !1, line: 9, virtuality: DW_VIRTUALITY_virtual, virtualIndex: 2, containingType: !DIDerivedType(tag: DW_TAG_pointer_type, baseType: !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed), size: 64), templateParams: null)`, got: virt.String()},
+		{want: "!DIExpression()", got: metadata.NewDIExpression().String()},
+		{want: "!DIExpression(DW_OP_plus_uconst, 8, DW_OP_deref)", got: metadata.NewDIExpression(metadata.DwarfOp("DW_OP_plus_uconst"), metadata.Uint(8), metadata.DwarfOp("DW_OP_deref")).String()},
+	}
+	for i, g := range golden {
+		if g.got != g.want {
+			t.Errorf("i=%d; expected %q, got %q", i, g.want, g.got)
+		}
+	}
+}
+
+func TestDILocationInlinedAtChain(t *testing.T) {
+	outer := metadata.NewDILocation(9, 1, nil)
+	mid := metadata.NewDILocation(5, 2, nil)
+	mid.InlinedAt = outer
+	inner := metadata.NewDILocation(2, 3, nil)
+	inner.InlinedAt = mid
+	chain := inner.InlinedAtChain()
+	want := []*metadata.DILocation{inner, mid, outer}
+	if len(chain) != len(want) {
+		t.Fatalf("chain length mismatch; expected %d, got %d", len(want), len(chain))
+	}
+	for i := range want {
+		if chain[i] != want[i] {
+			t.Errorf("i=%d; expected %v, got %v", i, want[i], chain[i])
+		}
+	}
+	// Cyclic chains terminate.
+	outer.InlinedAt = inner
+	if n := len(inner.InlinedAtChain()); n != 3 {
+		t.Errorf("cyclic chain length mismatch; expected 3, got %d", n)
+	}
+}
@@ -14,18 +14,39 @@ import (
 
 	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
 	"github.com/llir/llvm/ir/types"
 )
 
 // A Module represents an LLVM IR module, which consists of top-level type
-// definitions, global variables, functions, and metadata.
+// definitions, global variables, aliases, IFuncs, functions, and metadata.
 type Module struct {
+	// Source filename of the module; or empty if not present.
+	SourceFilename string
+	// Data layout of the target; or empty if not present.
+	DataLayout string
+	// Target triple of the module; or empty if not present.
+	TargetTriple string
+	// Module-level inline assembly, one line per entry.
+	ModuleAsm []string
 	// Type definitions.
 	Types []types.Type
+	// Comdat definitions of the module.
+	Comdats []*Comdat
 	// Global variables of the module.
 	Globals []*Global
+	// Aliases of the module.
+	Aliases []*Alias
+	// IFuncs of the module.
+	IFuncs []*IFunc
 	// Functions of the module.
 	Funcs []*Function
+	// Attribute group definitions of the module.
+	AttrGroupDefs []*AttrGroupDef
+	// Named metadata definitions of the module.
+	NamedMetadata []*metadata.Named
+	// Metadata node definitions of the module.
+	MetadataDefs []metadata.Node
 }
 
 // NewModule returns a new LLVM IR module.
@@ -36,16 +57,46 @@ func NewModule() *Module {
 // String returns the LLVM syntax representation of the module.
 func (m *Module) String() string {
 	buf := &bytes.Buffer{}
+	if len(m.SourceFilename) > 0 {
+		fmt.Fprintf(buf, "source_filename = \"%s\"\n", enc.Escape(m.SourceFilename))
+	}
+	if len(m.DataLayout) > 0 {
+		fmt.Fprintf(buf, "target datalayout = \"%s\"\n", enc.Escape(m.DataLayout))
+	}
+	if len(m.TargetTriple) > 0 {
+		fmt.Fprintf(buf, "target triple = \"%s\"\n", enc.Escape(m.TargetTriple))
+	}
+	for _, asm := range m.ModuleAsm {
+		fmt.Fprintf(buf, "module asm \"%s\"\n", enc.Escape(asm))
+	}
 	for _, typ := range m.Types {
 		name := enc.Local(typ.GetName())
 		fmt.Fprintf(buf, "%s = type %s\n", name, typ.Def())
 	}
+	for _, comdat := range m.Comdats {
+		fmt.Fprintln(buf, comdat)
+	}
 	for _, global := range m.Globals {
 		fmt.Fprintln(buf, global)
 	}
+	for _, alias := range m.Aliases {
+		fmt.Fprintln(buf, alias)
+	}
+	for _, ifunc := range m.IFuncs {
+		fmt.Fprintln(buf, ifunc)
+	}
 	for _, f := range m.Funcs {
 		fmt.Fprintln(buf, f)
 	}
+	for _, group := range m.AttrGroupDefs {
+		fmt.Fprintf(buf, "attributes %s = %s\n", group.Ident(), group.Def())
+	}
+	for _, md := range m.NamedMetadata {
+		fmt.Fprintln(buf, md)
+	}
+	for _, md := range m.MetadataDefs {
+		fmt.Fprintf(buf, "%s = %s\n", md.Ident(), md.Def())
+	}
 	return buf.String()
 }
 
@@ -63,6 +114,61 @@ func (m *Module) NewType(name string, typ types.Type) types.Type {
 	return typ
 }
 
+// NewComdat appends a new comdat definition to the module based on the given
+// comdat name and selection kind.
+func (m *Module) NewComdat(name string, kind SelectionKind) *Comdat {
+	comdat := NewComdat(name, kind)
+	m.Comdats = append(m.Comdats, comdat)
+	return comdat
+}
+
+// NewAttrGroupDef appends a new attribute group definition to the module based
+// on the given function attributes. The attribute group is assigned the next
+// unused attribute group ID of the module.
+func (m *Module) NewAttrGroupDef(attrs ...FuncAttribute) *AttrGroupDef {
+	var id int64
+	for _, group := range m.AttrGroupDefs {
+		if group.ID >= id {
+			id = group.ID + 1
+		}
+	}
+	group := NewAttrGroupDef(id, attrs...)
+	m.AttrGroupDefs = append(m.AttrGroupDefs, group)
+	return group
+}
+
+// NewNamedMetadata appends a new named metadata definition to the module based
+// on the given metadata name and metadata nodes.
+func (m *Module) NewNamedMetadata(name string, nodes ...metadata.Node) *metadata.Named {
+	md := metadata.NewNamed(name, nodes...)
+	m.NamedMetadata = append(m.NamedMetadata, md)
+	return md
+}
+
+// NewMetadataDef appends a new metadata node definition to the module based on
+// the given metadata operands. The metadata node is assigned the next unused
+// metadata ID of the module.
+func (m *Module) NewMetadataDef(nodes ...metadata.Metadata) *metadata.MDNode {
+	md := metadata.NewMDNode(nodes...)
+	m.AppendMetadataDef(md)
+	return md
+}
+
+// AppendMetadataDef appends the given metadata node (e.g. a specialized
+// metadata node such as *metadata.DILocation) to the metadata node definitions
+// of the module. The metadata node is assigned the next unused metadata ID of
+// the module.
+func (m *Module) AppendMetadataDef(md metadata.Node) {
+	var id int64
+	for _, def := range m.MetadataDefs {
+		if def.GetID() >= id {
+			id = def.GetID() + 1
+		}
+	}
+	md.SetID(id)
+	m.MetadataDefs = append(m.MetadataDefs, md)
+}
+
 // NewGlobalDecl appends a new external global variable declaration to the
 // module based on the given global variable name and content type.
 func (m *Module) NewGlobalDecl(name string, content types.Type) *Global {
@@ -79,6 +185,22 @@ func (m *Module) NewGlobalDef(name string, init constant.Constant) *Global {
 	return global
 }
 
+// NewAlias appends a new global alias to the module based on the given alias
+// name and aliasee.
+func (m *Module) NewAlias(name string, aliasee constant.Constant) *Alias {
+	alias := NewAlias(name, aliasee)
+	m.Aliases = append(m.Aliases, alias)
+	return alias
+}
+
+// NewIFunc appends a new indirect function to the module based on the given
+// IFunc name and resolver function.
+func (m *Module) NewIFunc(name string, resolver constant.Constant) *IFunc {
+	ifunc := NewIFunc(name, resolver)
+	m.IFuncs = append(m.IFuncs, ifunc)
+	return ifunc
+}
+
 // NewFunction appends a new function to the module based on the given function
 // name, return type and parameters.
 func (m *Module) NewFunction(name string, ret types.Type, params ...*types.Param) *Function {
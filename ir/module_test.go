@@ -0,0 +1,28 @@
+package ir_test
+
+import (
+	"testing"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/types"
+)
+
+func TestModuleString(t *testing.T) {
+	m := ir.NewModule()
+	m.SourceFilename = "foo.c"
+	m.DataLayout = "e-m:e-i64:64-f80:128-n8:16:32:64-S128"
+	m.TargetTriple = "x86_64-unknown-linux-gnu"
+	m.ModuleAsm = []string{".globl bar", `bar: ret "\n"`}
+	m.NewFunction("bar", types.Void)
+	const want = `source_filename = "foo.c"
+target datalayout = "e-m:e-i64:64-f80:128-n8:16:32:64-S128"
+target triple = "x86_64-unknown-linux-gnu"
+module asm ".globl bar"
+module asm "bar: ret \22\5Cn\22"
+declare void @bar()
+`
+	got := m.String()
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
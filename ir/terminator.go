@@ -9,7 +9,10 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/llir/llvm/internal/enc"
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 )
 
@@ -25,6 +28,12 @@ import (
 //    *ir.TermBr            (https://godoc.org/github.com/llir/llvm/ir#TermBr)
 //    *ir.TermCondBr        (https://godoc.org/github.com/llir/llvm/ir#TermCondBr)
 //    *ir.TermSwitch        (https://godoc.org/github.com/llir/llvm/ir#TermSwitch)
+//    *ir.TermIndirectBr    (https://godoc.org/github.com/llir/llvm/ir#TermIndirectBr)
+//    *ir.TermInvoke        (https://godoc.org/github.com/llir/llvm/ir#TermInvoke)
+//    *ir.TermResume        (https://godoc.org/github.com/llir/llvm/ir#TermResume)
+//    *ir.TermCatchSwitch   (https://godoc.org/github.com/llir/llvm/ir#TermCatchSwitch)
+//    *ir.TermCatchRet      (https://godoc.org/github.com/llir/llvm/ir#TermCatchRet)
+//    *ir.TermCleanupRet    (https://godoc.org/github.com/llir/llvm/ir#TermCleanupRet)
 //    *ir.TermUnreachable   (https://godoc.org/github.com/llir/llvm/ir#TermUnreachable)
 type Terminator interface {
 	Instruction
@@ -43,6 +52,8 @@ type TermRet struct {
 	Parent *BasicBlock
 	// Return value; or nil if "void" return.
 	X value.Value
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
 }
 
 // NewRet returns a new ret terminator based on the given return value. A nil
@@ -56,11 +67,12 @@ func NewRet(x value.Value) *TermRet {
 // String returns the LLVM syntax representation of the terminator.
 func (term *TermRet) String() string {
 	if term.X != nil {
-		return fmt.Sprintf("ret %s %s",
+		return fmt.Sprintf("ret %s %s%s",
 			term.X.Type(),
-			term.X.Ident())
+			term.X.Ident(),
+			attachments(term.Metadata))
 	}
-	return "ret void"
+	return fmt.Sprintf("ret void%s", attachments(term.Metadata))
 }
 
 // GetParent returns the parent basic block of the terminator.
@@ -73,6 +85,11 @@ func (term *TermRet) SetParent(parent *BasicBlock) {
 	term.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermRet) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
 // Succs returns the successor basic blocks of the terminator.
 func (term *TermRet) Succs() []*BasicBlock {
 	// ret terminators have no successors.
@@ -92,6 +109,8 @@ type TermBr struct {
 	Target *BasicBlock
 	// Successors basic blocks.
 	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
 }
 
 // NewBr returns a new unconditional br terminator based on the given target
@@ -106,7 +125,7 @@ func NewBr(target *BasicBlock) *TermBr {
 
 // String returns the LLVM syntax representation of the terminator.
 func (term *TermBr) String() string {
-	return fmt.Sprintf("br label %s", term.Target.Ident())
+	return fmt.Sprintf("br label %s%s", term.Target.Ident(), attachments(term.Metadata))
 }
 
 // GetParent returns the parent basic block of the terminator.
@@ -119,6 +138,11 @@ func (term *TermBr) SetParent(parent *BasicBlock) {
 	term.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermBr) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
 // Succs returns the successor basic blocks of the terminator.
 func (term *TermBr) Succs() []*BasicBlock {
 	return term.Successors
@@ -141,6 +165,8 @@ type TermCondBr struct {
 	TargetFalse *BasicBlock
 	// Successors basic blocks.
 	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
 }
 
 // NewCondBr returns a new conditional br terminator based on the given
@@ -157,10 +183,11 @@ func NewCondBr(cond value.Value, targetTrue, targetFalse *BasicBlock) *TermCondB
 
 // String returns the LLVM syntax representation of the terminator.
 func (term *TermCondBr) String() string {
-	return fmt.Sprintf("br i1 %s, label %s, label %s",
+	return fmt.Sprintf("br i1 %s, label %s, label %s%s",
 		term.Cond.Ident(),
 		term.TargetTrue.Ident(),
-		term.TargetFalse.Ident())
+		term.TargetFalse.Ident(),
+		attachments(term.Metadata))
 }
 
 // GetParent returns the parent basic block of the terminator.
@@ -173,6 +200,11 @@ func (term *TermCondBr) SetParent(parent *BasicBlock) {
 	term.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermCondBr) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
 // Succs returns the successor basic blocks of the terminator.
 func (term *TermCondBr) Succs() []*BasicBlock {
 	return term.Successors
@@ -195,6 +227,8 @@ type TermSwitch struct {
 	Cases []*Case
 	// Successors basic blocks.
 	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
 }
 
 // TODO: Consider renaming x to control to avoid confusion between term.X() and
@@ -229,6 +263,9 @@ func (term *TermSwitch) String() string {
 			c.Target.Ident())
 	}
 	buf.WriteString("\t]")
+	for _, md := range term.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
 	return buf.String()
 }
 
@@ -242,6 +279,11 @@ func (term *TermSwitch) SetParent(parent *BasicBlock) {
 	term.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermSwitch) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
 // Succs returns the successor basic blocks of the terminator.
 func (term *TermSwitch) Succs() []*BasicBlock {
 	return term.Successors
@@ -266,16 +308,475 @@ func NewCase(x *constant.Int, target *BasicBlock) *Case {
 
 // --- [ indirectbr ] ----------------------------------------------------------
 
+// TermIndirectBr represents an indirectbr terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#indirectbr-instruction
+type TermIndirectBr struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Target address.
+	Addr value.Value
+	// List of possible destinations of the target address.
+	ValidTargets []*BasicBlock
+	// Successors basic blocks.
+	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewIndirectBr returns a new indirectbr terminator based on the given target
+// address and list of possible destinations.
+func NewIndirectBr(addr value.Value, validTargets ...*BasicBlock) *TermIndirectBr {
+	return &TermIndirectBr{
+		Addr:         addr,
+		ValidTargets: validTargets,
+		Successors:   validTargets,
+	}
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermIndirectBr) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "indirectbr %s %s, [",
+		term.Addr.Type(),
+		term.Addr.Ident())
+	for i, target := range term.ValidTargets {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "label %s", target.Ident())
+	}
+	buf.WriteString("]")
+	for _, md := range term.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermIndirectBr) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermIndirectBr) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermIndirectBr) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermIndirectBr) Succs() []*BasicBlock {
+	return term.Successors
+}
+
 // --- [ invoke ] --------------------------------------------------------------
 
+// TermInvoke represents an invoke terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#invoke-instruction
+type TermInvoke struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the terminator.
+	Name string
+	// Callee.
+	//
+	// Callee may have one of the following underlying types.
+	//
+	//    *ir.Function
+	//    *types.Param
+	Callee value.Named
+	// Callee signature.
+	Sig *types.FuncType
+	// Function arguments.
+	Args []value.Value
+	// Target branch when the callee returns normally.
+	TargetNormal *BasicBlock
+	// Target branch when the callee unwinds through an exception.
+	TargetUnwind *BasicBlock
+	// Successors basic blocks.
+	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewInvoke returns a new invoke terminator based on the given callee, function
+// arguments and target branches.
+//
+// The callee value may have one of the following underlying types.
+//
+//    *ir.Function
+//    *types.Param
+func NewInvoke(callee value.Named, args []value.Value, targetNormal, targetUnwind *BasicBlock) *TermInvoke {
+	typ, ok := callee.Type().(*types.PointerType)
+	if !ok {
+		panic(fmt.Errorf("invalid callee type, expected *types.PointerType, got %T", callee.Type()))
+	}
+	sig, ok := typ.Elem.(*types.FuncType)
+	if !ok {
+		panic(fmt.Errorf("invalid callee signature type, expected *types.FuncType, got %T", typ.Elem))
+	}
+	successors := []*BasicBlock{targetNormal, targetUnwind}
+	return &TermInvoke{
+		Callee:       callee,
+		Sig:          sig,
+		Args:         args,
+		TargetNormal: targetNormal,
+		TargetUnwind: targetUnwind,
+		Successors:   successors,
+	}
+}
+
+// Type returns the type of the terminator.
+func (term *TermInvoke) Type() types.Type {
+	return term.Sig.Ret
+}
+
+// Ident returns the identifier associated with the terminator.
+func (term *TermInvoke) Ident() string {
+	return enc.Local(term.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// terminator.
+func (term *TermInvoke) GetName() string {
+	return term.Name
+}
+
+// SetName sets the name of the local variable associated with the terminator.
+func (term *TermInvoke) SetName(name string) {
+	term.Name = name
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermInvoke) String() string {
+	buf := &bytes.Buffer{}
+	if !term.Type().Equal(types.Void) {
+		fmt.Fprintf(buf, "%s = ", term.Ident())
+	}
+	// Print callee signature instead of return type for variadic callees.
+	sig := term.Sig
+	ret := sig.Ret.String()
+	if sig.Variadic {
+		ret = sig.String()
+	}
+	fmt.Fprintf(buf, "invoke %s %s(",
+		ret,
+		term.Callee.Ident())
+	for i, arg := range term.Args {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s %s",
+			arg.Type(),
+			arg.Ident())
+	}
+	fmt.Fprintf(buf, ") to label %s unwind label %s",
+		term.TargetNormal.Ident(),
+		term.TargetUnwind.Ident())
+	for _, md := range term.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermInvoke) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermInvoke) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermInvoke) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermInvoke) Succs() []*BasicBlock {
+	return term.Successors
+}
+
 // --- [ resume ] --------------------------------------------------------------
 
+// TermResume represents a resume terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#resume-instruction
+type TermResume struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Exception value to propagate.
+	X value.Value
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewResume returns a new resume terminator based on the given exception value.
+func NewResume(x value.Value) *TermResume {
+	return &TermResume{
+		X: x,
+	}
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermResume) String() string {
+	return fmt.Sprintf("resume %s %s%s",
+		term.X.Type(),
+		term.X.Ident(),
+		attachments(term.Metadata))
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermResume) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermResume) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermResume) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermResume) Succs() []*BasicBlock {
+	// resume terminators have no successors.
+	return nil
+}
+
 // --- [ catchswitch ] ---------------------------------------------------------
 
+// TermCatchSwitch represents a catchswitch terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchswitch-instruction
+type TermCatchSwitch struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Name of the local variable associated with the terminator.
+	Name string
+	// Parent exception pad; or a none token constant if not nested within
+	// another exception pad.
+	ParentPad value.Value
+	// Exception handlers.
+	Handlers []*BasicBlock
+	// Target branch when no handler matches; or nil if unwinding to the caller.
+	UnwindTarget *BasicBlock
+	// Successors basic blocks.
+	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewCatchSwitch returns a new catchswitch terminator based on the given parent
+// exception pad, exception handlers and optional unwind target branch. A nil
+// unwind target indicates unwinding to the caller.
+func NewCatchSwitch(parentPad value.Value, handlers []*BasicBlock, unwindTarget *BasicBlock) *TermCatchSwitch {
+	successors := append([]*BasicBlock(nil), handlers...)
+	if unwindTarget != nil {
+		successors = append(successors, unwindTarget)
+	}
+	return &TermCatchSwitch{
+		ParentPad:    parentPad,
+		Handlers:     handlers,
+		UnwindTarget: unwindTarget,
+		Successors:   successors,
+	}
+}
+
+// Type returns the type of the terminator.
+func (term *TermCatchSwitch) Type() types.Type {
+	return types.Token
+}
+
+// Ident returns the identifier associated with the terminator.
+func (term *TermCatchSwitch) Ident() string {
+	return enc.Local(term.Name)
+}
+
+// GetName returns the name of the local variable associated with the
+// terminator.
+func (term *TermCatchSwitch) GetName() string {
+	return term.Name
+}
+
+// SetName sets the name of the local variable associated with the terminator.
+func (term *TermCatchSwitch) SetName(name string) {
+	term.Name = name
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermCatchSwitch) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s = catchswitch within %s [",
+		term.Ident(),
+		term.ParentPad.Ident())
+	for i, handler := range term.Handlers {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "label %s", handler.Ident())
+	}
+	fmt.Fprintf(buf, "] unwind %s", unwindTarget(term.UnwindTarget))
+	for _, md := range term.Metadata {
+		fmt.Fprintf(buf, ", %s", md)
+	}
+	return buf.String()
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermCatchSwitch) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermCatchSwitch) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermCatchSwitch) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermCatchSwitch) Succs() []*BasicBlock {
+	return term.Successors
+}
+
 // --- [ catchret ] ------------------------------------------------------------
 
+// TermCatchRet represents a catchret terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#catchret-instruction
+type TermCatchRet struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Exited catchpad instruction.
+	CatchPad *InstCatchPad
+	// Target branch.
+	Target *BasicBlock
+	// Successors basic blocks.
+	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewCatchRet returns a new catchret terminator based on the given exited
+// catchpad instruction and target branch.
+func NewCatchRet(catchPad *InstCatchPad, target *BasicBlock) *TermCatchRet {
+	successors := []*BasicBlock{target}
+	return &TermCatchRet{
+		CatchPad:   catchPad,
+		Target:     target,
+		Successors: successors,
+	}
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermCatchRet) String() string {
+	return fmt.Sprintf("catchret from %s to label %s%s",
+		term.CatchPad.Ident(),
+		term.Target.Ident(),
+		attachments(term.Metadata))
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermCatchRet) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermCatchRet) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermCatchRet) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermCatchRet) Succs() []*BasicBlock {
+	return term.Successors
+}
+
 // --- [ cleanupret ] ----------------------------------------------------------
 
+// TermCleanupRet represents a cleanupret terminator.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#cleanupret-instruction
+type TermCleanupRet struct {
+	// Parent basic block.
+	Parent *BasicBlock
+	// Exited cleanuppad instruction.
+	CleanupPad *InstCleanupPad
+	// Target branch to unwind to; or nil if unwinding to the caller.
+	UnwindTarget *BasicBlock
+	// Successors basic blocks.
+	Successors []*BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
+}
+
+// NewCleanupRet returns a new cleanupret terminator based on the given exited
+// cleanuppad instruction and optional unwind target branch. A nil unwind target
+// indicates unwinding to the caller.
+func NewCleanupRet(cleanupPad *InstCleanupPad, unwindTarget *BasicBlock) *TermCleanupRet {
+	var successors []*BasicBlock
+	if unwindTarget != nil {
+		successors = append(successors, unwindTarget)
+	}
+	return &TermCleanupRet{
+		CleanupPad:   cleanupPad,
+		UnwindTarget: unwindTarget,
+		Successors:   successors,
+	}
+}
+
+// String returns the LLVM syntax representation of the terminator.
+func (term *TermCleanupRet) String() string {
+	return fmt.Sprintf("cleanupret from %s unwind %s%s",
+		term.CleanupPad.Ident(),
+		unwindTarget(term.UnwindTarget),
+		attachments(term.Metadata))
+}
+
+// GetParent returns the parent basic block of the terminator.
+func (term *TermCleanupRet) GetParent() *BasicBlock {
+	return term.Parent
+}
+
+// SetParent sets the parent basic block of the terminator.
+func (term *TermCleanupRet) SetParent(parent *BasicBlock) {
+	term.Parent = parent
+}
+
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermCleanupRet) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
+// Succs returns the successor basic blocks of the terminator.
+func (term *TermCleanupRet) Succs() []*BasicBlock {
+	return term.Successors
+}
+
 // --- [ unreachable ] ---------------------------------------------------------
 
 // TermUnreachable represents an unreachable terminator.
@@ -285,6 +786,8 @@ func NewCase(x *constant.Int, target *BasicBlock) *Case {
 type TermUnreachable struct {
 	// Parent basic block.
 	Parent *BasicBlock
+	// Metadata attachments of the terminator.
+	Metadata []*metadata.Attachment
 }
 
 // NewUnreachable returns a new unreachable terminator.
@@ -294,7 +797,7 @@ func NewUnreachable() *TermUnreachable {
 
 // String returns the LLVM syntax representation of the terminator.
 func (term *TermUnreachable) String() string {
-	return "unreachable"
+	return fmt.Sprintf("unreachable%s", attachments(term.Metadata))
 }
 
 // GetParent returns the parent basic block of the terminator.
@@ -307,8 +810,24 @@ func (term *TermUnreachable) SetParent(parent *BasicBlock) {
 	term.Parent = parent
 }
 
+// GetMetadata returns the metadata attachments of the terminator.
+func (term *TermUnreachable) GetMetadata() []*metadata.Attachment {
+	return term.Metadata
+}
+
 // Succs returns the successor basic blocks of the terminator.
 func (term *TermUnreachable) Succs() []*BasicBlock {
 	// unreachable terminators have no successors.
 	return nil
 }
+
+// ### [ Helper functions ] ####################################################
+
+// unwindTarget returns the LLVM syntax representation of the given unwind
+// target branch; or "to caller" if nil.
+func unwindTarget(target *BasicBlock) string {
+	if target == nil {
+		return "to caller"
+	}
+	return fmt.Sprintf("label %s", target.Ident())
+}
@@ -78,7 +78,10 @@ type StructType struct {
 	Name string
 	// Struct fields.
 	Fields []Type
-	// Opaque struct type.
+	// Packed struct type; fields are laid out without alignment padding, and
+	// the struct has an alignment of one byte.
+	Packed bool
+	// Opaque struct type; the body of the struct is unknown.
 	//
 	// References:
 	//    http://llvm.org/docs/LangRef.html#opaque-structure-types
@@ -104,6 +107,9 @@ func (t *StructType) Def() string {
 		return "opaque"
 	}
 	buf := &bytes.Buffer{}
+	if t.Packed {
+		buf.WriteString("<")
+	}
 	buf.WriteString("{")
 	if len(t.Fields) > 0 {
 		// Use same output format as Clang.
@@ -120,18 +126,29 @@ func (t *StructType) Def() string {
 		buf.WriteString(" ")
 	}
 	buf.WriteString("}")
+	if t.Packed {
+		buf.WriteString(">")
+	}
 	return buf.String()
 }
 
 // Equal reports whether t and u are of equal type.
 func (t *StructType) Equal(u Type) bool {
 	if u, ok := u.(*StructType); ok {
+		if t == u {
+			return true
+		}
 		// Identified struct types are uniqued by type names, not by structural
-		// identity.
+		// identity. An identified struct type is never equal to a literal struct
+		// type, even if their bodies are identical.
 		if t.Identified() || u.Identified() {
 			return t.Name == u.Name
 		}
-		// Literal struct types are uniqued by structural identity.
+		// Literal struct types are uniqued by structural identity; i.e. by their
+		// fields and packedness.
+		if t.Packed != u.Packed {
+			return false
+		}
 		if len(t.Fields) != len(u.Fields) {
 			return false
 		}
@@ -160,3 +177,62 @@ func (t *StructType) SetName(name string) {
 func (t *StructType) Identified() bool {
 	return len(t.Name) > 0
 }
+
+// SetBody sets the body of the struct type, based on the given packedness and
+// struct fields. SetBody may be used to complete the definition of an opaque
+// struct type.
+func (t *StructType) SetBody(packed bool, fields ...Type) {
+	t.Fields = fields
+	t.Packed = packed
+	t.Opaque = false
+}
+
+// --- [ Element lookup ] ------------------------------------------------------
+
+// ElemType returns the type of the element at the given index of the aggregate
+// or vector type t; i.e. the element type of array and vector types, or the
+// type of the indexed field of struct types.
+//
+// Array and vector indices are not bounds checked, as getelementptr indices may
+// address elements outside of the aggregate; struct field indices are.
+func ElemType(t Type, index int64) (Type, error) {
+	switch t := t.(type) {
+	case *ArrayType:
+		return t.Elem, nil
+	case *VectorType:
+		return t.Elem, nil
+	case *StructType:
+		if index < 0 || index >= int64(len(t.Fields)) {
+			return nil, fmt.Errorf("invalid struct field index %d; struct type %v has %d fields", index, t, len(t.Fields))
+		}
+		return t.Fields[index], nil
+	default:
+		return nil, fmt.Errorf("unable to index into non-aggregate type %v", t)
+	}
+}
+
+// AggregateElemType returns the type of the element at the position in the
+// aggregate type t specified by the given extractvalue or insertvalue indices.
+//
+// Contrary to ElemType, array indices are bounds checked and vector types may
+// not be indexed, as extractvalue and insertvalue only address elements within
+// arrays and structs.
+func AggregateElemType(t Type, indices []int64) (Type, error) {
+	e := t
+	for _, index := range indices {
+		switch t := e.(type) {
+		case *ArrayType:
+			if index < 0 || index >= t.Len {
+				return nil, fmt.Errorf("invalid array index %d; out of bounds for type %v", index, t)
+			}
+		case *VectorType:
+			return nil, fmt.Errorf("unable to index into vector type %v; expected array or struct type", t)
+		}
+		elem, err := ElemType(e, index)
+		if err != nil {
+			return nil, err
+		}
+		e = elem
+	}
+	return e, nil
+}
@@ -147,6 +147,8 @@ type Param struct {
 	Name string
 	// Parameter type.
 	Typ Type
+	// Parameter attributes.
+	Attrs []ParamAttribute
 }
 
 // NewParam returns a new function parameter based on the given parameter name
@@ -175,6 +177,21 @@ func (param *Param) SetName(name string) {
 	param.Name = name
 }
 
+// A ParamAttribute represents a parameter attribute of a function parameter,
+// return value or call site argument.
+//
+// ParamAttribute may have one of the following underlying types.
+//
+//    ir.AttrKind     (https://godoc.org/github.com/llir/llvm/ir#AttrKind)
+//    ir.AttrInt      (https://godoc.org/github.com/llir/llvm/ir#AttrInt)
+//    ir.AttrString   (https://godoc.org/github.com/llir/llvm/ir#AttrString)
+type ParamAttribute interface {
+	fmt.Stringer
+	// IsParamAttribute ensures that only parameter attributes can be assigned
+	// to the types.ParamAttribute interface.
+	IsParamAttribute()
+}
+
 // --- [ label ] ---------------------------------------------------------------
 
 // LabelType represents a label type, which is used for basic block values.
@@ -254,3 +271,45 @@ func (t *MetadataType) GetName() string {
 func (t *MetadataType) SetName(name string) {
 	t.Name = name
 }
+
+// --- [ token ] ---------------------------------------------------------------
+
+// TokenType represents a token type, which is used for values associated with
+// instructions that may not be inspected or obscured by optimizations (e.g.
+// exception handling pads).
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#token-type
+type TokenType struct {
+	// Type name alias.
+	Name string
+}
+
+// String returns the LLVM syntax representation of the type.
+func (t *TokenType) String() string {
+	if len(t.Name) > 0 {
+		return enc.Local(t.Name)
+	}
+	return t.Def()
+}
+
+// Def returns the LLVM syntax representation of the definition of the type.
+func (t *TokenType) Def() string {
+	return "token"
+}
+
+// Equal reports whether t and u are of equal type.
+func (t *TokenType) Equal(u Type) bool {
+	_, ok := u.(*TokenType)
+	return ok
+}
+
+// GetName returns the name of the type.
+func (t *TokenType) GetName() string {
+	return t.Name
+}
+
+// SetName sets the name of the type.
+func (t *TokenType) SetName(name string) {
+	t.Name = name
+}
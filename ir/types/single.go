@@ -115,6 +115,7 @@ const (
 	FloatKindIEEE_128                           // fp128:     128-bit floating point type (112-bit mantissa)
 	FloatKindDoubleExtended_80                  // x86_fp80:  80-bit floating point type (x87)
 	FloatKindDoubleDouble_128                   // ppc_fp128: 128-bit floating point type (two 64-bits, PowerPC)
+	FloatKindBrain_16                           // bfloat:    16-bit brain floating point type (7-bit mantissa)
 )
 
 // String returns the LLVM syntax representation of the floating-point kind.
@@ -132,6 +133,8 @@ func (kind FloatKind) String() string {
 		return "x86_fp80"
 	case FloatKindDoubleDouble_128:
 		return "ppc_fp128"
+	case FloatKindBrain_16:
+		return "bfloat"
 	}
 	return fmt.Sprintf("<unknown floating-point kind %d>", int(kind))
 }
@@ -201,8 +204,11 @@ type VectorType struct {
 	Name string
 	// Element type.
 	Elem Type
-	// Vector length.
+	// Vector length; or the minimum vector length if scalable.
 	Len int64
+	// Scalable vector type; the vector length is an unknown integer multiple
+	// (vscale) of Len, determined at runtime.
+	Scalable bool
 }
 
 // NewVector returns a new vector type based on the given element type and
@@ -221,6 +227,11 @@ func (t *VectorType) String() string {
 
 // Def returns the LLVM syntax representation of the definition of the type.
 func (t *VectorType) Def() string {
+	if t.Scalable {
+		return fmt.Sprintf("<vscale x %d x %s>",
+			t.Len,
+			t.Elem)
+	}
 	return fmt.Sprintf("<%d x %s>",
 		t.Len,
 		t.Elem)
@@ -229,7 +240,7 @@ func (t *VectorType) Def() string {
 // Equal reports whether t and u are of equal type.
 func (t *VectorType) Equal(u Type) bool {
 	if u, ok := u.(*VectorType); ok {
-		return t.Elem.Equal(u.Elem) && t.Len == u.Len
+		return t.Elem.Equal(u.Elem) && t.Len == u.Len && t.Scalable == u.Scalable
 	}
 	return false
 }
@@ -243,3 +254,44 @@ func (t *VectorType) GetName() string {
 func (t *VectorType) SetName(name string) {
 	t.Name = name
 }
+
+// --- [ x86_mmx ] -------------------------------------------------------------
+
+// MMXType represents an x86_mmx type, which is used for values held in MMX
+// registers of x86 machines.
+//
+// References:
+//    http://llvm.org/docs/LangRef.html#x86-mmx-type
+type MMXType struct {
+	// Type name alias.
+	Name string
+}
+
+// String returns the LLVM syntax representation of the type.
+func (t *MMXType) String() string {
+	if len(t.Name) > 0 {
+		return enc.Local(t.Name)
+	}
+	return t.Def()
+}
+
+// Def returns the LLVM syntax representation of the definition of the type.
+func (t *MMXType) Def() string {
+	return "x86_mmx"
+}
+
+// Equal reports whether t and u are of equal type.
+func (t *MMXType) Equal(u Type) bool {
+	_, ok := u.(*MMXType)
+	return ok
+}
+
+// GetName returns the name of the type.
+func (t *MMXType) GetName() string {
+	return t.Name
+}
+
+// SetName sets the name of the type.
+func (t *MMXType) SetName(name string) {
+	t.Name = name
+}
@@ -18,8 +18,10 @@ import "fmt"
 //    *types.FloatType      (https://godoc.org/github.com/llir/llvm/ir/types#FloatType)
 //    *types.PointerType    (https://godoc.org/github.com/llir/llvm/ir/types#PointerType)
 //    *types.VectorType     (https://godoc.org/github.com/llir/llvm/ir/types#VectorType)
+//    *types.MMXType        (https://godoc.org/github.com/llir/llvm/ir/types#MMXType)
 //    *types.LabelType      (https://godoc.org/github.com/llir/llvm/ir/types#LabelType)
 //    *types.MetadataType   (https://godoc.org/github.com/llir/llvm/ir/types#MetadataType)
+//    *types.TokenType      (https://godoc.org/github.com/llir/llvm/ir/types#TokenType)
 //    *types.ArrayType      (https://godoc.org/github.com/llir/llvm/ir/types#ArrayType)
 //    *types.StructType     (https://godoc.org/github.com/llir/llvm/ir/types#StructType)
 type Type interface {
@@ -52,6 +54,8 @@ var (
 	I128 = NewInt(128)
 	// Half represents the `half` floating-point type.
 	Half = &FloatType{Kind: FloatKindIEEE_16}
+	// BFloat represents the `bfloat` floating-point type.
+	BFloat = &FloatType{Kind: FloatKindBrain_16}
 	// Float represents the `float` floating-point type.
 	Float = &FloatType{Kind: FloatKindIEEE_32}
 	// Double represents the `double` floating-point type.
@@ -62,10 +66,14 @@ var (
 	X86_FP80 = &FloatType{Kind: FloatKindDoubleExtended_80}
 	// PPC_FP128 represents the `ppc_fp128` floating-point type.
 	PPC_FP128 = &FloatType{Kind: FloatKindDoubleDouble_128}
+	// X86_MMX represents the `x86_mmx` type.
+	X86_MMX = &MMXType{}
 	// Label represents the `label` type.
 	Label = &LabelType{}
 	// Metadata represents the `metadata` type.
 	Metadata = &MetadataType{}
+	// Token represents the `token` type.
+	Token = &TokenType{}
 )
 
 // Equal reports whether t and u are of equal type.
@@ -118,6 +126,20 @@ func IsVector(t Type) bool {
 	return ok
 }
 
+// IsScalableVector reports whether the given type is a scalable vector type.
+func IsScalableVector(t Type) bool {
+	if t, ok := t.(*VectorType); ok {
+		return t.Scalable
+	}
+	return false
+}
+
+// IsMMX reports whether the given type is an x86_mmx type.
+func IsMMX(t Type) bool {
+	_, ok := t.(*MMXType)
+	return ok
+}
+
 // IsLabel reports whether the given type is a label type.
 func IsLabel(t Type) bool {
 	_, ok := t.(*LabelType)
@@ -130,6 +152,12 @@ func IsMetadata(t Type) bool {
 	return ok
 }
 
+// IsToken reports whether the given type is a token type.
+func IsToken(t Type) bool {
+	_, ok := t.(*TokenType)
+	return ok
+}
+
 // IsArray reports whether the given type is an array type.
 func IsArray(t Type) bool {
 	_, ok := t.(*ArrayType)
@@ -55,6 +55,7 @@ func TestFloatTypeString(t *testing.T) {
 		typ  *types.FloatType
 	}{
 		{want: "half", typ: types.Half},
+		{want: "bfloat", typ: types.BFloat},
 		{want: "float", typ: types.Float},
 		{want: "double", typ: types.Double},
 		{want: "fp128", typ: types.FP128},
@@ -77,6 +78,22 @@ func TestMetadataTypeString(t *testing.T) {
 	}
 }
 
+func TestTokenTypeString(t *testing.T) {
+	const want = "token"
+	got := types.Token.String()
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMMXTypeString(t *testing.T) {
+	const want = "x86_mmx"
+	got := types.X86_MMX.String()
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
 func TestFuncTypeString(t *testing.T) {
 	i8, i32 := types.I8, types.I32
 	formatParam := types.NewParam("format", types.NewPointer(i8))
@@ -124,6 +141,7 @@ func TestVectorTypeString(t *testing.T) {
 	}{
 		{want: "<10 x i8>", typ: types.NewVector(types.I8, 10)},
 		{want: "<42 x i8*>", typ: types.NewVector(types.NewPointer(types.I8), 42)},
+		{want: "<vscale x 4 x i32>", typ: &types.VectorType{Elem: types.I32, Len: 4, Scalable: true}},
 	}
 	for i, g := range golden {
 		got := g.typ.String()
@@ -157,6 +175,8 @@ func TestStructTypeString(t *testing.T) {
 		{want: "{ i32, i8* }", typ: types.NewStruct(types.I32, types.NewPointer(types.I8))},
 		{want: "{ i32, i16, i8 }", typ: types.NewStruct(types.I32, types.I16, types.I8)},
 		{want: "{}", typ: types.NewStruct()},
+		{want: "<{ i8, i32 }>", typ: &types.StructType{Fields: []types.Type{types.I8, types.I32}, Packed: true}},
+		{want: "<{}>", typ: &types.StructType{Packed: true}},
 	}
 	for i, g := range golden {
 		got := g.typ.String()
@@ -536,6 +556,85 @@ func TestIsStruct(t *testing.T) {
 	}
 }
 
+func TestIsToken(t *testing.T) {
+	golden := []struct {
+		want bool
+		typ  types.Type
+	}{
+		{want: false, typ: types.Void},
+		{want: false, typ: types.Label},
+		{want: false, typ: types.Metadata},
+		{want: true, typ: types.Token},
+		{want: true, typ: &types.TokenType{}},
+		{want: false, typ: types.I8},
+		{want: false, typ: types.Float},
+		{want: false, typ: &types.FuncType{}},
+		{want: false, typ: &types.PointerType{}},
+		{want: false, typ: &types.VectorType{}},
+		{want: false, typ: &types.ArrayType{}},
+		{want: false, typ: &types.StructType{}},
+	}
+	for i, g := range golden {
+		got := types.IsToken(g.typ)
+		if got != g.want {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+}
+
+func TestIsMMX(t *testing.T) {
+	golden := []struct {
+		want bool
+		typ  types.Type
+	}{
+		{want: false, typ: types.Void},
+		{want: false, typ: types.Token},
+		{want: true, typ: types.X86_MMX},
+		{want: true, typ: &types.MMXType{}},
+		{want: false, typ: types.I64},
+		{want: false, typ: types.X86_FP80},
+		{want: false, typ: types.NewVector(types.I8, 8)},
+	}
+	for i, g := range golden {
+		got := types.IsMMX(g.typ)
+		if got != g.want {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+}
+
+func TestIsScalableVector(t *testing.T) {
+	golden := []struct {
+		want bool
+		typ  types.Type
+	}{
+		{want: false, typ: types.I32},
+		{want: false, typ: types.NewVector(types.I32, 4)},
+		{want: true, typ: &types.VectorType{Elem: types.I32, Len: 4, Scalable: true}},
+		{want: false, typ: types.NewArray(types.I32, 4)},
+	}
+	for i, g := range golden {
+		got := types.IsScalableVector(g.typ)
+		if got != g.want {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+}
+
+func TestScalableVectorEqual(t *testing.T) {
+	fixed := types.NewVector(types.I32, 4)
+	scalable := &types.VectorType{Elem: types.I32, Len: 4, Scalable: true}
+	if fixed.Equal(scalable) || scalable.Equal(fixed) {
+		t.Errorf("expected fixed and scalable vector types to differ")
+	}
+	if !scalable.Equal(&types.VectorType{Elem: types.I32, Len: 4, Scalable: true}) {
+		t.Errorf("expected scalable vector types to be equal")
+	}
+	if types.X86_MMX.Equal(types.NewVector(types.I8, 8)) {
+		t.Errorf("expected x86_mmx and <8 x i8> to differ")
+	}
+}
+
 func TestEqual(t *testing.T) {
 	golden := []struct {
 		want bool
@@ -1047,6 +1146,129 @@ func TestStructEqual(t *testing.T) {
 	}
 }
 
+func TestStructEqualNominal(t *testing.T) {
+	i8, i32 := types.I8, types.I32
+	literal := types.NewStruct(i8, i32)
+	packed := &types.StructType{Fields: []types.Type{i8, i32}, Packed: true}
+	foo := &types.StructType{Name: "foo", Fields: []types.Type{i8, i32}}
+	foo2 := &types.StructType{Name: "foo", Fields: []types.Type{i8, i32}}
+	bar := &types.StructType{Name: "bar", Fields: []types.Type{i8, i32}}
+	opaque := &types.StructType{Name: "baz", Opaque: true}
+
+	golden := []struct {
+		want bool
+		t, u types.Type
+	}{
+		// Literal struct types are compared structurally, including packedness.
+		{want: true, t: literal, u: types.NewStruct(i8, i32)},
+		{want: false, t: literal, u: packed},
+		{want: false, t: packed, u: literal},
+		{want: true, t: packed, u: &types.StructType{Fields: []types.Type{i8, i32}, Packed: true}},
+		// Identified struct types are compared by name.
+		{want: true, t: foo, u: foo},
+		{want: true, t: foo, u: foo2},
+		{want: false, t: foo, u: bar},
+		// Identified struct types are never equal to literal struct types.
+		{want: false, t: foo, u: literal},
+		{want: false, t: literal, u: foo},
+		{want: false, t: opaque, u: types.NewStruct()},
+	}
+	for i, g := range golden {
+		got := g.t.Equal(g.u)
+		if got != g.want {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+}
+
+func TestStructSetBody(t *testing.T) {
+	typ := &types.StructType{Name: "foo", Opaque: true}
+	if got, want := typ.Def(), "opaque"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	typ.SetBody(true, types.I8, types.I32)
+	if got, want := typ.Def(), "<{ i8, i32 }>"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if typ.Opaque {
+		t.Errorf("expected non-opaque struct type after SetBody")
+	}
+}
+
+func TestElemType(t *testing.T) {
+	inner := types.NewStruct(types.I16, types.I32)
+	golden := []struct {
+		t     types.Type
+		index int64
+		want  types.Type
+	}{
+		{t: types.NewArray(types.I8, 4), index: 2, want: types.I8},
+		// Array and vector indices are not bounds checked.
+		{t: types.NewArray(types.I8, 4), index: 5, want: types.I8},
+		{t: types.NewVector(types.Float, 4), index: 1, want: types.Float},
+		{t: inner, index: 0, want: types.I16},
+		{t: inner, index: 1, want: types.I32},
+	}
+	for i, g := range golden {
+		got, err := types.ElemType(g.t, g.index)
+		if err != nil {
+			t.Errorf("i=%d; unexpected error: %v", i, err)
+			continue
+		}
+		if !got.Equal(g.want) {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+	if _, err := types.ElemType(inner, 2); err == nil {
+		t.Errorf("expected error for out of bounds struct field index, got nil")
+	}
+	if _, err := types.ElemType(types.I32, 0); err == nil {
+		t.Errorf("expected error for indexing into non-aggregate type, got nil")
+	}
+}
+
+func TestAggregateElemType(t *testing.T) {
+	inner := types.NewStruct(types.I16, types.NewArray(types.I32, 2))
+	outer := types.NewArray(inner, 3)
+	golden := []struct {
+		t       types.Type
+		indices []int64
+		want    types.Type
+	}{
+		{t: outer, indices: nil, want: outer},
+		{t: outer, indices: []int64{2}, want: inner},
+		{t: outer, indices: []int64{1, 0}, want: types.I16},
+		{t: outer, indices: []int64{0, 1, 1}, want: types.I32},
+	}
+	for i, g := range golden {
+		got, err := types.AggregateElemType(g.t, g.indices)
+		if err != nil {
+			t.Errorf("i=%d; unexpected error: %v", i, err)
+			continue
+		}
+		if !got.Equal(g.want) {
+			t.Errorf("i=%d; expected %v, got %v", i, g.want, got)
+		}
+	}
+	invalid := []struct {
+		t       types.Type
+		indices []int64
+	}{
+		// Array indices are bounds checked.
+		{t: outer, indices: []int64{3}},
+		{t: outer, indices: []int64{-1}},
+		{t: outer, indices: []int64{0, 1, 2}},
+		{t: outer, indices: []int64{0, 2}},
+		{t: types.NewVector(types.I32, 4), indices: []int64{0}},
+		{t: types.I32, indices: []int64{0}},
+	}
+	for i, g := range invalid {
+		if _, err := types.AggregateElemType(g.t, g.indices); err == nil {
+			t.Errorf("i=%d; expected error for indices %v into type %v, got nil", i, g.indices, g.t)
+		}
+	}
+}
+
 // Validate that the relevant types satisfy the types.Type interface.
 var (
 	_ types.Type = &types.VoidType{}
@@ -1057,6 +1279,8 @@ var (
 	_ types.Type = &types.VectorType{}
 	_ types.Type = &types.LabelType{}
 	_ types.Type = &types.MetadataType{}
+	_ types.Type = &types.TokenType{}
+	_ types.Type = &types.MMXType{}
 	_ types.Type = &types.ArrayType{}
 	_ types.Type = &types.StructType{}
 )
@@ -10,6 +10,8 @@ import "github.com/llir/llvm/ir/types"
 //
 //    constant.Constant   (https://godoc.org/github.com/llir/llvm/ir/constant#Constant)
 //    value.Named         (https://godoc.org/github.com/llir/llvm/ir/value#Named)
+//    *ir.Arg             (https://godoc.org/github.com/llir/llvm/ir#Arg)
+//    metadata.Metadata   (https://godoc.org/github.com/llir/llvm/ir/metadata#Metadata)
 type Value interface {
 	// Type returns the type of the value.
 	Type() types.Type
@@ -22,6 +24,8 @@ type Value interface {
 // Named may have one of the following underlying types.
 //
 //    *ir.Global       (https://godoc.org/github.com/llir/llvm/ir#Global)
+//    *ir.Alias        (https://godoc.org/github.com/llir/llvm/ir#Alias)
+//    *ir.IFunc        (https://godoc.org/github.com/llir/llvm/ir#IFunc)
 //    *ir.Function     (https://godoc.org/github.com/llir/llvm/ir#Function)
 //    *types.Param     (https://godoc.org/github.com/llir/llvm/ir/types#Param)
 //    *ir.BasicBlock   (https://godoc.org/github.com/llir/llvm/ir#BasicBlock)
@@ -10,12 +10,13 @@ import (
 	"github.com/llir/llvm/ir/constant"
 	"github.com/llir/llvm/ir/irutil"
 	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
 	"github.com/pkg/errors"
 )
 
 // Check performs static semantic analysis on the given LLVM IR module.
 func Check(m *ir.Module) error {
-	sem := &sem{}
+	sem := &sem{comdats: make(map[string]*ir.Comdat)}
 	// Validate type definitions.
 	for _, typ := range m.Types {
 		name := typ.GetName()
@@ -26,11 +27,30 @@ func Check(m *ir.Module) error {
 		}
 	}
 
+	// Validate comdat definitions.
+	for _, comdat := range m.Comdats {
+		name := comdat.Name
+		if len(name) == 0 {
+			sem.Errorf("comdat name missing in comdat definition")
+		} else if !isValidIdent(name) {
+			sem.Errorf("invalid comdat name `%v`", enc.Comdat(name))
+		}
+		if _, ok := sem.comdats[name]; ok {
+			sem.Errorf("comdat %s already defined", enc.Comdat(name))
+			continue
+		}
+		sem.comdats[name] = comdat
+	}
+
 	// check performs static semantic analysis on the given LLVM IR node.
 	check := func(n interface{}) {
 		switch n := n.(type) {
 		case *ir.Global:
 			sem.checkGlobal(n)
+		case *ir.Alias:
+			sem.checkAlias(n)
+		case *ir.IFunc:
+			sem.checkIFunc(n)
 		case *ir.Function:
 			sem.checkFunc(n)
 		case *ir.BasicBlock:
@@ -39,10 +59,12 @@ func Check(m *ir.Module) error {
 			sem.checkType(n)
 		case constant.Constant:
 			sem.checkConst(n)
-		case ir.Instruction:
-			sem.checkInst(n)
+		// Check terminators before instructions, as terminators also implement
+		// the ir.Instruction interface.
 		case ir.Terminator:
 			sem.checkTerm(n)
+		case ir.Instruction:
+			sem.checkInst(n)
 		}
 	}
 	irutil.Walk(m, check)
@@ -66,6 +88,8 @@ func (es ErrorList) Error() string {
 
 // sem represents a static semantic analysis checker for LLVM IR.
 type sem struct {
+	// comdats maps from comdat names to the comdat definitions of the module.
+	comdats map[string]*ir.Comdat
 	// List of identified errors.
 	errs ErrorList
 }
@@ -95,6 +119,8 @@ func (sem *sem) checkGlobal(global *ir.Global) {
 	// Validate global variable content type.
 	if !isSingleValueType(content) && !isAggregateType(content) {
 		sem.Errorf("invalid global variable content type; expected single value or aggregate type, got %T", content)
+	} else if types.IsScalableVector(content) {
+		sem.Errorf("invalid global variable content type; scalable vector type `%v` not allowed", content)
 	}
 	// Validate global variable initial value
 	if init := global.Init; init != nil {
@@ -102,6 +128,114 @@ func (sem *sem) checkGlobal(global *ir.Global) {
 			sem.Errorf("global variable content type `%v` and initial value type `%v` mismatch", content, init.Type())
 		}
 	}
+	// Validate global variable linkage.
+	sem.checkLinkage(global.Ident(), global.Linkage, global.Visibility, global.DLLStorageClass, global.Init == nil)
+	if global.Linkage == ir.LinkageAppending {
+		if _, ok := content.(*types.ArrayType); !ok {
+			sem.Errorf("invalid content type of global variable %s with appending linkage; expected array type, got `%v`", global.Ident(), content)
+		}
+	}
+	// Validate global variable comdat and alignment.
+	sem.checkGlobalAttrs(global.Ident(), global.Comdat, global.Align, global.Init == nil)
+}
+
+// --- [ Aliases ] -------------------------------------------------------------
+
+// checkAlias validates the semantics of the given alias.
+func (sem *sem) checkAlias(alias *ir.Alias) {
+	// Validate alias name.
+	if len(alias.Name) == 0 {
+		sem.Errorf("alias name missing")
+	} else if !isValidIdent(alias.Name) {
+		sem.Errorf("invalid alias name `%v`", enc.Global(alias.Name))
+	}
+	// Validate alias type.
+	content, elem := alias.Content, alias.Typ.Elem
+	if !content.Equal(elem) {
+		sem.Errorf("alias content type `%v` and element type `%v` mismatch", content, elem)
+	}
+	// Validate aliasee.
+	if alias.Aliasee == nil {
+		sem.Errorf("aliasee of alias %s missing", alias.Ident())
+		return
+	}
+	if want := types.NewPointer(content); !want.Equal(alias.Aliasee.Type()) {
+		sem.Errorf("alias %s content type `%v` and aliasee type `%v` mismatch; expected `%v`", alias.Ident(), content, alias.Aliasee.Type(), want)
+	}
+	// Validate that the chain of aliasees is free of cycles and ends in a
+	// definition.
+	visited := map[*ir.Alias]bool{alias: true}
+	chain := []string{alias.Ident()}
+	aliasee := alias.Aliasee
+	for {
+		base := aliaseeBase(aliasee)
+		next, ok := base.(*ir.Alias)
+		if !ok {
+			switch base := base.(type) {
+			case *ir.Global:
+				if base.Init == nil {
+					sem.Errorf("invalid aliasee of alias %s; expected definition, got declaration %s", alias.Ident(), base.Ident())
+				}
+			case *ir.Function:
+				if len(base.Blocks) == 0 {
+					sem.Errorf("invalid aliasee of alias %s; expected definition, got declaration %s", alias.Ident(), base.Ident())
+				}
+			}
+			break
+		}
+		chain = append(chain, next.Ident())
+		if visited[next] {
+			sem.Errorf("alias cycle detected; %s", strings.Join(chain, " -> "))
+			break
+		}
+		visited[next] = true
+		if next.Aliasee == nil {
+			break
+		}
+		aliasee = next.Aliasee
+	}
+	// Validate alias linkage.
+	if !isValidAliasLinkage(alias.Linkage) {
+		sem.Errorf("invalid linkage type of alias %s; expected private, internal, linkonce, weak, linkonce_odr, weak_odr or external linkage, got %v", alias.Ident(), alias.Linkage)
+	}
+	sem.checkLinkage(alias.Ident(), alias.Linkage, alias.Visibility, alias.DLLStorageClass, false)
+}
+
+// --- [ IFuncs ] --------------------------------------------------------------
+
+// checkIFunc validates the semantics of the given IFunc.
+func (sem *sem) checkIFunc(ifunc *ir.IFunc) {
+	// Validate IFunc name.
+	if len(ifunc.Name) == 0 {
+		sem.Errorf("IFunc name missing")
+	} else if !isValidIdent(ifunc.Name) {
+		sem.Errorf("invalid IFunc name `%v`", enc.Global(ifunc.Name))
+	}
+	// Validate IFunc type.
+	content, elem := ifunc.Content, ifunc.Typ.Elem
+	if !content.Equal(elem) {
+		sem.Errorf("IFunc content type `%v` and element type `%v` mismatch", content, elem)
+	}
+	if _, ok := content.(*types.FuncType); !ok {
+		sem.Errorf("invalid IFunc content type; expected function type, got %T", content)
+	}
+	// Validate resolver function.
+	if ifunc.Resolver == nil {
+		sem.Errorf("resolver function of IFunc %s missing", ifunc.Ident())
+		return
+	}
+	want := types.NewPointer(types.NewFunc(types.NewPointer(content)))
+	if got := ifunc.Resolver.Type(); !want.Equal(got) {
+		sem.Errorf("invalid resolver function type of IFunc %s; expected `%v`, got `%v`", ifunc.Ident(), want, got)
+	}
+	if _, ok := aliaseeBase(ifunc.Resolver).(*ir.Function); !ok {
+		sem.Errorf("invalid resolver function of IFunc %s; expected function, got %T", ifunc.Ident(), aliaseeBase(ifunc.Resolver))
+	}
+	// Validate IFunc linkage.
+	if !isValidAliasLinkage(ifunc.Linkage) {
+		sem.Errorf("invalid linkage type of IFunc %s; expected private, internal, linkonce, weak, linkonce_odr, weak_odr or external linkage, got %v", ifunc.Ident(), ifunc.Linkage)
+	}
+	sem.checkLinkage(ifunc.Ident(), ifunc.Linkage, ifunc.Visibility, ir.DLLStorageNone, false)
 }
 
 // --- [ Functions ] -----------------------------------------------------------
@@ -123,10 +257,71 @@ func (sem *sem) checkFunc(f *ir.Function) {
 	if !sig.Equal(elem) {
 		sem.Errorf("function signature type `%v` and element type `%v` mismatch", sig, elem)
 	}
+	// Validate function linkage.
+	sem.checkLinkage(f.Ident(), f.Linkage, f.Visibility, f.DLLStorageClass, len(f.Blocks) == 0)
+	if f.Linkage == ir.LinkageCommon || f.Linkage == ir.LinkageAppending {
+		sem.Errorf("invalid linkage type of function %s; %v linkage is only valid for global variables", f.Ident(), f.Linkage)
+	}
+	// Validate function comdat and alignment.
+	sem.checkGlobalAttrs(f.Ident(), f.Comdat, f.Align, len(f.Blocks) == 0)
+	// Token return types, and metadata and token parameter types, are only
+	// valid for intrinsic functions.
+	if !isIntrinsic(f) {
+		if types.IsToken(sig.Ret) {
+			sem.Errorf("invalid return type of function %s; token type only allowed for intrinsic functions", f.Ident())
+		}
+		for _, param := range sig.Params {
+			switch {
+			case types.IsMetadata(param.Typ):
+				sem.Errorf("invalid parameter type of function %s; metadata type only allowed for intrinsic functions", f.Ident())
+			case types.IsToken(param.Typ):
+				sem.Errorf("invalid parameter type of function %s; token type only allowed for intrinsic functions", f.Ident())
+			}
+		}
+	}
 	// f.Sig is validated when later traversed.
 	// f.Blocks is validated when later traversed.
 }
 
+// --- [ Linkage ] -------------------------------------------------------------
+
+// checkLinkage validates the linkage type, visibility style and DLL storage
+// class of the given global variable or function, as identified by ident.
+func (sem *sem) checkLinkage(ident string, linkage ir.Linkage, visibility ir.Visibility, class ir.DLLStorageClass, isDecl bool) {
+	switch {
+	case isDecl && linkage != ir.LinkageExternal && linkage != ir.LinkageExternWeak:
+		sem.Errorf("invalid linkage type of declaration %s; declarations must have external or extern_weak linkage, got %v", ident, linkage)
+	case !isDecl && linkage == ir.LinkageExternWeak:
+		sem.Errorf("invalid linkage type of definition %s; definitions must not have extern_weak linkage", ident)
+	}
+	if linkage == ir.LinkagePrivate || linkage == ir.LinkageInternal {
+		if visibility != ir.VisibilityDefault {
+			sem.Errorf("invalid visibility style of %s; global values with %v linkage must have default visibility, got %v", ident, linkage, visibility)
+		}
+		if class != ir.DLLStorageNone {
+			sem.Errorf("invalid DLL storage class of %s; global values with %v linkage must not have DLL storage class, got %v", ident, linkage, class)
+		}
+	}
+}
+
+// --- [ Comdats and alignment ] -----------------------------------------------
+
+// checkGlobalAttrs validates the comdat and alignment of the given global
+// variable or function, as identified by ident.
+func (sem *sem) checkGlobalAttrs(ident string, comdat *ir.Comdat, align int, isDecl bool) {
+	if comdat != nil {
+		if isDecl {
+			sem.Errorf("invalid comdat of declaration %s; declarations must not be in a comdat", ident)
+		}
+		if sem.comdats[comdat.Name] != comdat {
+			sem.Errorf("comdat %s of %s not defined in module", comdat.Ident(), ident)
+		}
+	}
+	if align < 0 || align&(align-1) != 0 {
+		sem.Errorf("invalid alignment %d of %s; expected power of two", align, ident)
+	}
+}
+
 // --- [ Basic blocks ] --------------------------------------------------------
 
 // checkBlock validates the semantics of the given basic block.
@@ -161,7 +356,9 @@ func (sem *sem) checkType(t types.Type) {
 		//
 		// References:
 		//    http://llvm.org/docs/LangRef.html#function-type
-		if !types.IsVoid(t.Ret) && !isSingleValueType(t.Ret) && !isAggregateType(t.Ret) {
+		// Token return types are validated by checkFunc, as they are only valid
+		// for intrinsic functions.
+		if !types.IsVoid(t.Ret) && !types.IsToken(t.Ret) && !isSingleValueType(t.Ret) && !isAggregateType(t.Ret) {
 			sem.Errorf("invalid function return type; expected void, single value or aggregate type, got %T", t.Ret)
 		}
 		for _, param := range t.Params {
@@ -170,6 +367,8 @@ func (sem *sem) checkType(t types.Type) {
 			}
 			if !isFirstClassType(param.Typ) {
 				sem.Errorf("invalid function parameter; expected first class type, got %T", param.Typ)
+			} else if types.IsLabel(param.Typ) {
+				sem.Errorf("invalid function parameter; label type not allowed")
 			}
 		}
 	case *types.IntType:
@@ -191,8 +390,9 @@ func (sem *sem) checkType(t types.Type) {
 		case types.FloatKindIEEE_128:
 		case types.FloatKindDoubleExtended_80:
 		case types.FloatKindDoubleDouble_128:
+		case types.FloatKindBrain_16:
 		default:
-			sem.Errorf("invalid float type kind; expected half, float, double, fp128, x86_fp80 or ppc_fp128, got %v", t.Kind)
+			sem.Errorf("invalid float type kind; expected half, bfloat, float, double, fp128, x86_fp80 or ppc_fp128, got %v", t.Kind)
 		}
 	case *types.PointerType:
 		if !types.IsFunc(t.Elem) && !isSingleValueType(t.Elem) && !isAggregateType(t.Elem) {
@@ -208,18 +408,33 @@ func (sem *sem) checkType(t types.Type) {
 		if !types.IsInt(t.Elem) && !types.IsFloat(t.Elem) && !types.IsPointer(t.Elem) {
 			sem.Errorf("invalid vector element type; expected integer, floating-point or pointer type, got %T", t.Elem)
 		}
+	case *types.MMXType:
+		// nothing to do.
 	case *types.LabelType:
 		// nothing to do.
 	case *types.MetadataType:
 		// nothing to do.
+	case *types.TokenType:
+		// nothing to do.
 	case *types.ArrayType:
-		if !isSingleValueType(t.Elem) && !isAggregateType(t.Elem) {
+		// There are no arrays of x86_mmx or scalable vector types.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#x86-mmx-type
+		switch {
+		case !isSingleValueType(t.Elem) && !isAggregateType(t.Elem):
 			sem.Errorf("invalid array element type; expected single value or aggregate type, got %T", t.Elem)
+		case types.IsMMX(t.Elem):
+			sem.Errorf("invalid array element type; x86_mmx type not allowed")
+		case types.IsScalableVector(t.Elem):
+			sem.Errorf("invalid array element type; scalable vector type `%v` not allowed", t.Elem)
 		}
 	case *types.StructType:
 		for _, field := range t.Fields {
 			if !isSingleValueType(field) && !isAggregateType(field) {
 				sem.Errorf("invalid struct field type; expected single value or aggregate type, got %T", field)
+			} else if types.IsScalableVector(field) {
+				sem.Errorf("invalid struct field type; scalable vector type `%v` not allowed", field)
 			}
 		}
 	default:
@@ -247,10 +462,18 @@ func (sem *sem) checkConst(c constant.Constant) {
 		}
 	case *constant.Null:
 		// c.Typ is validated when later traversed.
+	case *constant.NoneToken:
+		// c.Typ is validated when later traversed.
 
 	// Complex constants.
 	case *constant.Vector:
 		// c.Typ is validated when later traversed.
+		// The length of scalable vectors is unknown at compile time; thus
+		// scalable vector constants may only be zeroinitializer, undef or
+		// poison.
+		if c.Typ.Scalable {
+			sem.Errorf("invalid vector constant type; scalable vector type `%v` not allowed", c.Typ)
+		}
 		// Validate number of vector elements.
 		if c.Typ.Len != int64(len(c.Elems)) {
 			sem.Errorf("number of vector elements mismatch for type `%v`; expected %d, got %d", c.Typ, c.Typ.Len, len(c.Elems))
@@ -295,6 +518,52 @@ func (sem *sem) checkConst(c constant.Constant) {
 	case *constant.ZeroInitializer:
 		// c.Typ is validated when later traversed.
 
+	// Undefined values.
+	case *constant.Undef:
+		// The `undef` constant may be of any single value or aggregate type.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#undefined-values
+
+		// c.Typ is validated when later traversed.
+		if !isSingleValueType(c.Typ) && !isAggregateType(c.Typ) {
+			sem.Errorf("invalid undef constant type; expected single value or aggregate type, got %T", c.Typ)
+		}
+	case *constant.Poison:
+		// The `poison` constant may be of any single value or aggregate type.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#poison-values
+
+		// c.Typ is validated when later traversed.
+		if !isSingleValueType(c.Typ) && !isAggregateType(c.Typ) {
+			sem.Errorf("invalid poison constant type; expected single value or aggregate type, got %T", c.Typ)
+		}
+
+	// Addresses of basic blocks.
+	case *constant.BlockAddress:
+		// The `blockaddress` constant computes the address of the specified
+		// basic block in the specified function. The basic block must belong to
+		// the function.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#addresses-of-basic-blocks
+
+		// c.Typ is validated when later traversed.
+		f, ok := c.Func.(*ir.Function)
+		if !ok {
+			sem.Errorf("invalid `blockaddress` constant function type; expected *ir.Function, got %T", c.Func)
+			return
+		}
+		block, ok := c.Block.(*ir.BasicBlock)
+		if !ok {
+			sem.Errorf("invalid `blockaddress` constant basic block type; expected *ir.BasicBlock, got %T", c.Block)
+			return
+		}
+		if block.Parent != f || !containsBlock(f.Blocks, block) {
+			sem.Errorf("basic block %s of `blockaddress` constant does not belong to function %s", block.Ident(), f.Ident())
+		}
+
 	// Binary expressions.
 	case *constant.ExprAdd:
 		// The two arguments to the `add` instruction must be integer or vector of
@@ -592,6 +861,53 @@ func (sem *sem) checkConst(c constant.Constant) {
 			sem.Errorf("`xor` expression x type `%v` and y type `%v` mismatch", xType, yType)
 		}
 
+	// Vector expressions.
+	case *constant.ExprExtractElement:
+		// c.Typ is validated when later traversed.
+		// c.X is validated when later traversed.
+		// c.Index is validated when later traversed.
+		sem.checkExtractElement("`extractelement` expression", c.Typ, c.X, c.Index)
+	case *constant.ExprInsertElement:
+		// c.X is validated when later traversed.
+		// c.Elem is validated when later traversed.
+		// c.Index is validated when later traversed.
+		sem.checkInsertElement("`insertelement` expression", c.X, c.Elem, c.Index)
+	case *constant.ExprShuffleVector:
+		// c.Typ is validated when later traversed.
+		// c.X is validated when later traversed.
+		// c.Y is validated when later traversed.
+		// c.Mask is validated when later traversed.
+		sem.checkShuffleVector("`shufflevector` expression", c.Typ, c.X, c.Y, c.Mask)
+
+	// Aggregate expressions.
+	case *constant.ExprExtractValue:
+		// The first operand of an `extractvalue` instruction is a value of struct
+		// or array type. The other operands are constant indices to specify which
+		// value to extract.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#extractvalue-instruction
+
+		// c.Typ is validated when later traversed.
+		// c.X is validated when later traversed.
+		if elem := sem.checkIndices("`extractvalue` expression", c.X.Type(), c.Indices); elem != nil && !elem.Equal(c.Typ) {
+			sem.Errorf("`extractvalue` expression type `%v` and element type `%v` mismatch", c.Typ, elem)
+		}
+	case *constant.ExprInsertValue:
+		// The first operand of an `insertvalue` instruction is a value of struct
+		// or array type. The second operand is a first-class value to insert. The
+		// following operands are constant indices indicating the position at
+		// which to insert the value.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#insertvalue-instruction
+
+		// c.X is validated when later traversed.
+		// c.Elem is validated when later traversed.
+		if elem := sem.checkIndices("`insertvalue` expression", c.X.Type(), c.Indices); elem != nil && !elem.Equal(c.Elem.Type()) {
+			sem.Errorf("`insertvalue` expression element type `%v` and indexed type `%v` mismatch", c.Elem.Type(), elem)
+		}
+
 	// Memory expressions.
 	case *constant.ExprGetElementPtr:
 		panic("not yet implemented")
@@ -680,13 +996,203 @@ func (sem *sem) checkInst(inst ir.Instruction) {
 		panic("not yet implemented")
 	case *ir.InstXor:
 		panic("not yet implemented")
+	// Vector instructions.
+	case *ir.InstExtractElement:
+		// inst.Typ is validated when later traversed.
+		// inst.X is validated when later traversed.
+		// inst.Index is validated when later traversed.
+		sem.checkExtractElement("`extractelement` instruction", inst.Typ, inst.X, inst.Index)
+	case *ir.InstInsertElement:
+		// inst.X is validated when later traversed.
+		// inst.Elem is validated when later traversed.
+		// inst.Index is validated when later traversed.
+		sem.checkInsertElement("`insertelement` instruction", inst.X, inst.Elem, inst.Index)
+	case *ir.InstShuffleVector:
+		// inst.Typ is validated when later traversed.
+		// inst.X is validated when later traversed.
+		// inst.Y is validated when later traversed.
+		// inst.Mask is validated when later traversed.
+		sem.checkShuffleVector("`shufflevector` instruction", inst.Typ, inst.X, inst.Y, inst.Mask)
+	// Aggregate instructions.
+	case *ir.InstExtractValue:
+		// The first operand of an `extractvalue` instruction is a value of struct
+		// or array type. The other operands are constant indices to specify which
+		// value to extract.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#extractvalue-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.X is validated when later traversed.
+		if elem := sem.checkIndices("`extractvalue` instruction", inst.X.Type(), inst.Indices); elem != nil && !elem.Equal(inst.Typ) {
+			sem.Errorf("`extractvalue` instruction type `%v` and element type `%v` mismatch", inst.Typ, elem)
+		}
+	case *ir.InstInsertValue:
+		// The first operand of an `insertvalue` instruction is a value of struct
+		// or array type. The second operand is a first-class value to insert. The
+		// following operands are constant indices indicating the position at
+		// which to insert the value.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#insertvalue-instruction
+
+		// inst.X is validated when later traversed.
+		// inst.Elem is validated when later traversed.
+		if elem := sem.checkIndices("`insertvalue` instruction", inst.X.Type(), inst.Indices); elem != nil && !elem.Equal(inst.Elem.Type()) {
+			sem.Errorf("`insertvalue` instruction element type `%v` and indexed type `%v` mismatch", inst.Elem.Type(), elem)
+		}
 	// Memory instructions.
 	case *ir.InstAlloca:
 		panic("not yet implemented")
 	case *ir.InstLoad:
-		panic("not yet implemented")
+		// The argument to the `load` instruction specifies the memory address
+		// from which to load. The type specified must be a first class type of
+		// known size. If the load is marked as atomic, it takes an extra ordering
+		// and optional syncscope argument. The release and acq_rel orderings are
+		// not valid on load instructions. Atomic loads produce defined results
+		// when they may see multiple atomic stores. The type of the pointee must
+		// be an integer, pointer, or floating-point type whose bit width is a
+		// power of two greater than or equal to eight and less than or equal to
+		// a target-specific size limit. align must be explicitly specified on
+		// atomic loads.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#load-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.Src is validated when later traversed.
+		if srcType, ok := inst.Src.Type().(*types.PointerType); !ok {
+			sem.Errorf("invalid `load` instruction source address type; expected pointer type, got %T", inst.Src.Type())
+		} else if !srcType.Elem.Equal(inst.Typ) {
+			sem.Errorf("`load` instruction type `%v` and source element type `%v` mismatch", inst.Typ, srcType.Elem)
+		}
+		switch inst.Ordering {
+		case ir.OrderingRelease, ir.OrderingAcqRel:
+			sem.Errorf("invalid `load` instruction atomic memory ordering; %v not allowed on loads", inst.Ordering)
+		}
+		sem.checkAtomicAccess("`load` instruction", inst.Typ, inst.Ordering, inst.SyncScope, inst.Align)
 	case *ir.InstStore:
-		panic("not yet implemented")
+		// There are two arguments to the `store` instruction: a value to store
+		// and an address at which to store it. The type of the pointer operand
+		// must be a pointer to the first class type of the value operand. If the
+		// store is marked as atomic, it takes an extra ordering and optional
+		// syncscope argument. The acquire and acq_rel orderings aren't valid on
+		// store instructions. The type of the pointee must be an integer,
+		// pointer, or floating-point type whose bit width is a power of two
+		// greater than or equal to eight and less than or equal to a
+		// target-specific size limit. align must be explicitly specified on
+		// atomic stores.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#store-instruction
+
+		// inst.Src is validated when later traversed.
+		// inst.Dst is validated when later traversed.
+		if dstType, ok := inst.Dst.Type().(*types.PointerType); !ok {
+			sem.Errorf("invalid `store` instruction destination address type; expected pointer type, got %T", inst.Dst.Type())
+		} else if !dstType.Elem.Equal(inst.Src.Type()) {
+			sem.Errorf("`store` instruction source type `%v` and destination element type `%v` mismatch", inst.Src.Type(), dstType.Elem)
+		}
+		switch inst.Ordering {
+		case ir.OrderingAcquire, ir.OrderingAcqRel:
+			sem.Errorf("invalid `store` instruction atomic memory ordering; %v not allowed on stores", inst.Ordering)
+		}
+		sem.checkAtomicAccess("`store` instruction", inst.Src.Type(), inst.Ordering, inst.SyncScope, inst.Align)
+	case *ir.InstFence:
+		// `fence` instructions take an ordering argument which defines what
+		// synchronizes-with edges they add. They can only be given acquire,
+		// release, acq_rel, and seq_cst orderings.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#fence-instruction
+		switch inst.Ordering {
+		case ir.OrderingAcquire, ir.OrderingRelease, ir.OrderingAcqRel, ir.OrderingSeqCst:
+			// valid fence ordering.
+		default:
+			sem.Errorf("invalid `fence` instruction atomic memory ordering; expected acquire, release, acq_rel or seq_cst, got %v", inst.Ordering)
+		}
+	case *ir.InstCmpXchg:
+		// There are three arguments to the `cmpxchg` instruction: an address to
+		// operate on, a value to compare to the value currently be at that
+		// address, and a new value to place at that address if the compared
+		// values are equal. The type of `<cmp>` must be an integer or pointer
+		// type whose bit width is a power of two greater than or equal to eight
+		// and less than or equal to a target-specific size limit. `<cmp>` and
+		// `<new>` must have the same type, and the type of `<pointer>` must be a
+		// pointer to that type.
+		//
+		// The ordering arguments specify how this `cmpxchg` synchronizes with
+		// other atomic operations. Both ordering parameters must be at least
+		// monotonic, the ordering constraint on failure must be no stronger
+		// than that on success, and the failure ordering cannot be either
+		// release or acq_rel.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#cmpxchg-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.Ptr is validated when later traversed.
+		// inst.Cmp is validated when later traversed.
+		// inst.New is validated when later traversed.
+		cmpType := inst.Cmp.Type()
+		if !types.IsInt(cmpType) && !types.IsPointer(cmpType) {
+			sem.Errorf("invalid `cmpxchg` instruction operand type; expected integer or pointer type, got %T", cmpType)
+		}
+		if !cmpType.Equal(inst.New.Type()) {
+			sem.Errorf("`cmpxchg` instruction compare type `%v` and new value type `%v` mismatch", cmpType, inst.New.Type())
+		}
+		if ptrType, ok := inst.Ptr.Type().(*types.PointerType); !ok {
+			sem.Errorf("invalid `cmpxchg` instruction address type; expected pointer type, got %T", inst.Ptr.Type())
+		} else if !ptrType.Elem.Equal(cmpType) {
+			sem.Errorf("`cmpxchg` instruction compare type `%v` and address element type `%v` mismatch", cmpType, ptrType.Elem)
+		}
+		if want := types.NewStruct(cmpType, types.I1); !inst.Typ.Equal(want) {
+			sem.Errorf("`cmpxchg` instruction type mismatch; expected `%v`, got `%v`", want, inst.Typ)
+		}
+		if orderingStrength(inst.Success) < orderingStrength(ir.OrderingMonotonic) {
+			sem.Errorf("invalid `cmpxchg` instruction success ordering; expected at least monotonic, got %v", inst.Success)
+		}
+		switch {
+		case orderingStrength(inst.Failure) < orderingStrength(ir.OrderingMonotonic):
+			sem.Errorf("invalid `cmpxchg` instruction failure ordering; expected at least monotonic, got %v", inst.Failure)
+		case inst.Failure == ir.OrderingRelease || inst.Failure == ir.OrderingAcqRel:
+			sem.Errorf("invalid `cmpxchg` instruction failure ordering; %v not allowed on failure", inst.Failure)
+		case orderingStrength(inst.Failure) > orderingStrength(inst.Success):
+			sem.Errorf("invalid `cmpxchg` instruction failure ordering; %v is stronger than success ordering %v", inst.Failure, inst.Success)
+		}
+	case *ir.InstAtomicRMW:
+		// There are three arguments to the `atomicrmw` instruction: an operation
+		// to apply, an address whose value to modify, an argument to the
+		// operation. The operation must be one of the supported keywords. For
+		// xchg, the type of the operand may be an integer, floating-point or
+		// pointer type; for all other operations, it must be an integer type.
+		// The type of the `<pointer>` operand must be a pointer to that type.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#atomicrmw-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.Ptr is validated when later traversed.
+		// inst.X is validated when later traversed.
+		xType := inst.X.Type()
+		if inst.Op == ir.AtomicXchg {
+			if !types.IsInt(xType) && !types.IsFloat(xType) && !types.IsPointer(xType) {
+				sem.Errorf("invalid `atomicrmw xchg` instruction operand type; expected integer, floating-point or pointer type, got %T", xType)
+			}
+		} else if !types.IsInt(xType) {
+			sem.Errorf("invalid `atomicrmw %v` instruction operand type; expected integer type, got %T", inst.Op, xType)
+		}
+		if ptrType, ok := inst.Ptr.Type().(*types.PointerType); !ok {
+			sem.Errorf("invalid `atomicrmw` instruction address type; expected pointer type, got %T", inst.Ptr.Type())
+		} else if !ptrType.Elem.Equal(xType) {
+			sem.Errorf("`atomicrmw` instruction operand type `%v` and address element type `%v` mismatch", xType, ptrType.Elem)
+		}
+		if !inst.Typ.Equal(xType) {
+			sem.Errorf("`atomicrmw` instruction type `%v` and operand type `%v` mismatch", inst.Typ, xType)
+		}
+		if orderingStrength(inst.Ordering) < orderingStrength(ir.OrderingMonotonic) {
+			sem.Errorf("invalid `atomicrmw` instruction atomic memory ordering; expected at least monotonic, got %v", inst.Ordering)
+		}
 	case *ir.InstGetElementPtr:
 		panic("not yet implemented")
 	// Conversion instructions.
@@ -722,11 +1228,173 @@ func (sem *sem) checkInst(inst ir.Instruction) {
 	case *ir.InstFCmp:
 		panic("not yet implemented")
 	case *ir.InstPhi:
-		panic("not yet implemented")
+		// The `phi` instruction takes a list of pairs as arguments, with one
+		// pair for each predecessor basic block of the current block. Only
+		// values of first class type may be used as the value arguments to the
+		// PHI node, and the type of each incoming value must match the type of
+		// the instruction.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#phi-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.Incs is validated when later traversed.
+		if len(inst.Incs) == 0 {
+			sem.Errorf("invalid `phi` instruction; expected at least one incoming value")
+		}
+		for _, inc := range inst.Incs {
+			if !inc.X.Type().Equal(inst.Typ) {
+				sem.Errorf("`phi` instruction incoming value type `%v` and instruction type `%v` mismatch", inc.X.Type(), inst.Typ)
+			}
+			if inc.Pred == nil {
+				sem.Errorf("predecessor basic block of `phi` instruction incoming value missing")
+			}
+		}
 	case *ir.InstSelect:
 		panic("not yet implemented")
 	case *ir.InstCall:
-		panic("not yet implemented")
+		// The `call` instruction represents a simple function call. The
+		// function arguments must match the parameters of the callee signature,
+		// and only variadic functions may be called with additional arguments.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#call-instruction
+
+		// inst.Callee is validated when later traversed.
+		// inst.Sig is validated when later traversed.
+		// inst.Args is validated when later traversed.
+		sig := inst.Sig
+		switch {
+		case len(inst.Args) < len(sig.Params):
+			sem.Errorf("too few arguments in `call` instruction; expected %d, got %d", len(sig.Params), len(inst.Args))
+		case len(inst.Args) > len(sig.Params) && !sig.Variadic:
+			sem.Errorf("too many arguments in `call` instruction; expected %d, got %d", len(sig.Params), len(inst.Args))
+		}
+		for i, param := range sig.Params {
+			if i >= len(inst.Args) {
+				break
+			}
+			if arg := inst.Args[i]; !arg.Type().Equal(param.Type()) {
+				sem.Errorf("`call` instruction argument type `%v` and parameter type `%v` mismatch", arg.Type(), param.Type())
+			}
+		}
+	case *ir.InstLandingPad:
+		// The `landingpad` instruction is used by LLVM's exception handling
+		// system to specify that a basic block is a landing pad; one where the
+		// exception lands. The landing pad must be the first non-phi instruction
+		// of a basic block which is only reached through the unwind edges of
+		// `invoke` instructions, and its parent function must have a personality
+		// function. A `landingpad` instruction must have at least one clause or
+		// the `cleanup` flag. A `catch` clause specifies the type info of an
+		// exception to catch, and a `filter` clause an array of type infos.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#landingpad-instruction
+
+		// inst.Typ is validated when later traversed.
+		// inst.Clauses is validated when later traversed.
+		if !inst.Cleanup && len(inst.Clauses) == 0 {
+			sem.Errorf("invalid `landingpad` instruction; expected at least one clause or cleanup")
+		}
+		for _, clause := range inst.Clauses {
+			t := clause.X.Type()
+			switch clause.Kind {
+			case ir.ClauseCatch:
+				if !types.IsPointer(t) {
+					sem.Errorf("invalid `landingpad` instruction catch clause type; expected pointer type, got %T", t)
+				}
+			case ir.ClauseFilter:
+				if !types.IsArray(t) {
+					sem.Errorf("invalid `landingpad` instruction filter clause type; expected array type, got %T", t)
+				}
+			default:
+				sem.Errorf("invalid `landingpad` instruction clause kind %v", clause.Kind)
+			}
+		}
+		block := inst.Parent
+		if block == nil || block.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		if block.Parent.Personality == nil {
+			sem.Errorf("`landingpad` instruction in function %s without personality function", block.Parent.Ident())
+		}
+		if firstNonPhi(block) != ir.Instruction(inst) {
+			sem.Errorf("`landingpad` instruction must be the first non-phi instruction of basic block %s", block.Ident())
+		}
+		unwind := false
+		for _, pred := range block.Parent.Blocks {
+			if pred.Term == nil {
+				// terminator is validated when traversed.
+				continue
+			}
+			for _, succ := range pred.Term.Succs() {
+				if succ != block {
+					continue
+				}
+				if term, ok := pred.Term.(*ir.TermInvoke); ok && term.TargetUnwind == block {
+					unwind = true
+					continue
+				}
+				sem.Errorf("basic block %s containing `landingpad` instruction reached by non-unwind edge from basic block %s", block.Ident(), pred.Ident())
+			}
+		}
+		if !unwind {
+			sem.Errorf("basic block %s containing `landingpad` instruction is not the unwind destination of any `invoke` terminator", block.Ident())
+		}
+	case *ir.InstCatchPad:
+		// The `catchpad` instruction is used by LLVM's funclet-based exception
+		// handling system to specify that a basic block begins a catch handler.
+		// The catch pad must be the first non-phi instruction of a basic block
+		// which is a handler of its parent `catchswitch` terminator, and its
+		// parent function must have a personality function.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#catchpad-instruction
+
+		// inst.Args is validated when later traversed.
+		if inst.CatchSwitch == nil {
+			sem.Errorf("parent `catchswitch` terminator of `catchpad` instruction missing")
+		}
+		block := inst.Parent
+		if block == nil || block.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		if block.Parent.Personality == nil {
+			sem.Errorf("`catchpad` instruction in function %s without personality function", block.Parent.Ident())
+		}
+		if firstNonPhi(block) != ir.Instruction(inst) {
+			sem.Errorf("`catchpad` instruction must be the first non-phi instruction of basic block %s", block.Ident())
+		}
+		if inst.CatchSwitch != nil && !containsBlock(inst.CatchSwitch.Handlers, block) {
+			sem.Errorf("basic block %s containing `catchpad` instruction is not a handler of its parent `catchswitch` terminator", block.Ident())
+		}
+	case *ir.InstCleanupPad:
+		// The `cleanuppad` instruction is used by LLVM's funclet-based exception
+		// handling system to specify that a basic block is a cleanup block. The
+		// parent pad is either the none token or another funclet pad, the
+		// cleanup pad must be the first non-phi instruction of its basic block,
+		// and its parent function must have a personality function.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#cleanuppad-instruction
+
+		// inst.Args is validated when later traversed.
+		if !isValidParentPad(inst.ParentPad) {
+			sem.Errorf("invalid parent pad of `cleanuppad` instruction; expected none token, `catchpad` or `cleanuppad` instruction, got %T", inst.ParentPad)
+		}
+		block := inst.Parent
+		if block == nil || block.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		if block.Parent.Personality == nil {
+			sem.Errorf("`cleanuppad` instruction in function %s without personality function", block.Parent.Ident())
+		}
+		if firstNonPhi(block) != ir.Instruction(inst) {
+			sem.Errorf("`cleanuppad` instruction must be the first non-phi instruction of basic block %s", block.Ident())
+		}
 	default:
 		panic(fmt.Errorf("support for instruction %T not yet implemented", inst))
 	}
@@ -738,20 +1406,359 @@ func (sem *sem) checkInst(inst ir.Instruction) {
 func (sem *sem) checkTerm(term ir.Terminator) {
 	switch term := term.(type) {
 	case *ir.TermRet:
-		panic("not yet implemented")
+		// The `ret` instruction optionally accepts a single argument, the return
+		// value. The type of the return value must be a first class type. A
+		// function is not well formed if it has a non-void return type and
+		// contains a `ret` instruction with no return value or a return value
+		// with a type that does not match its type, or if it has a void return
+		// type and contains a `ret` instruction with a return value.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#ret-instruction
+
+		// term.X is validated when later traversed.
+		if term.Parent == nil || term.Parent.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		ret := term.Parent.Parent.Sig.Ret
+		switch {
+		case term.X == nil && !types.IsVoid(ret):
+			sem.Errorf("`ret` terminator return value missing; expected value of type `%v`", ret)
+		case term.X != nil && !term.X.Type().Equal(ret):
+			sem.Errorf("`ret` terminator return value type `%v` and function return type `%v` mismatch", term.X.Type(), ret)
+		}
 	case *ir.TermBr:
-		panic("not yet implemented")
+		// The unconditional form of the `br` instruction takes a single label
+		// value as a target.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#br-instruction
+		if term.Target == nil {
+			sem.Errorf("target branch of `br` terminator missing")
+		}
 	case *ir.TermCondBr:
-		panic("not yet implemented")
+		// The conditional branch form of the `br` instruction takes a single i1
+		// value and two label values.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#br-instruction
+
+		// term.Cond is validated when later traversed.
+		if !types.IsBool(term.Cond.Type()) {
+			sem.Errorf("invalid `br` terminator condition type; expected i1, got `%v`", term.Cond.Type())
+		}
+		if term.TargetTrue == nil {
+			sem.Errorf("true target branch of `br` terminator missing")
+		}
+		if term.TargetFalse == nil {
+			sem.Errorf("false target branch of `br` terminator missing")
+		}
 	case *ir.TermSwitch:
 		panic("not yet implemented")
+	case *ir.TermIndirectBr:
+		// The `indirectbr` instruction implements an indirect branch to a label
+		// within the current function, whose address is specified by the
+		// address operand. The address must be derived from a `blockaddress`
+		// constant.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#indirectbr-instruction
+
+		// term.Addr is validated when later traversed.
+		// term.ValidTargets is validated when later traversed.
+		if !types.IsPointer(term.Addr.Type()) {
+			sem.Errorf("invalid `indirectbr` terminator address type; expected pointer type, got %T", term.Addr.Type())
+		}
+	case *ir.TermInvoke:
+		// The `invoke` instruction causes control to transfer to a specified
+		// function, with the possibility of control flow transfer to either the
+		// `normal` label or the `exception` label. The function arguments must
+		// match the parameters of the callee signature, and the unwind
+		// destination must begin with an exception handling pad.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#invoke-instruction
+
+		// term.Callee is validated when later traversed.
+		// term.Sig is validated when later traversed.
+		// term.Args is validated when later traversed.
+		sig := term.Sig
+		switch {
+		case len(term.Args) < len(sig.Params):
+			sem.Errorf("too few arguments in `invoke` terminator; expected %d, got %d", len(sig.Params), len(term.Args))
+		case len(term.Args) > len(sig.Params) && !sig.Variadic:
+			sem.Errorf("too many arguments in `invoke` terminator; expected %d, got %d", len(sig.Params), len(term.Args))
+		}
+		for i, param := range sig.Params {
+			if i >= len(term.Args) {
+				break
+			}
+			if arg := term.Args[i]; !arg.Type().Equal(param.Type()) {
+				sem.Errorf("`invoke` terminator argument type `%v` and parameter type `%v` mismatch", arg.Type(), param.Type())
+			}
+		}
+		if term.TargetNormal == nil {
+			sem.Errorf("normal target branch of `invoke` terminator missing")
+		}
+		if term.TargetUnwind == nil {
+			sem.Errorf("unwind target branch of `invoke` terminator missing")
+		} else if _, ok := firstNonPhi(term.TargetUnwind).(*ir.InstLandingPad); !ok && !isFuncletUnwindDest(term.TargetUnwind) {
+			sem.Errorf("unwind destination %s of `invoke` terminator does not begin with an exception handling pad", term.TargetUnwind.Ident())
+		}
+	case *ir.TermResume:
+		// The `resume` instruction resumes propagation of an existing
+		// (in-flight) exception whose unwinding was interrupted with a
+		// `landingpad` instruction. The value must have the same type as the
+		// result of any `landingpad` instruction in the same function.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#resume-instruction
+
+		// term.X is validated when later traversed.
+		if term.Parent == nil || term.Parent.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		f := term.Parent.Parent
+		if f.Personality == nil {
+			sem.Errorf("`resume` terminator in function %s without personality function", f.Ident())
+		}
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				if inst, ok := inst.(*ir.InstLandingPad); ok && !term.X.Type().Equal(inst.Typ) {
+					sem.Errorf("`resume` terminator value type `%v` and `landingpad` instruction type `%v` mismatch", term.X.Type(), inst.Typ)
+				}
+			}
+		}
+	case *ir.TermCatchSwitch:
+		// The `catchswitch` instruction is used by LLVM's funclet-based
+		// exception handling system to describe the set of possible catch
+		// handlers that may be executed. Each handler must begin with a
+		// `catchpad` instruction whose parent is the `catchswitch`, and the
+		// unwind destination must begin with a `catchswitch` or `cleanuppad`.
+		// The `catchswitch` must be the only non-phi instruction of its basic
+		// block, and its parent function must have a personality function.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#catchswitch-instruction
+		if !isValidParentPad(term.ParentPad) {
+			sem.Errorf("invalid parent pad of `catchswitch` terminator; expected none token, `catchpad` or `cleanuppad` instruction, got %T", term.ParentPad)
+		}
+		if len(term.Handlers) == 0 {
+			sem.Errorf("invalid `catchswitch` terminator; expected at least one handler")
+		}
+		for _, handler := range term.Handlers {
+			if inst, ok := firstNonPhi(handler).(*ir.InstCatchPad); !ok || inst.CatchSwitch != term {
+				sem.Errorf("handler %s of `catchswitch` terminator does not begin with a `catchpad` instruction within the `catchswitch`", handler.Ident())
+			}
+		}
+		if term.UnwindTarget != nil && !isFuncletUnwindDest(term.UnwindTarget) {
+			sem.Errorf("unwind destination %s of `catchswitch` terminator does not begin with a `catchswitch` or `cleanuppad` instruction", term.UnwindTarget.Ident())
+		}
+		block := term.Parent
+		if block == nil || block.Parent == nil {
+			// parent basic block and function are validated when traversed.
+			return
+		}
+		if block.Parent.Personality == nil {
+			sem.Errorf("`catchswitch` terminator in function %s without personality function", block.Parent.Ident())
+		}
+		if firstNonPhi(block) != ir.Instruction(term) {
+			sem.Errorf("`catchswitch` terminator must be the only non-phi instruction of basic block %s", block.Ident())
+		}
+	case *ir.TermCatchRet:
+		// The `catchret` instruction ends an existing (in-flight) exception
+		// whose unwinding was interrupted with a `catchpad` instruction, and
+		// transfers control to the target basic block.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#catchret-instruction
+		if term.CatchPad == nil {
+			sem.Errorf("exited `catchpad` instruction of `catchret` terminator missing")
+		}
+		if term.Target == nil {
+			sem.Errorf("target branch of `catchret` terminator missing")
+		}
+	case *ir.TermCleanupRet:
+		// The `cleanupret` instruction indicates to the personality function
+		// that the `cleanuppad` it transferred control to has ended. The unwind
+		// destination, if any, must begin with a `catchswitch` or `cleanuppad`.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#cleanupret-instruction
+		if term.CleanupPad == nil {
+			sem.Errorf("exited `cleanuppad` instruction of `cleanupret` terminator missing")
+		}
+		if term.UnwindTarget != nil && !isFuncletUnwindDest(term.UnwindTarget) {
+			sem.Errorf("unwind destination %s of `cleanupret` terminator does not begin with a `catchswitch` or `cleanuppad` instruction", term.UnwindTarget.Ident())
+		}
 	case *ir.TermUnreachable:
-		panic("not yet implemented")
+		// The `unreachable` instruction has no defined semantics.
+		//
+		// References:
+		//    http://llvm.org/docs/LangRef.html#unreachable-instruction
 	default:
 		panic(fmt.Errorf("support for instruction %T not yet implemented", term))
 	}
 }
 
+// checkExtractElement validates the semantics of the given extractelement
+// instruction or expression.
+func (sem *sem) checkExtractElement(kind string, typ types.Type, x, index value.Value) {
+	// The first operand of an `extractelement` instruction is a value of vector
+	// type. The second operand is an index indicating the position from which
+	// to extract the element. The index may be a variable of any integer type.
+	//
+	// References:
+	//    http://llvm.org/docs/LangRef.html#extractelement-instruction
+	xType, ok := x.Type().(*types.VectorType)
+	if !ok {
+		sem.Errorf("invalid %s x type; expected vector type, got %T", kind, x.Type())
+	} else if !xType.Elem.Equal(typ) {
+		sem.Errorf("%s type `%v` and vector element type `%v` mismatch", kind, typ, xType.Elem)
+	}
+	if !types.IsInt(index.Type()) {
+		sem.Errorf("invalid %s index type; expected integer type, got %T", kind, index.Type())
+	}
+}
+
+// checkInsertElement validates the semantics of the given insertelement
+// instruction or expression.
+func (sem *sem) checkInsertElement(kind string, x, elem, index value.Value) {
+	// The first operand of an `insertelement` instruction is a value of vector
+	// type. The second operand is a scalar value whose type must equal the
+	// element type of the first operand. The third operand is an index
+	// indicating the position at which to insert the value. The index may be a
+	// variable of any integer type.
+	//
+	// References:
+	//    http://llvm.org/docs/LangRef.html#insertelement-instruction
+	xType, ok := x.Type().(*types.VectorType)
+	if !ok {
+		sem.Errorf("invalid %s x type; expected vector type, got %T", kind, x.Type())
+	} else if !xType.Elem.Equal(elem.Type()) {
+		sem.Errorf("%s element type `%v` and vector element type `%v` mismatch", kind, elem.Type(), xType.Elem)
+	}
+	if !types.IsInt(index.Type()) {
+		sem.Errorf("invalid %s index type; expected integer type, got %T", kind, index.Type())
+	}
+}
+
+// checkShuffleVector validates the semantics of the given shufflevector
+// instruction or expression.
+func (sem *sem) checkShuffleVector(kind string, typ *types.VectorType, x, y, mask value.Value) {
+	// The first two operands of a `shufflevector` instruction are vectors with
+	// the same type. The third argument is a shuffle mask whose element type is
+	// always `i32`. The result of the instruction is a vector whose length is
+	// the same as the shuffle mask and whose element type is the same as the
+	// element type of the first two operands.
+	//
+	// The shuffle mask operand is required to be a constant vector with either
+	// constant integer or undef values.
+	//
+	// References:
+	//    http://llvm.org/docs/LangRef.html#shufflevector-instruction
+	xType, ok := x.Type().(*types.VectorType)
+	if !ok {
+		sem.Errorf("invalid %s x type; expected vector type, got %T", kind, x.Type())
+		return
+	}
+	if !xType.Equal(y.Type()) {
+		sem.Errorf("%s x type `%v` and y type `%v` mismatch", kind, xType, y.Type())
+	}
+	maskType, ok := mask.Type().(*types.VectorType)
+	if !ok || !maskType.Elem.Equal(types.I32) {
+		sem.Errorf("invalid %s shuffle mask type; expected vector of i32 type, got `%v`", kind, mask.Type())
+		return
+	}
+	if typ.Len != maskType.Len {
+		sem.Errorf("%s result length %d and shuffle mask length %d mismatch", kind, typ.Len, maskType.Len)
+	}
+	if typ.Scalable != maskType.Scalable || xType.Scalable != maskType.Scalable {
+		sem.Errorf("%s vector type `%v`, shuffle mask type `%v` and result type `%v` must all be scalable or fixed-length", kind, xType, maskType, typ)
+	}
+	if !typ.Elem.Equal(xType.Elem) {
+		sem.Errorf("%s result element type `%v` and vector element type `%v` mismatch", kind, typ.Elem, xType.Elem)
+	}
+	switch m := mask.(type) {
+	case *constant.Vector:
+		// Indices are numbered from left to right across both input vectors.
+		n := 2 * xType.Len
+		for _, elem := range m.Elems {
+			if i, ok := elem.(*constant.Int); ok {
+				if idx := i.Int64(); idx < 0 || idx >= n {
+					sem.Errorf("invalid %s shuffle mask index %d; out of bounds for %d input elements", kind, idx, n)
+				}
+			}
+		}
+	case constant.Constant:
+		// valid constant shuffle mask.
+	default:
+		sem.Errorf("invalid %s shuffle mask; expected constant, got %T", kind, mask)
+	}
+}
+
+// checkAtomicAccess validates the atomic memory ordering, synchronization
+// scope and alignment of the given load or store instruction, which accesses
+// memory of type t.
+func (sem *sem) checkAtomicAccess(kind string, t types.Type, ordering ir.AtomicOrdering, syncScope string, align int) {
+	if align != 0 && align&(align-1) != 0 {
+		sem.Errorf("invalid %s alignment %d; expected power of two", kind, align)
+	}
+	if ordering == ir.OrderingNone {
+		if len(syncScope) > 0 {
+			sem.Errorf("invalid %s synchronization scope %q; only allowed on atomic operations", kind, syncScope)
+		}
+		return
+	}
+	if !types.IsInt(t) && !types.IsFloat(t) && !types.IsPointer(t) {
+		sem.Errorf("invalid atomic %s type; expected integer, floating-point or pointer type, got %T", kind, t)
+	}
+	if align == 0 {
+		sem.Errorf("invalid atomic %s; alignment must be explicitly specified", kind)
+	}
+}
+
+// checkIndices validates the aggregate index path of the given extractvalue or
+// insertvalue instruction or expression into the aggregate type t, and returns
+// the type of the indexed element. A nil type is returned if the index path is
+// invalid.
+func (sem *sem) checkIndices(kind string, t types.Type, indices []int64) types.Type {
+	switch t.(type) {
+	case *types.ArrayType, *types.StructType:
+		// valid aggregate type.
+	default:
+		sem.Errorf("invalid %s aggregate type; expected struct or array type, got %T", kind, t)
+		return nil
+	}
+	if len(indices) == 0 {
+		sem.Errorf("invalid %s; expected at least one index", kind)
+		return nil
+	}
+	e := t
+	for _, index := range indices {
+		switch t := e.(type) {
+		case *types.ArrayType:
+			if index < 0 || index >= t.Len {
+				sem.Errorf("invalid %s index %d; out of bounds for type `%v`", kind, index, t)
+				return nil
+			}
+			e = t.Elem
+		case *types.StructType:
+			if index < 0 || index >= int64(len(t.Fields)) {
+				sem.Errorf("invalid %s index %d; out of bounds for type `%v`", kind, index, t)
+				return nil
+			}
+			e = t.Fields[index]
+		default:
+			sem.Errorf("invalid %s index %d; unable to index into non-aggregate type `%v`", kind, index, t)
+			return nil
+		}
+	}
+	return e
+}
+
 // ### [ Helper functions ] ####################################################
 
 const (
@@ -760,6 +1767,105 @@ const (
 	decimalDigit = "0123456789"
 )
 
+// firstNonPhi returns the first non-phi instruction of the given basic block;
+// or its terminator if the basic block only contains phi instructions.
+func firstNonPhi(block *ir.BasicBlock) ir.Instruction {
+	for _, inst := range block.Insts {
+		if _, ok := inst.(*ir.InstPhi); !ok {
+			return inst
+		}
+	}
+	return block.Term
+}
+
+// isFuncletUnwindDest reports whether the given basic block is a valid unwind
+// destination of a funclet-based exception handling pad; i.e. whether it
+// begins with a `catchswitch` or `cleanuppad` instruction.
+func isFuncletUnwindDest(block *ir.BasicBlock) bool {
+	switch firstNonPhi(block).(type) {
+	case *ir.TermCatchSwitch, *ir.InstCleanupPad:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidParentPad reports whether the given value is a valid parent pad of a
+// `catchswitch` or `cleanuppad` instruction; i.e. either the none token or a
+// funclet pad.
+func isValidParentPad(v value.Value) bool {
+	switch v.(type) {
+	case *constant.NoneToken, *ir.InstCatchPad, *ir.InstCleanupPad:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIntrinsic reports whether the given function is an intrinsic function;
+// i.e. whether its name starts with "llvm.".
+func isIntrinsic(f *ir.Function) bool {
+	return strings.HasPrefix(f.Name, "llvm.")
+}
+
+// containsBlock reports whether the given basic blocks contain block.
+func containsBlock(blocks []*ir.BasicBlock, block *ir.BasicBlock) bool {
+	for _, b := range blocks {
+		if b == block {
+			return true
+		}
+	}
+	return false
+}
+
+// orderingStrength returns the relative strength of the given atomic memory
+// ordering constraint. The acquire and release orderings are of equal
+// strength, as neither is stronger than the other.
+func orderingStrength(ordering ir.AtomicOrdering) int {
+	switch ordering {
+	case ir.OrderingNone:
+		return 0
+	case ir.OrderingUnordered:
+		return 1
+	case ir.OrderingMonotonic:
+		return 2
+	case ir.OrderingAcquire, ir.OrderingRelease:
+		return 3
+	case ir.OrderingAcqRel:
+		return 4
+	case ir.OrderingSeqCst:
+		return 5
+	}
+	panic(fmt.Errorf("support for atomic memory ordering %v not yet implemented", ordering))
+}
+
+// aliaseeBase returns the global value referred to by the given aliasee,
+// looking through pointer casts and getelementptr expressions.
+func aliaseeBase(aliasee constant.Constant) constant.Constant {
+	for {
+		switch c := aliasee.(type) {
+		case *constant.ExprBitCast:
+			aliasee = c.From
+		case *constant.ExprAddrSpaceCast:
+			aliasee = c.From
+		case *constant.ExprGetElementPtr:
+			aliasee = c.Src
+		default:
+			return aliasee
+		}
+	}
+}
+
+// isValidAliasLinkage reports whether the given linkage type is valid for
+// aliases and IFuncs.
+func isValidAliasLinkage(linkage ir.Linkage) bool {
+	switch linkage {
+	case ir.LinkageExternal, ir.LinkagePrivate, ir.LinkageInternal, ir.LinkageLinkOnce, ir.LinkageWeak, ir.LinkageLinkOnceODR, ir.LinkageWeakODR:
+		return true
+	}
+	return false
+}
+
 // isValidIdent reports whether the given identifier is valid.
 func isValidIdent(ident string) bool {
 	// TODO: Add support for quoted string identifiers.
@@ -839,10 +1945,14 @@ func isFirstClassType(t types.Type) bool {
 		return true
 	case *types.VectorType:
 		return true
+	case *types.MMXType:
+		return true
 	case *types.LabelType:
 		return true
 	case *types.MetadataType:
 		return true
+	case *types.TokenType:
+		return true
 	case *types.ArrayType:
 		return true
 	case *types.StructType:
@@ -867,10 +1977,14 @@ func isSingleValueType(t types.Type) bool {
 		return true
 	case *types.VectorType:
 		return true
+	case *types.MMXType:
+		return true
 	case *types.LabelType:
 		return false
 	case *types.MetadataType:
 		return false
+	case *types.TokenType:
+		return false
 	case *types.ArrayType:
 		return false
 	case *types.StructType:
@@ -895,10 +2009,14 @@ func isAggregateType(t types.Type) bool {
 		return true
 	case *types.VectorType:
 		return true
+	case *types.MMXType:
+		return true
 	case *types.LabelType:
 		return false
 	case *types.MetadataType:
 		return false
+	case *types.TokenType:
+		return false
 	case *types.ArrayType:
 		return true
 	case *types.StructType:
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/llir/llvm/asm"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/sem"
 )
 
@@ -18,6 +20,44 @@ func TestCheck(t *testing.T) {
 			errs: []string{
 				"invalid global variable content type; expected single value or aggregate type, got *types.LabelType",
 				"invalid global variable content type; expected single value or aggregate type, got *types.MetadataType",
+				"invalid global variable content type; scalable vector type `<vscale x 4 x i32>` not allowed",
+			},
+		},
+		{
+			path: "testdata/linkage.ll",
+			errs: []string{
+				"invalid visibility style of @a; global values with private linkage must have default visibility, got hidden",
+				"invalid DLL storage class of @b; global values with internal linkage must not have DLL storage class, got dllexport",
+				"invalid content type of global variable @c with appending linkage; expected array type, got `i32`",
+				"invalid linkage type of declaration @f; declarations must have external or extern_weak linkage, got internal",
+				"invalid linkage type of declaration @g; declarations must have external or extern_weak linkage, got private",
+				"invalid visibility style of @g; global values with private linkage must have default visibility, got hidden",
+				"invalid linkage type of definition @i; definitions must not have extern_weak linkage",
+				"invalid linkage type of function @j; common linkage is only valid for global variables",
+			},
+		},
+
+		{
+			path: "testdata/comdat.ll",
+			errs: []string{
+				"invalid alignment 3 of @a; expected power of two",
+				"invalid comdat of declaration @b; declarations must not be in a comdat",
+				"invalid alignment 6 of @g; expected power of two",
+				"invalid comdat of declaration @h; declarations must not be in a comdat",
+			},
+		},
+
+		// Aliases and IFuncs.
+		{
+			path: "testdata/alias.ll",
+			errs: []string{
+				"alias @b content type `i64` and aliasee type `i32*` mismatch; expected `i64*`",
+				"invalid aliasee of alias @c; expected definition, got declaration @y",
+				"alias cycle detected; @e -> @d -> @e",
+				"alias cycle detected; @d -> @e -> @d",
+				"invalid linkage type of alias @g; expected private, internal, linkonce, weak, linkonce_odr, weak_odr or external linkage, got common",
+				"invalid IFunc content type; expected function type, got *types.IntType",
+				"invalid resolver function type of IFunc @i; expected `i32* ()*`, got `void ()* ()*`",
 			},
 		},
 
@@ -28,6 +68,10 @@ func TestCheck(t *testing.T) {
 				"invalid function return type; expected void, single value or aggregate type, got *types.FuncType",
 				"invalid function return type; expected void, single value or aggregate type, got *types.LabelType",
 				"invalid function return type; expected void, single value or aggregate type, got *types.MetadataType",
+				"invalid function parameter; label type not allowed",
+				"invalid parameter type of function @s; metadata type only allowed for intrinsic functions",
+				"invalid return type of function @x; token type only allowed for intrinsic functions",
+				"invalid parameter type of function @y; token type only allowed for intrinsic functions",
 			},
 		},
 		{
@@ -54,6 +98,7 @@ func TestCheck(t *testing.T) {
 				"invalid vector element type; expected integer, floating-point or pointer type, got *types.ArrayType",
 				"invalid vector element type; expected integer, floating-point or pointer type, got *types.StructType",
 				"invalid vector element type; expected integer, floating-point or pointer type, got *types.StructType",
+				"invalid vector element type; expected integer, floating-point or pointer type, got *types.LabelType",
 			},
 		},
 		{
@@ -61,6 +106,8 @@ func TestCheck(t *testing.T) {
 			errs: []string{
 				"invalid array element type; expected single value or aggregate type, got *types.LabelType",
 				"invalid array element type; expected single value or aggregate type, got *types.MetadataType",
+				"invalid array element type; x86_mmx type not allowed",
+				"invalid array element type; scalable vector type `<vscale x 4 x i32>` not allowed",
 			},
 		},
 		{
@@ -68,6 +115,7 @@ func TestCheck(t *testing.T) {
 			errs: []string{
 				"invalid struct field type; expected single value or aggregate type, got *types.LabelType",
 				"invalid struct field type; expected single value or aggregate type, got *types.MetadataType",
+				"invalid struct field type; scalable vector type `<vscale x 4 x i32>` not allowed",
 			},
 		},
 
@@ -75,6 +123,7 @@ func TestCheck(t *testing.T) {
 		{
 			path: "testdata/const_vector.ll",
 			errs: []string{
+				"number of vector elements mismatch for type `<3 x i32>`; expected 3, got 2",
 				"vector element type `i32` and element type `i8` mismatch",
 			},
 		},
@@ -88,6 +137,92 @@ func TestCheck(t *testing.T) {
 			path: "testdata/const_struct.ll",
 			errs: nil,
 		},
+		{
+			path: "testdata/const_undef.ll",
+			errs: []string{
+				"invalid undef constant type; expected single value or aggregate type, got *types.TokenType",
+				"invalid poison constant type; expected single value or aggregate type, got *types.TokenType",
+			},
+		},
+
+		// Constant expressions.
+		{
+			path: "testdata/expr_vector.ll",
+			errs: []string{
+				"invalid `extractelement` expression index type; expected integer type, got *types.FloatType",
+				"invalid `shufflevector` expression shuffle mask index 4; out of bounds for 4 input elements",
+				"`insertelement` expression element type `i8` and vector element type `i32` mismatch",
+			},
+		},
+		{
+			path: "testdata/expr_aggregate.ll",
+			errs: []string{
+				"invalid `extractvalue` expression index 2; out of bounds for type `{ i32, i8 }`",
+				"`insertvalue` expression element type `i32` and indexed type `i8` mismatch",
+			},
+		},
+
+		// Instructions.
+		{
+			path: "testdata/inst_atomic.ll",
+			errs: []string{
+				"invalid `load` instruction atomic memory ordering; release not allowed on loads",
+				"invalid atomic `load` instruction; alignment must be explicitly specified",
+				"invalid `store` instruction atomic memory ordering; acquire not allowed on stores",
+				"invalid `store` instruction alignment 3; expected power of two",
+				"invalid `fence` instruction atomic memory ordering; expected acquire, release, acq_rel or seq_cst, got monotonic",
+				"invalid `cmpxchg` instruction failure ordering; seq_cst is stronger than success ordering acquire",
+				"invalid `cmpxchg` instruction failure ordering; release not allowed on failure",
+				"invalid `atomicrmw` instruction atomic memory ordering; expected at least monotonic, got unordered",
+				"invalid `atomicrmw add` instruction operand type; expected integer type, got *types.FloatType",
+			},
+		},
+		{
+			path: "testdata/inst_eh.ll",
+			errs: []string{
+				"invalid `landingpad` instruction; expected at least one clause or cleanup",
+				"`landingpad` instruction must be the first non-phi instruction of basic block %lpad2",
+				"too few arguments in `invoke` terminator; expected 1, got 0",
+				"unwind destination %exit of `invoke` terminator does not begin with an exception handling pad",
+				"basic block %lpad3 containing `landingpad` instruction is not the unwind destination of any `invoke` terminator",
+				"invalid `landingpad` instruction filter clause type; expected array type, got *types.PointerType",
+				"`landingpad` instruction in function @h without personality function",
+				"`resume` terminator in function @h without personality function",
+			},
+		},
+		{
+			path: "testdata/inst_funclet.ll",
+			errs: []string{
+				"invalid parent pad of `cleanuppad` instruction; expected none token, `catchpad` or `cleanuppad` instruction, got *ir.TermCatchSwitch",
+				"unwind destination %exit of `cleanupret` terminator does not begin with a `catchswitch` or `cleanuppad` instruction",
+				"handler %bad of `catchswitch` terminator does not begin with a `catchpad` instruction within the `catchswitch`",
+				"unwind destination %handler of `catchswitch` terminator does not begin with a `catchswitch` or `cleanuppad` instruction",
+				"basic block %stray containing `catchpad` instruction is not a handler of its parent `catchswitch` terminator",
+				"`cleanuppad` instruction in function @h without personality function",
+				"`catchpad` instruction in function @h without personality function",
+				"`cleanuppad` instruction in function @h without personality function",
+				"`cleanuppad` instruction must be the first non-phi instruction of basic block %handler",
+				"`catchswitch` terminator in function @h without personality function",
+				"`catchswitch` terminator must be the only non-phi instruction of basic block %entry",
+			},
+		},
+
+		{
+			path: "testdata/inst_other.ll",
+			errs: []string{
+				"`call` instruction argument type `i32` and parameter type `i8` mismatch",
+				"too few arguments in `call` instruction; expected 2, got 1",
+				"`phi` instruction incoming value type `float` and instruction type `i32` mismatch",
+			},
+		},
+
+		// Terminators.
+		{
+			path: "testdata/term_indirectbr.ll",
+			errs: []string{
+				"invalid `indirectbr` terminator address type; expected pointer type, got *types.IntType",
+			},
+		},
 	}
 	for _, g := range golden {
 		m, err := asm.ParseFile(g.path)
@@ -125,3 +260,28 @@ func TestCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckVectorElemType(t *testing.T) {
+	// Vector types of void and function element types are rejected by the
+	// parser, so they are constructed directly.
+	golden := []struct {
+		elem types.Type
+		err  string
+	}{
+		{elem: types.Void, err: "invalid vector element type; expected integer, floating-point or pointer type, got *types.VoidType"},
+		{elem: types.NewFunc(types.I32), err: "invalid vector element type; expected integer, floating-point or pointer type, got *types.FuncType"},
+	}
+	for _, g := range golden {
+		m := &ir.Module{}
+		m.Globals = append(m.Globals, ir.NewGlobalDecl("a", types.NewVector(g.elem, 5)))
+		err := sem.Check(m)
+		if err == nil {
+			t.Errorf("%q: expected semantic error, got nil", g.err)
+			continue
+		}
+		errs := err.(sem.ErrorList)
+		if len(errs) != 1 || errs[0].Error() != g.err {
+			t.Errorf("error mismatch; expected `%v`, got `%v`", g.err, err)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+; Aliases and IFuncs.
+@x = global i32 0                                                       ; valid
+@y = external global i32                                                ; valid
+
+@a = alias i32, i32* @x                                                 ; valid
+@b = alias i64, i32* @x                                                 ; error: alias @b content type `i64` and aliasee type `i32*` mismatch; expected `i64*`
+@c = alias i32, i32* @y                                                 ; error: invalid aliasee of alias @c; expected definition, got declaration @y
+@d = alias i32, i32* @e                                                 ; error: alias cycle detected; @d -> @e -> @d
+@e = alias i32, i32* @d                                                 ; error: alias cycle detected; @e -> @d -> @e
+@g = common alias i32, i32* @x                                          ; error: invalid linkage type of alias @g; expected private, internal, linkonce, weak, linkonce_odr, weak_odr or external linkage, got common
+
+@h = ifunc void (), void ()* ()* @resolve_f                             ; valid
+@i = ifunc i32, void ()* ()* @resolve_f                                 ; error: invalid IFunc content type; expected function type, got *types.IntType; error: invalid resolver function type of IFunc @i; expected `i32* ()*`, got `void ()* ()*`
+
+define void @f() {
+	ret void
+}
+
+define void ()* @resolve_f() {
+	ret void ()* @f
+}
@@ -0,0 +1,16 @@
+; Comdats and alignment.
+$foo = comdat any                                                       ; valid
+
+@x = global i32 0, comdat($foo), align 4                                ; valid
+@a = global i32 0, align 3                                              ; error: invalid alignment 3 of @a; expected power of two
+@b = external global i32, comdat($foo)                                  ; error: invalid comdat of declaration @b; declarations must not be in a comdat
+
+define void @f() comdat($foo) {                                         ; valid
+	ret void
+}
+
+define void @g() align 6 {                                              ; error: invalid alignment 6 of @g; expected power of two
+	ret void
+}
+
+declare void @h() comdat($foo)                                          ; error: invalid comdat of declaration @h; declarations must not be in a comdat
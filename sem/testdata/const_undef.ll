@@ -0,0 +1,19 @@
+; Undefined values.
+@a = global i32 undef            ; valid
+@b = global {i32, i8} undef      ; valid
+@c = global <2 x float> poison   ; valid
+@d = global [4 x i8*] poison     ; valid
+
+declare void @llvm.g(token)
+
+define i32 @f(i1 %cond) {
+entry:
+	br i1 %cond, label %a, label %b
+a:
+	call void @llvm.g(token undef)               ; error: invalid undef constant type; expected single value or aggregate type, got *types.TokenType
+	br label %b
+b:
+	%x = phi i32 [undef, %entry], [poison, %a]   ; valid
+	call void @llvm.g(token poison)              ; error: invalid poison constant type; expected single value or aggregate type, got *types.TokenType
+	ret i32 %x
+}
@@ -0,0 +1,6 @@
+; Aggregate expressions.
+@a = global i32 extractvalue ({i32, i8} {i32 1, i8 2}, 0)             ; valid
+@b = global i8 extractvalue ([2 x {i32, i8}] zeroinitializer, 1, 1)   ; valid
+@c = global {i32, i8} insertvalue ({i32, i8} {i32 1, i8 2}, i8 3, 1) ; valid
+@d = global i32 extractvalue ({i32, i8} {i32 1, i8 2}, 2)     ; error: invalid `extractvalue` expression index 2; out of bounds for type `{ i32, i8 }`
+@e = global {i32, i8} insertvalue ({i32, i8} zeroinitializer, i32 3, 1) ; error: `insertvalue` expression element type `i32` and indexed type `i8` mismatch
@@ -0,0 +1,7 @@
+; Vector expressions.
+@a = global i32 extractelement (<2 x i32> <i32 1, i32 2>, i32 1)                                     ; valid
+@b = global i32 extractelement (<2 x i32> <i32 1, i32 2>, float 1.0)                                 ; error: invalid `extractelement` expression index type; expected integer type, got *types.FloatType
+@c = global <2 x i32> insertelement (<2 x i32> <i32 1, i32 2>, i32 3, i64 0)                         ; valid
+@d = global <2 x i32> shufflevector (<2 x i32> <i32 1, i32 2>, <2 x i32> zeroinitializer, <2 x i32> <i32 0, i32 3>) ; valid
+@e = global <2 x i32> shufflevector (<2 x i32> <i32 1, i32 2>, <2 x i32> zeroinitializer, <2 x i32> <i32 0, i32 4>) ; error: invalid `shufflevector` expression shuffle mask index 4; out of bounds for 4 input elements
+@f = global <2 x i32> insertelement (<2 x i32> <i32 1, i32 2>, i8 3, i64 0)                   ; error: `insertelement` expression element type `i8` and vector element type `i32` mismatch
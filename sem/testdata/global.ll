@@ -12,5 +12,7 @@
 @i = external global [5 x i32] ; valid
 @j = external global {i32, i8} ; valid
 @k = external global %t        ; valid
+@m = external global x86_mmx            ; valid
+@n = external global <vscale x 4 x i32> ; error: invalid global variable content type; scalable vector type `<vscale x 4 x i32>` not allowed
 
 ;TODO: @l = global {i32, i8} [i8 3, i8 5] ; error: global variable content type `{i32, i8}` and initial value type `[2 x i8]` mismatch
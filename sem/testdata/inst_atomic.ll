@@ -0,0 +1,19 @@
+; Atomic memory instructions.
+define i32 @f(i32* %p, float* %q) {
+	%a = load atomic i32, i32* %p acquire, align 4                         ; valid
+	%b = load atomic i32, i32* %p release, align 4                         ; error: invalid `load` instruction atomic memory ordering; release not allowed on loads
+	%c = load atomic i32, i32* %p seq_cst                                  ; error: invalid atomic `load` instruction; alignment must be explicitly specified
+	store atomic i32 %a, i32* %p release, align 4                          ; valid
+	store atomic i32 %a, i32* %p acquire, align 4                          ; error: invalid `store` instruction atomic memory ordering; acquire not allowed on stores
+	store i32 %a, i32* %p, align 3                                         ; error: invalid `store` instruction alignment 3; expected power of two
+	fence seq_cst                                                          ; valid
+	fence monotonic                                                        ; error: invalid `fence` instruction atomic memory ordering; expected acquire, release, acq_rel or seq_cst, got monotonic
+	%d = cmpxchg i32* %p, i32 %a, i32 1 acq_rel monotonic                  ; valid
+	%e = cmpxchg i32* %p, i32 %a, i32 1 acquire seq_cst                    ; error: invalid `cmpxchg` instruction failure ordering; seq_cst is stronger than success ordering acquire
+	%f = cmpxchg i32* %p, i32 %a, i32 1 seq_cst release                    ; error: invalid `cmpxchg` instruction failure ordering; release not allowed on failure
+	%g = atomicrmw add i32* %p, i32 1 monotonic                            ; valid
+	%h = atomicrmw add i32* %p, i32 1 unordered                            ; error: invalid `atomicrmw` instruction atomic memory ordering; expected at least monotonic, got unordered
+	%i = atomicrmw xchg float* %q, float 1.0 seq_cst                       ; valid
+	%j = atomicrmw add float* %q, float 1.0 seq_cst                        ; error: invalid `atomicrmw add` instruction operand type; expected integer type, got *types.FloatType
+	ret i32 %g
+}
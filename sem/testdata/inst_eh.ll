@@ -0,0 +1,39 @@
+; Exception handling instructions.
+@_ZTIi = external constant i8*
+
+declare i32 @__gxx_personality_v0(...)
+
+declare void @g(i32)
+
+define void @f(i32 %x) personality i32 (...)* @__gxx_personality_v0 {
+entry:
+	invoke void @g(i32 %x) to label %cont unwind label %lpad               ; valid
+cont:
+	invoke void @g() to label %cont2 unwind label %exit                    ; error: too few arguments in `invoke` terminator; expected 1, got 0
+	                                                                       ; error: unwind destination %exit of `invoke` terminator does not begin with an exception handling pad
+cont2:
+	invoke void @g(i32 %x) to label %exit unwind label %lpad2              ; valid
+exit:
+	ret void
+lpad:
+	%a = landingpad { i8*, i32 } catch i8** @_ZTIi                         ; valid
+	resume { i8*, i32 } %a                                                 ; valid
+lpad2:
+	%b = landingpad { i8*, i32 }                                           ; error: invalid `landingpad` instruction; expected at least one clause or cleanup
+	%c = landingpad { i8*, i32 } cleanup                                   ; error: `landingpad` instruction must be the first non-phi instruction of basic block %lpad2
+	resume { i8*, i32 } %b                                                 ; valid
+lpad3:
+	%d = landingpad { i8*, i32 } cleanup                                   ; error: basic block %lpad3 containing `landingpad` instruction is not the unwind destination of any `invoke` terminator
+	resume { i8*, i32 } %d                                                 ; valid
+}
+
+define void @h() {
+entry:
+	invoke void @g(i32 0) to label %ok unwind label %lp                    ; valid
+ok:
+	ret void
+lp:
+	%e = landingpad { i8*, i32 } filter i8** @_ZTIi                        ; error: invalid `landingpad` instruction filter clause type; expected array type, got *types.PointerType
+	                                                                       ; error: `landingpad` instruction in function @h without personality function
+	resume { i8*, i32 } %e                                                 ; error: `resume` terminator in function @h without personality function
+}
@@ -0,0 +1,49 @@
+; Funclet-based exception handling instructions.
+declare i32 @__CxxFrameHandler3(...)
+
+declare void @g(i32)
+
+define void @f() personality i32 (...)* @__CxxFrameHandler3 {
+entry:
+	invoke void @g(i32 1) to label %exit unwind label %dispatch                   ; valid
+dispatch:
+	%cs = catchswitch within none [label %handler, label %bad] unwind label %handler   ; error: handler %bad of `catchswitch` terminator does not begin with a `catchpad` instruction within the `catchswitch`
+	                                                                              ; error: unwind destination %handler of `catchswitch` terminator does not begin with a `catchswitch` or `cleanuppad` instruction
+handler:
+	%cp = catchpad within %cs [i8* null, i32 64, i8* null]                        ; valid
+	catchret from %cp to label %exit                                              ; valid
+bad:
+	%cl = cleanuppad within %cs []                                                ; error: invalid parent pad of `cleanuppad` instruction; expected none token, `catchpad` or `cleanuppad` instruction, got *ir.TermCatchSwitch
+	cleanupret from %cl unwind label %exit                                        ; error: unwind destination %exit of `cleanupret` terminator does not begin with a `catchswitch` or `cleanuppad` instruction
+stray:
+	%sp = catchpad within %cs []                                                  ; error: basic block %stray containing `catchpad` instruction is not a handler of its parent `catchswitch` terminator
+	catchret from %sp to label %exit                                              ; valid
+exit:
+	ret void
+}
+
+define void @forward() personality i32 (...)* @__CxxFrameHandler3 {
+entry:
+	invoke void @g(i32 2) to label %exit unwind label %dispatch                   ; valid
+handler:
+	%cp = catchpad within %cs []                                                  ; valid
+	catchret from %cp to label %exit                                              ; valid
+dispatch:
+	%cs = catchswitch within none [label %handler] unwind to caller               ; valid
+exit:
+	ret void
+}
+
+define void @h() {
+entry:
+	%y = cleanuppad within none []                                                ; error: `cleanuppad` instruction in function @h without personality function
+	%cs = catchswitch within none [label %handler] unwind to caller               ; error: `catchswitch` terminator in function @h without personality function
+	                                                                              ; error: `catchswitch` terminator must be the only non-phi instruction of basic block %entry
+handler:
+	%cp = catchpad within %cs []                                                  ; error: `catchpad` instruction in function @h without personality function
+	%x = cleanuppad within %cp []                                                 ; error: `cleanuppad` instruction in function @h without personality function
+	                                                                              ; error: `cleanuppad` instruction must be the first non-phi instruction of basic block %handler
+	catchret from %cp to label %exit                                              ; valid
+exit:
+	ret void
+}
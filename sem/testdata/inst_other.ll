@@ -0,0 +1,17 @@
+; Other instructions.
+declare void @g(i32, i8)
+declare void @v(i32, ...)
+
+define i32 @f(i1 %cond, float %y) {
+entry:
+	call void @g(i32 1, i8 2)                ; valid
+	call void @v(i32 1, i8 2, i64 3)         ; valid
+	call void @g(i32 1, i32 2)               ; error: `call` instruction argument type `i32` and parameter type `i8` mismatch
+	call void @g(i32 1)                      ; error: too few arguments in `call` instruction; expected 2, got 1
+	br i1 %cond, label %a, label %b
+a:
+	br label %b
+b:
+	%x = phi i32 [1, %entry], [%y, %a]       ; error: `phi` instruction incoming value type `float` and instruction type `i32` mismatch
+	ret i32 %x
+}
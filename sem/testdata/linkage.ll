@@ -0,0 +1,18 @@
+; Linkage types, visibility styles and DLL storage classes.
+@a = private hidden global i32 0                                        ; error: invalid visibility style of @a; global values with private linkage must have default visibility, got hidden
+@b = internal dllexport global i32 0                                    ; error: invalid DLL storage class of @b; global values with internal linkage must not have DLL storage class, got dllexport
+@c = appending global i32 0                                             ; error: invalid content type of global variable @c with appending linkage; expected array type, got `i32`
+@d = appending global [1 x i32] zeroinitializer                         ; valid
+@e = extern_weak dllimport global i32                                   ; valid
+
+declare internal void @f()                                              ; error: invalid linkage type of declaration @f; declarations must have external or extern_weak linkage, got internal
+declare private hidden void @g()                                        ; error: invalid linkage type of declaration @g; declarations must have external or extern_weak linkage, got private; error: invalid visibility style of @g; global values with private linkage must have default visibility, got hidden
+declare extern_weak void @h()                                           ; valid
+
+define extern_weak void @i() {                                          ; error: invalid linkage type of definition @i; definitions must not have extern_weak linkage
+	ret void
+}
+
+define common void @j() {                                               ; error: invalid linkage type of function @j; common linkage is only valid for global variables
+	ret void
+}
@@ -0,0 +1,20 @@
+; Indirect branch terminators.
+define i8* @g() {
+	ret i8* blockaddress(@f, %b)                                           ; valid
+}
+
+define void @f(i8* %addr) {
+entry:
+	indirectbr i8* %addr, [label %a, label %b]                             ; valid
+a:
+	ret void
+b:
+	ret void
+}
+
+define void @h(i64 %x) {
+entry:
+	indirectbr i64 %x, [label %a]                                          ; error: invalid `indirectbr` terminator address type; expected pointer type, got *types.IntType
+a:
+	ret void
+}
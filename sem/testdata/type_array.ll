@@ -12,3 +12,5 @@
 @i = external global [5 x [5 x i32]] ; valid
 @j = external global [5 x {i32, i8}] ; valid
 @k = external global [5 x %t]        ; valid
+@l = external global [5 x x86_mmx]            ; error: invalid array element type; x86_mmx type not allowed
+@m = external global [5 x <vscale x 4 x i32>] ; error: invalid array element type; scalable vector type `<vscale x 4 x i32>` not allowed
@@ -20,8 +20,16 @@ declare void @n(i32 %x)       ; valid
 declare void @o(double %x)    ; valid
 declare void @p(i32* %x)      ; valid
 declare void @q(<5 x i32> %x) ; valid
-declare void @r(label %x)     ; valid
-declare void @s(metadata %x)  ; valid
+declare void @r(label %x)     ; error: invalid function parameter; label type not allowed
+declare void @s(metadata %x)  ; error: invalid parameter type of function @s; metadata type only allowed for intrinsic functions
 declare void @t([5 x i32] %x) ; valid
 declare void @u({i32, i8} %x) ; valid
 declare void @v(%t %x)        ; valid
+
+; Intrinsic-only types.
+declare void @llvm.w(metadata %x) ; valid
+declare token @x()                ; error: invalid return type of function @x; token type only allowed for intrinsic functions
+declare token @llvm.x()           ; valid
+declare void @y(token %x)         ; error: invalid parameter type of function @y; token type only allowed for intrinsic functions
+declare void @llvm.y(token %x)    ; valid
+declare void @z(x86_mmx %x)       ; valid
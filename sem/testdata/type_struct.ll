@@ -12,3 +12,5 @@
 @i = external global {[5 x i32]} ; valid
 @j = external global {{i32, i8}} ; valid
 @k = external global {%t}        ; valid
+@l = external global {x86_mmx}            ; valid
+@m = external global {<vscale x 4 x i32>} ; error: invalid struct field type; scalable vector type `<vscale x 4 x i32>` not allowed
@@ -1,8 +1,6 @@
 %t = type {i32, i8}
 
 ; Vector types.
-;TODO: @a = external global <5 x void>      ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.VoidType
-;TODO: @b = external global <5 x i32 ()>    ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.FuncType
 @c = external global <5 x i32>       ; valid
 @d = external global <5 x double>    ; valid
 @e = external global <5 x i32*>      ; valid
@@ -12,3 +10,5 @@
 @i = external global <5 x [5 x i32]> ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.ArrayType
 @j = external global <5 x {i32, i8}> ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.StructType
 @k = external global <5 x %t>        ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.StructType
+@l = external global {<vscale x 4 x float>*} ; valid
+@m = external global <vscale x 4 x label>*   ; error: invalid vector element type; expected integer, floating-point or pointer type, got *types.LabelType